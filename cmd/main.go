@@ -5,22 +5,30 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	_ "github.com/lib/pq"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 	"log"
+	"log/slog"
 	"net"
 	"net/http"
 	"path/filepath"
 	"readly/controller"
 	sqlc "readly/db/sqlc"
 	"readly/env"
+	"readly/job"
 	"readly/middleware"
 	"readly/pb"
 	"readly/repository"
 	"readly/router"
 	"readly/server"
 	"readly/service/auth"
+	"readly/service/event"
+	"readly/service/report"
+	"readly/service/webhook"
 	"readly/usecase"
+	"strings"
+	_ "time/tzdata"
 )
 
 func main() {
@@ -36,30 +44,198 @@ func main() {
 	bookRepo := repository.NewBookRepository(q)
 	userRepo := repository.NewUserRepository(q)
 	readingHistoryRepo := repository.NewReadingHistoryRepository(q)
+	readingActivityRepo := repository.NewReadingActivityRepository(q)
+	readingStatsRepo := repository.NewReadingStatsRepository(q)
+	loanRepo := repository.NewLoanRepository(q)
+	recommendationRepo := repository.NewRecommendationRepository(q)
+	followRepo := repository.NewFollowRepository(q)
+	feedRepo := repository.NewFeedRepository(q)
+	outboxRepo := repository.NewOutboxRepository(q)
+	tombstoneRepo := repository.NewSyncTombstoneRepository(q)
+	bookTagRepo := repository.NewBookTagRepository(q)
+	bookNoteRepo := repository.NewBookNoteRepository(q)
+	webhookRepo := repository.NewWebhookRepository(q)
+	shelfVisibilityRepo := repository.NewShelfVisibilityRepository(q)
+	clubRepo := repository.NewBookClubRepository(q)
+	clubMemberRepo := repository.NewClubMemberRepository(q)
+	clubPostRepo := repository.NewClubPostRepository(q)
 	sessionRepo := repository.NewSessionRepository(q)
+	idempotencyKeyRepo := repository.NewIdempotencyKeyRepository(q)
+	auditLogRepo := repository.NewAuditLogRepository(q)
+	catalogRepo := repository.NewCatalogRepository(q)
 
 	maker, err := auth.NewPasetoMaker(config.TokenSymmetricKey)
 	if err != nil {
 		log.Fatal("cannot start server:", err)
 	}
+	renderer, err := report.NewHTMLRenderer(report.NewHTTPCoverFetcher())
+	if err != nil {
+		log.Fatal("cannot create renderer:", err)
+	}
 
-	registerBookUseCase := usecase.NewRegisterBookUseCase(t, bookRepo, readingHistoryRepo, userRepo)
-	deleteBookUseCase := usecase.NewDeleteBookUseCase(t, bookRepo, readingHistoryRepo, userRepo)
-	signUpUseCase := usecase.NewSignUpUseCase(config, maker, t, sessionRepo, userRepo)
+	registerBookUseCase := usecase.NewRegisterBookUseCase(t, bookRepo, readingHistoryRepo, readingActivityRepo, userRepo, feedRepo, outboxRepo, catalogRepo)
+	deleteBookUseCase := usecase.NewDeleteBookUseCase(t, bookRepo, readingHistoryRepo, userRepo, outboxRepo, tombstoneRepo)
+	updateBookUseCase := usecase.NewUpdateBookUseCase(t, bookRepo, catalogRepo)
+	signUpUseCase := usecase.NewSignUpUseCase(config, maker, t, sessionRepo, userRepo, outboxRepo)
 	signInUseCase := usecase.NewSignInUseCase(config, maker, t, sessionRepo, userRepo)
-	refreshTokenUseCase := usecase.NewRefreshAccessTokenUseCase(config, maker, sessionRepo)
+	refreshTokenUseCase := usecase.NewRefreshAccessTokenUseCase(config, maker, sessionRepo, userRepo)
+	readingStatsUseCase := usecase.NewGetReadingStatsUseCase(readingStatsRepo)
+	yearInReviewUseCase := usecase.NewGenerateYearInReviewUseCase(readingStatsRepo, renderer)
+	streakUseCase := usecase.NewGetReadingStreakUseCase(userRepo, readingActivityRepo)
+	calendarUseCase := usecase.NewGetActivityCalendarUseCase(userRepo, readingActivityRepo)
+	queueUseCase := usecase.NewGetReadingQueueUseCase(readingHistoryRepo)
+	reorderUseCase := usecase.NewReorderReadingQueueUseCase(t, readingHistoryRepo)
+	popNextUseCase := usecase.NewPopNextBookUseCase(t, readingHistoryRepo, readingActivityRepo, feedRepo, outboxRepo)
+	wishlistUseCase := usecase.NewUpdateWishlistEntryUseCase(t, readingHistoryRepo, outboxRepo)
+	libraryUseCase := usecase.NewGetLibraryUseCase(readingHistoryRepo)
+	spendingUseCase := usecase.NewGetSpendingSummaryUseCase(readingStatsRepo)
+	recommendUseCase := usecase.NewRecommendBooksUseCase(t, recommendationRepo)
+	refreshRecommendationsUseCase := usecase.NewRefreshRecommendationsUseCase(t, recommendationRepo)
+	libraryBus := event.NewBus()
+	watchLibraryUseCase := usecase.NewWatchLibraryUseCase(outboxRepo, libraryBus)
+	syncUseCase := usecase.NewSyncUseCase(t, readingHistoryRepo, readingActivityRepo, feedRepo, outboxRepo, bookTagRepo, bookNoteRepo, tombstoneRepo)
+	batchRegisterUseCase := usecase.NewBatchRegisterBooksUseCase(t, registerBookUseCase)
+	idempotencyUseCase := usecase.NewExecuteWithIdempotencyKeyUseCase(t, idempotencyKeyRepo, config.IdempotencyKeyTTL)
+	enqueueWebhooksUseCase := usecase.NewEnqueueWebhookDeliveriesUseCase(webhookRepo)
+	relayOutboxUseCase := usecase.NewRelayOutboxEventsUseCase(
+		t,
+		outboxRepo,
+		[]event.Sink{
+			event.NewLogSink(slog.Default()),
+			event.SinkFunc(enqueueWebhooksUseCase.EnqueueWebhookDeliveries),
+		},
+		[]event.Sink{libraryBus},
+	)
+	deliverWebhooksUseCase := usecase.NewDeliverWebhooksUseCase(webhookRepo, webhook.NewHTTPClient(config.WebhookTimeout))
+	createWebhookUseCase := usecase.NewCreateWebhookUseCase(webhookRepo)
+	listWebhooksUseCase := usecase.NewListWebhooksUseCase(webhookRepo)
+	deleteWebhookUseCase := usecase.NewDeleteWebhookUseCase(webhookRepo)
+	webhookDeliveriesUseCase := usecase.NewListWebhookDeliveriesUseCase(webhookRepo)
+	timezoneUseCase := usecase.NewUpdateTimezoneUseCase(userRepo)
+	privacyUseCase := usecase.NewUpdatePrivacySettingsUseCase(t, userRepo, shelfVisibilityRepo)
+	lendBookUseCase := usecase.NewLendBookUseCase(t, readingHistoryRepo, loanRepo, userRepo)
+	returnBookUseCase := usecase.NewReturnBookUseCase(t, loanRepo, userRepo)
+	activeLoansUseCase := usecase.NewListActiveLoansUseCase(loanRepo)
+	overdueLoansUseCase := usecase.NewListOverdueLoansUseCase(loanRepo)
+	followUseCase := usecase.NewFollowUserUseCase(followRepo, userRepo)
+	unfollowUseCase := usecase.NewUnfollowUserUseCase(followRepo)
+	followersUseCase := usecase.NewListFollowersUseCase(followRepo)
+	followingUseCase := usecase.NewListFollowingUseCase(followRepo)
+	feedUseCase := usecase.NewGetFeedUseCase(feedRepo)
+	publicProfileUseCase := usecase.NewGetPublicProfileUseCase(userRepo, readingHistoryRepo)
+	createClubUseCase := usecase.NewCreateClubUseCase(t, clubRepo, clubMemberRepo)
+	getClubUseCase := usecase.NewGetClubUseCase(clubRepo, clubMemberRepo, bookRepo)
+	inviteToClubUseCase := usecase.NewInviteToClubUseCase(clubRepo, clubMemberRepo, userRepo)
+	joinClubUseCase := usecase.NewJoinClubUseCase(t, clubRepo, clubMemberRepo)
+	leaveClubUseCase := usecase.NewLeaveClubUseCase(clubRepo, clubMemberRepo)
+	clubRoleUseCase := usecase.NewUpdateClubMemberRoleUseCase(t, clubRepo, clubMemberRepo)
+	clubScheduleUseCase := usecase.NewSetClubScheduleUseCase(t, clubRepo, clubMemberRepo, bookRepo)
+	clubProgressUseCase := usecase.NewUpdateClubProgressUseCase(t, clubRepo, clubMemberRepo, bookRepo, readingActivityRepo)
+	postClubDiscussionUseCase := usecase.NewPostClubDiscussionUseCase(clubRepo, clubMemberRepo, clubPostRepo, userRepo)
+	listClubDiscussionUseCase := usecase.NewListClubDiscussionUseCase(clubRepo, clubMemberRepo, clubPostRepo)
+	listUsersUseCase := usecase.NewListUsersUseCase(t, userRepo, auditLogRepo)
+	setUserDisabledUseCase := usecase.NewSetUserDisabledUseCase(t, userRepo, sessionRepo, auditLogRepo)
+	revokeUserSessionsUseCase := usecase.NewRevokeUserSessionsUseCase(t, userRepo, sessionRepo, auditLogRepo)
+	userLibraryUseCase := usecase.NewGetUserLibraryUseCase(t, userRepo, readingHistoryRepo, auditLogRepo)
+	deleteCatalogBookUseCase := usecase.NewDeleteCatalogBookUseCase(t, bookRepo, readingHistoryRepo, outboxRepo, tombstoneRepo, auditLogRepo)
+	listAuditLogsUseCase := usecase.NewListAuditLogsUseCase(auditLogRepo)
+	duplicateCatalogEntriesUseCase := usecase.NewGetDuplicateCatalogEntriesUseCase(catalogRepo)
+	mergeCatalogEntryUseCase := usecase.NewMergeCatalogEntryUseCase(t, catalogRepo)
 
-	// メインルーチンでgRPC Serverの起動しているとそこでブロックしてしまい、
-	//HTTP Gatewayの起動ができないため、別のルーチンで起動する
-	go runGatewayServer(
+	userServer := server.NewUserServer(
 		config,
-		maker,
-		registerBookUseCase,
-		deleteBookUseCase,
 		signUpUseCase,
 		signInUseCase,
 		refreshTokenUseCase,
+		timezoneUseCase,
+		privacyUseCase,
+	)
+	bookServer := server.NewBookServer(
+		registerBookUseCase,
+		deleteBookUseCase,
+		updateBookUseCase,
+		readingStatsUseCase,
+		yearInReviewUseCase,
+		streakUseCase,
+		calendarUseCase,
+		queueUseCase,
+		reorderUseCase,
+		popNextUseCase,
+		wishlistUseCase,
+		libraryUseCase,
+		spendingUseCase,
+		recommendUseCase,
+		watchLibraryUseCase,
+		syncUseCase,
+		batchRegisterUseCase,
+		idempotencyUseCase,
+	)
+	loanServer := server.NewLoanServer(
+		lendBookUseCase,
+		returnBookUseCase,
+		activeLoansUseCase,
+		overdueLoansUseCase,
+	)
+	socialServer := server.NewSocialServer(
+		config,
+		followUseCase,
+		unfollowUseCase,
+		followersUseCase,
+		followingUseCase,
+		feedUseCase,
+		publicProfileUseCase,
+	)
+	webhookServer := server.NewWebhookServer(
+		createWebhookUseCase,
+		listWebhooksUseCase,
+		deleteWebhookUseCase,
+		webhookDeliveriesUseCase,
+	)
+	clubServer := server.NewClubServer(
+		createClubUseCase,
+		getClubUseCase,
+		inviteToClubUseCase,
+		joinClubUseCase,
+		leaveClubUseCase,
+		clubRoleUseCase,
+		clubScheduleUseCase,
+		clubProgressUseCase,
+		postClubDiscussionUseCase,
+		listClubDiscussionUseCase,
 	)
+	adminServer := server.NewAdminServer(
+		listUsersUseCase,
+		setUserDisabledUseCase,
+		revokeUserSessionsUseCase,
+		userLibraryUseCase,
+		deleteCatalogBookUseCase,
+		listAuditLogsUseCase,
+	)
+	catalogServer := server.NewCatalogServer(
+		duplicateCatalogEntriesUseCase,
+		mergeCatalogEntryUseCase,
+	)
+
+	recommendationJob := job.NewRecommendationJob(
+		refreshRecommendationsUseCase,
+		config.RecommendationRefreshInterval,
+		config.RecommendationMaxAge,
+	)
+	go recommendationJob.Run(context.Background())
+	outboxRelayJob := job.NewOutboxRelayJob(
+		relayOutboxUseCase,
+		config.OutboxRelayInterval,
+	)
+	go outboxRelayJob.Run(context.Background())
+	webhookDeliveryJob := job.NewWebhookDeliveryJob(
+		deliverWebhooksUseCase,
+		config.WebhookDeliveryInterval,
+	)
+	go webhookDeliveryJob.Run(context.Background())
+
+	// メインルーチンでgRPC Serverの起動しているとそこでブロックしてしまい、
+	//HTTP Gatewayの起動ができないため、別のルーチンで起動する
+	go runGatewayServer(config)
 
 	//runGinServer(
 	//	config,
@@ -74,11 +250,14 @@ func main() {
 	runGRPCServer(
 		config,
 		maker,
-		registerBookUseCase,
-		deleteBookUseCase,
-		signUpUseCase,
-		signInUseCase,
-		refreshTokenUseCase,
+		userServer,
+		bookServer,
+		loanServer,
+		socialServer,
+		clubServer,
+		webhookServer,
+		adminServer,
+		catalogServer,
 	)
 }
 
@@ -105,28 +284,29 @@ func runGinServer(
 func runGRPCServer(
 	config env.Config,
 	maker auth.TokenMaker,
-	registerBookUseCase usecase.RegisterBookUseCase,
-	deleteBookUseCase usecase.DeleteBookUseCase,
-	signUpUseCase usecase.SignUpUseCase,
-	signInUseCase usecase.SignInUseCase,
-	refreshTokenUseCase usecase.RefreshAccessTokenUseCase,
+	userServer pb.UserServiceServer,
+	bookServer pb.BookServiceServer,
+	loanServer pb.LoanServiceServer,
+	socialServer pb.SocialServiceServer,
+	clubServer pb.ClubServiceServer,
+	webhookServer pb.WebhookServiceServer,
+	adminServer pb.AdminServiceServer,
+	catalogServer pb.CatalogServiceServer,
 ) {
-	grpcServer := grpc.NewServer()
-
-	userServer := server.NewUserServer(
-		config,
-		maker,
-		signUpUseCase,
-		signInUseCase,
-		refreshTokenUseCase,
-	)
-	bookServer := server.NewBookServer(
-		maker,
-		registerBookUseCase,
-		deleteBookUseCase,
+	// allow_unauthenticatedを指定したメソッド以外はハンドラーの前に認証する
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(middleware.UnaryAuthInterceptor(maker)),
+		grpc.StreamInterceptor(middleware.StreamAuthInterceptor(maker)),
 	)
+
 	pb.RegisterUserServiceServer(grpcServer, userServer)
 	pb.RegisterBookServiceServer(grpcServer, bookServer)
+	pb.RegisterLoanServiceServer(grpcServer, loanServer)
+	pb.RegisterSocialServiceServer(grpcServer, socialServer)
+	pb.RegisterClubServiceServer(grpcServer, clubServer)
+	pb.RegisterWebhookServiceServer(grpcServer, webhookServer)
+	pb.RegisterAdminServiceServer(grpcServer, adminServer)
+	pb.RegisterCatalogServiceServer(grpcServer, catalogServer)
 	reflection.Register(grpcServer)
 
 	listener, err := net.Listen("tcp", config.GRPCServerAddress)
@@ -141,28 +321,7 @@ func runGRPCServer(
 	}
 }
 
-func runGatewayServer(
-	config env.Config,
-	maker auth.TokenMaker,
-	registerBookUseCase usecase.RegisterBookUseCase,
-	deleteBookUseCase usecase.DeleteBookUseCase,
-	signUpUseCase usecase.SignUpUseCase,
-	signInUseCase usecase.SignInUseCase,
-	refreshTokenUseCase usecase.RefreshAccessTokenUseCase,
-) {
-	userServer := server.NewUserServer(
-		config,
-		maker,
-		signUpUseCase,
-		signInUseCase,
-		refreshTokenUseCase,
-	)
-	bookServer := server.NewBookServer(
-		maker,
-		registerBookUseCase,
-		deleteBookUseCase,
-	)
-
+func runGatewayServer(config env.Config) {
 	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 		MarshalOptions: protojson.MarshalOptions{
 			UseProtoNames: true,
@@ -171,19 +330,48 @@ func runGatewayServer(
 			DiscardUnknown: true,
 		},
 	})
+	// Cache-ControlとETagはgRPCのメタデータとしてではなくHTTPヘッダーとしてそのまま返す
+	headerOption := runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+		switch strings.ToLower(key) {
+		case "cache-control":
+			return "Cache-Control", true
+		case "etag":
+			return "ETag", true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	})
+	// Idempotency-Key、If-Match、Accept-LanguageはgRPCと同じメタデータのキーで受け取れるようにする
+	incomingHeaderOption := runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+		switch k := strings.ToLower(key); k {
+		case "idempotency-key", "if-match", "accept-language":
+			return k, true
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	})
+	errorHandlerOption := runtime.WithErrorHandler(server.HTTPErrorHandler)
 	// HTTPリクエストをgRPCのリクエストに変換する
-	grpcMux := runtime.NewServeMux(jsonOption)
+	grpcMux := runtime.NewServeMux(jsonOption, headerOption, incomingHeaderOption, errorHandlerOption)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	err := pb.RegisterUserServiceHandlerServer(ctx, grpcMux, userServer)
-	if err != nil {
-		log.Fatalf("cannot register handle server: %v", err)
+	// 認証インターセプターを通すため、サーバーを直接呼び出さずにgRPCサーバーへプロキシする
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	registers := []func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error{
+		pb.RegisterUserServiceHandlerFromEndpoint,
+		pb.RegisterBookServiceHandlerFromEndpoint,
+		pb.RegisterLoanServiceHandlerFromEndpoint,
+		pb.RegisterSocialServiceHandlerFromEndpoint,
+		pb.RegisterClubServiceHandlerFromEndpoint,
+		pb.RegisterWebhookServiceHandlerFromEndpoint,
+		pb.RegisterAdminServiceHandlerFromEndpoint,
+		pb.RegisterCatalogServiceHandlerFromEndpoint,
 	}
-	err = pb.RegisterBookServiceHandlerServer(ctx, grpcMux, bookServer)
-	if err != nil {
-		log.Fatalf("cannot register handle server: %v", err)
+	for _, register := range registers {
+		err := register(ctx, grpcMux, config.GRPCServerAddress, dialOptions)
+		if err != nil {
+			log.Fatalf("cannot register handle server: %v", err)
+		}
 	}
 
 	// クライアントから実際のHTTPリクエストを受け取る
@@ -31,18 +31,27 @@ func NewBookController(
 }
 
 type RegisterBookRequest struct {
-	Title         string               `json:"title" binding:"required,min=1"`
-	Genres        []string             `json:"genres" binding:"omitempty,max=5"`
-	Description   *string              `json:"description" binding:"omitempty,max=500"`
-	CoverImageURL *string              `json:"cover_image_url" binding:"omitempty,url,max=2048"`
-	URL           *string              `json:"url" binding:"omitempty,url,max=2048"`
-	AuthorName    *string              `json:"author_name" binding:"omitempty,max=255"`
-	PublisherName *string              `json:"publisher_name" binding:"omitempty,max=255"`
-	PublishDate   *time.Time           `json:"publish_date" binding:"omitempty"`
-	ISBN          *string              `json:"isbn" binding:"omitempty,isbn"`
-	Status        entity.ReadingStatus `json:"status" binding:"required"`
-	StartDate     *time.Time           `json:"start_date" binding:"omitempty"`
-	EndDate       *time.Time           `json:"end_date" binding:"omitempty"`
+	Title            string               `json:"title" binding:"required,min=1"`
+	Genres           []string             `json:"genres" binding:"omitempty,max=5"`
+	Description      *string              `json:"description" binding:"omitempty,max=500"`
+	CoverImageURL    *string              `json:"cover_image_url" binding:"omitempty,url,max=2048"`
+	URL              *string              `json:"url" binding:"omitempty,url,max=2048"`
+	AuthorName       *string              `json:"author_name" binding:"omitempty,max=255"`
+	PublisherName    *string              `json:"publisher_name" binding:"omitempty,max=255"`
+	PublishDate      *time.Time           `json:"publish_date" binding:"omitempty"`
+	ISBN             *string              `json:"isbn" binding:"omitempty,isbn"`
+	PageCount        *int32               `json:"page_count" binding:"omitempty,min=1"`
+	Priority         int16                `json:"priority" binding:"min=0,max=5"`
+	Owned            *bool                `json:"owned"`
+	Format           entity.BookFormat    `json:"format" binding:"min=0,max=4"`
+	PurchasePrice    *int64               `json:"purchase_price" binding:"omitempty,min=0"`
+	PurchaseCurrency *string              `json:"purchase_currency" binding:"omitempty,len=3"`
+	PurchaseStore    *string              `json:"purchase_store" binding:"omitempty,max=255"`
+	PurchaseDate     *time.Time           `json:"purchase_date" binding:"omitempty"`
+	DurationMinutes  *int32               `json:"duration_minutes" binding:"omitempty,min=1"`
+	Status           entity.ReadingStatus `json:"status" binding:"required"`
+	StartDate        *time.Time           `json:"start_date" binding:"omitempty"`
+	EndDate          *time.Time           `json:"end_date" binding:"omitempty"`
 }
 
 func (bc *BookControllerImpl) Register(ctx *gin.Context) {
@@ -55,19 +64,28 @@ func (bc *BookControllerImpl) Register(ctx *gin.Context) {
 	claims := ctx.MustGet(middleware.AuthorizationClaimKey).(*auth.Claims)
 
 	args := usecase.RegisterBookRequest{
-		UserID:        claims.UserID,
-		Title:         req.Title,
-		Genres:        req.Genres,
-		Description:   req.Description,
-		CoverImageURL: req.CoverImageURL,
-		URL:           req.URL,
-		AuthorName:    req.AuthorName,
-		PublisherName: req.PublisherName,
-		PublishDate:   req.PublishDate,
-		ISBN:          req.ISBN,
-		Status:        req.Status,
-		StartDate:     req.StartDate,
-		EndDate:       req.EndDate,
+		UserID:           claims.UserID,
+		Title:            req.Title,
+		Genres:           req.Genres,
+		Description:      req.Description,
+		CoverImageURL:    req.CoverImageURL,
+		URL:              req.URL,
+		AuthorName:       req.AuthorName,
+		PublisherName:    req.PublisherName,
+		PublishDate:      req.PublishDate,
+		ISBN:             req.ISBN,
+		PageCount:        req.PageCount,
+		Priority:         req.Priority,
+		Owned:            req.Owned,
+		Format:           req.Format,
+		PurchasePrice:    req.PurchasePrice,
+		PurchaseCurrency: req.PurchaseCurrency,
+		PurchaseStore:    req.PurchaseStore,
+		PurchaseDate:     req.PurchaseDate,
+		DurationMinutes:  req.DurationMinutes,
+		Status:           req.Status,
+		StartDate:        req.StartDate,
+		EndDate:          req.EndDate,
 	}
 	book, err := bc.registerUseCase.RegisterBook(ctx, args)
 	if err != nil {
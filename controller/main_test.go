@@ -37,16 +37,21 @@ func setupControllers(t *testing.T) (BookController, UserController) {
 	bookRepo := repository.NewBookRepository(q)
 	userRepo := repository.NewUserRepository(q)
 	readingHistoryRepo := repository.NewReadingHistoryRepository(q)
+	readingActivityRepo := repository.NewReadingActivityRepository(q)
+	feedRepo := repository.NewFeedRepository(q)
 	sessionRepo := repository.NewSessionRepository(q)
+	outboxRepo := repository.NewOutboxRepository(q)
+	tombstoneRepo := repository.NewSyncTombstoneRepository(q)
+	catalogRepo := repository.NewCatalogRepository(q)
 
 	maker, err := auth.NewPasetoMaker(config.TokenSymmetricKey)
 	require.NoError(t, err)
 
-	registerBookUseCase := usecase.NewRegisterBookUseCase(transaction, bookRepo, readingHistoryRepo, userRepo)
-	deleteBookUseCase := usecase.NewDeleteBookUseCase(transaction, bookRepo, readingHistoryRepo, userRepo)
-	signUpUseCase := usecase.NewSignUpUseCase(config, maker, transaction, sessionRepo, userRepo)
+	registerBookUseCase := usecase.NewRegisterBookUseCase(transaction, bookRepo, readingHistoryRepo, readingActivityRepo, userRepo, feedRepo, outboxRepo, catalogRepo)
+	deleteBookUseCase := usecase.NewDeleteBookUseCase(transaction, bookRepo, readingHistoryRepo, userRepo, outboxRepo, tombstoneRepo)
+	signUpUseCase := usecase.NewSignUpUseCase(config, maker, transaction, sessionRepo, userRepo, outboxRepo)
 	signInUseCase := usecase.NewSignInUseCase(config, maker, transaction, sessionRepo, userRepo)
-	refreshTokenUseCase := usecase.NewRefreshAccessTokenUseCase(config, maker, sessionRepo)
+	refreshTokenUseCase := usecase.NewRefreshAccessTokenUseCase(config, maker, sessionRepo, userRepo)
 
 	bookController := NewBookController(registerBookUseCase, deleteBookUseCase)
 	userController := NewUserController(config, maker, signUpUseCase, signInUseCase, refreshTokenUseCase)
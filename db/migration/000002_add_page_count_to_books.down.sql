@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS reading_histories_user_id_end_date_idx;
+
+ALTER TABLE "books"
+    DROP COLUMN IF EXISTS "page_count";
@@ -0,0 +1,7 @@
+ALTER TABLE "books"
+    ADD COLUMN "page_count" integer;
+
+ALTER TABLE "books"
+    ADD CONSTRAINT "books_page_count_check" CHECK ("page_count" > 0);
+
+CREATE INDEX ON "reading_histories" ("user_id", "end_date");
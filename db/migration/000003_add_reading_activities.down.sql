@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS reading_activities;
+
+DROP TYPE IF EXISTS activity_type;
+
+ALTER TABLE "users"
+    DROP COLUMN IF EXISTS "timezone";
\ No newline at end of file
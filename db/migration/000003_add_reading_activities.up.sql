@@ -0,0 +1,27 @@
+ALTER TABLE "users"
+    ADD COLUMN "timezone" varchar(64) NOT NULL DEFAULT ('UTC');
+
+CREATE TYPE "activity_type" AS ENUM (
+  'status_changed',
+  'progress_updated'
+);
+
+CREATE TABLE "reading_activities"
+(
+    "id"            bigserial PRIMARY KEY,
+    "user_id"       bigint        NOT NULL,
+    "book_id"       bigint        NOT NULL,
+    "activity_type" activity_type NOT NULL,
+    "occurred_at"   timestamptz   NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "reading_activities" ("user_id", "occurred_at");
+
+COMMENT
+ON TABLE "reading_activities" IS 'Stores reading activities such as status changes and progress updates. Used to compute reading streaks.';
+
+ALTER TABLE "reading_activities"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "reading_activities"
+    ADD FOREIGN KEY ("book_id") REFERENCES "books" ("id") ON DELETE CASCADE;
\ No newline at end of file
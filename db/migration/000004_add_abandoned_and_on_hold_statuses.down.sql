@@ -0,0 +1,18 @@
+-- PostgreSQLではenumの値を削除できないため型を作り直す
+UPDATE "reading_histories"
+SET "status" = 'unknown'
+WHERE "status" IN ('abandoned', 'on_hold');
+
+ALTER TYPE "reading_status" RENAME TO "reading_status_old";
+
+CREATE TYPE "reading_status" AS ENUM (
+  'unread',
+  'reading',
+  'done',
+  'unknown'
+);
+
+ALTER TABLE "reading_histories"
+    ALTER COLUMN "status" TYPE "reading_status" USING "status"::text::"reading_status";
+
+DROP TYPE IF EXISTS "reading_status_old";
\ No newline at end of file
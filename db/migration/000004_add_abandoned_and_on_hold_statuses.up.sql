@@ -0,0 +1,3 @@
+ALTER TYPE "reading_status" ADD VALUE IF NOT EXISTS 'abandoned';
+
+ALTER TYPE "reading_status" ADD VALUE IF NOT EXISTS 'on_hold';
\ No newline at end of file
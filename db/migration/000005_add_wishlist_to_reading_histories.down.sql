@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS reading_histories_user_id_queue_position_idx;
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "owned";
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "queue_position";
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "priority";
\ No newline at end of file
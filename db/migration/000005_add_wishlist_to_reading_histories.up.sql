@@ -0,0 +1,16 @@
+ALTER TABLE "reading_histories"
+    ADD COLUMN "priority" smallint NOT NULL DEFAULT (0);
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "queue_position" integer;
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "owned" boolean NOT NULL DEFAULT (true);
+
+ALTER TABLE "reading_histories"
+    ADD CONSTRAINT "reading_histories_priority_check" CHECK ("priority" BETWEEN 0 AND 5);
+
+CREATE INDEX ON "reading_histories" ("user_id", "queue_position");
+
+COMMENT
+ON COLUMN "reading_histories"."queue_position" IS 'Position in the want-to-read queue. Only unread histories have a position.';
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS loans;
\ No newline at end of file
@@ -0,0 +1,35 @@
+CREATE TABLE "loans"
+(
+    "id"               bigserial PRIMARY KEY,
+    "user_id"          bigint      NOT NULL,
+    "book_id"          bigint      NOT NULL,
+    "borrower_name"    varchar(30) NOT NULL,
+    "borrower_user_id" bigint,
+    "loan_date"        date        NOT NULL,
+    "due_date"         date,
+    "return_date"      date,
+    "created_at"       timestamptz NOT NULL DEFAULT (now()),
+    "updated_at"       timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "loans" ("user_id", "book_id") WHERE "return_date" IS NULL;
+
+CREATE INDEX ON "loans" ("user_id", "due_date");
+
+ALTER TABLE "loans"
+    ADD CONSTRAINT "loans_due_date_check" CHECK ("due_date" IS NULL OR "due_date" >= "loan_date");
+
+ALTER TABLE "loans"
+    ADD CONSTRAINT "loans_return_date_check" CHECK ("return_date" IS NULL OR "return_date" >= "loan_date");
+
+COMMENT
+ON TABLE "loans" IS 'Stores books lent to other people. A loan without return_date is active.';
+
+COMMENT
+ON COLUMN "loans"."borrower_user_id" IS 'Set when the borrower is a registered user.';
+
+ALTER TABLE "loans"
+    ADD FOREIGN KEY ("user_id", "book_id") REFERENCES "reading_histories" ("user_id", "book_id") ON DELETE CASCADE;
+
+ALTER TABLE "loans"
+    ADD FOREIGN KEY ("borrower_user_id") REFERENCES "users" ("id") ON DELETE SET NULL;
\ No newline at end of file
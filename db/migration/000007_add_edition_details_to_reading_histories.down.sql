@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS reading_histories_user_id_purchase_date_idx;
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "duration_minutes";
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "purchase_date";
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "purchase_store";
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "purchase_currency";
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "purchase_price";
+
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "format";
+
+DROP TYPE IF EXISTS book_format;
\ No newline at end of file
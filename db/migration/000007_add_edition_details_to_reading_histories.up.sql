@@ -0,0 +1,44 @@
+CREATE TYPE "book_format" AS ENUM (
+  'paperback',
+  'hardcover',
+  'ebook',
+  'audiobook'
+);
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "format" book_format;
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "purchase_price" bigint;
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "purchase_currency" char(3);
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "purchase_store" varchar(255);
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "purchase_date" date;
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "duration_minutes" integer;
+
+ALTER TABLE "reading_histories"
+    ADD CONSTRAINT "reading_histories_purchase_price_check" CHECK ("purchase_price" >= 0);
+
+ALTER TABLE "reading_histories"
+    ADD CONSTRAINT "reading_histories_purchase_currency_check" CHECK (("purchase_price" IS NULL) = ("purchase_currency" IS NULL));
+
+ALTER TABLE "reading_histories"
+    ADD CONSTRAINT "reading_histories_duration_minutes_check" CHECK ("duration_minutes" IS NULL OR ("duration_minutes" > 0 AND "format" = 'audiobook'));
+
+CREATE INDEX ON "reading_histories" ("user_id", "purchase_date");
+
+COMMENT
+ON COLUMN "reading_histories"."purchase_price" IS 'Price in the smallest unit of purchase_currency (e.g. yen, cents).';
+
+COMMENT
+ON COLUMN "reading_histories"."purchase_currency" IS 'ISO 4217 currency code.';
+
+COMMENT
+ON COLUMN "reading_histories"."duration_minutes" IS 'Length of the audiobook. Only audiobooks have a duration.';
\ No newline at end of file
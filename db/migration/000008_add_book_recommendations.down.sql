@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS recommendation_refreshes;
+
+DROP TABLE IF EXISTS book_recommendations;
+
+DROP TYPE IF EXISTS recommendation_reason;
\ No newline at end of file
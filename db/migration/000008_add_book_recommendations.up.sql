@@ -0,0 +1,48 @@
+CREATE TYPE "recommendation_reason" AS ENUM (
+  'author',
+  'genre'
+);
+
+CREATE TABLE "book_recommendations"
+(
+    "user_id"      bigint                NOT NULL,
+    "book_id"      bigint                NOT NULL,
+    "rank"         integer               NOT NULL,
+    "score"        bigint                NOT NULL,
+    "reason"       recommendation_reason NOT NULL,
+    "reason_name"  varchar(255)          NOT NULL,
+    "reason_count" bigint                NOT NULL,
+    "created_at"   timestamptz           NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("user_id", "book_id")
+);
+
+CREATE TABLE "recommendation_refreshes"
+(
+    "user_id"      bigint PRIMARY KEY,
+    "refreshed_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "book_recommendations" ("user_id", "rank");
+
+CREATE INDEX ON "recommendation_refreshes" ("refreshed_at");
+
+COMMENT
+ON TABLE "book_recommendations" IS 'Stores cached book recommendations per user. Rebuilt by the background job.';
+
+COMMENT
+ON COLUMN "book_recommendations"."reason_name" IS 'Author or genre name that explains the recommendation.';
+
+COMMENT
+ON COLUMN "book_recommendations"."reason_count" IS 'Number of finished books that share the author or genre.';
+
+COMMENT
+ON TABLE "recommendation_refreshes" IS 'Stores when the recommendations of each user were last rebuilt.';
+
+ALTER TABLE "book_recommendations"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "book_recommendations"
+    ADD FOREIGN KEY ("book_id") REFERENCES "books" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "recommendation_refreshes"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS feed_events;
+
+DROP TYPE IF EXISTS feed_event_type;
+
+DROP TABLE IF EXISTS follows;
+
+ALTER TABLE "users"
+    DROP COLUMN IF EXISTS "activity_visibility";
+
+DROP TYPE IF EXISTS visibility;
\ No newline at end of file
@@ -0,0 +1,59 @@
+CREATE TYPE "visibility" AS ENUM (
+  'private',
+  'followers',
+  'public'
+);
+
+ALTER TABLE "users"
+    ADD COLUMN "activity_visibility" visibility NOT NULL DEFAULT ('followers');
+
+CREATE TABLE "follows"
+(
+    "follower_id" bigint      NOT NULL,
+    "followee_id" bigint      NOT NULL,
+    "created_at"  timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("follower_id", "followee_id")
+);
+
+CREATE TYPE "feed_event_type" AS ENUM (
+  'added_book',
+  'started_reading',
+  'finished_reading'
+);
+
+CREATE TABLE "feed_events"
+(
+    "id"         bigserial PRIMARY KEY,
+    "user_id"    bigint          NOT NULL,
+    "book_id"    bigint          NOT NULL,
+    "event_type" feed_event_type NOT NULL,
+    "created_at" timestamptz     NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "follows" ("followee_id", "created_at");
+
+CREATE INDEX ON "feed_events" ("user_id", "id");
+
+ALTER TABLE "follows"
+    ADD CONSTRAINT "follows_self_check" CHECK ("follower_id" <> "followee_id");
+
+COMMENT
+ON COLUMN "users"."activity_visibility" IS 'Who can see the user''s activities in their feed.';
+
+COMMENT
+ON TABLE "follows" IS 'Stores follow relationships between users.';
+
+COMMENT
+ON TABLE "feed_events" IS 'Stores user activities shown in the feed of followers. Visibility is applied when reading.';
+
+ALTER TABLE "follows"
+    ADD FOREIGN KEY ("follower_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "follows"
+    ADD FOREIGN KEY ("followee_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "feed_events"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "feed_events"
+    ADD FOREIGN KEY ("book_id") REFERENCES "books" ("id") ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS shelf_visibilities;
+
+ALTER TABLE "users"
+    DROP COLUMN IF EXISTS "library_visibility";
+
+ALTER TABLE "users"
+    DROP COLUMN IF EXISTS "profile_visibility";
\ No newline at end of file
@@ -0,0 +1,26 @@
+ALTER TABLE "users"
+    ADD COLUMN "profile_visibility" visibility NOT NULL DEFAULT ('private');
+
+ALTER TABLE "users"
+    ADD COLUMN "library_visibility" visibility NOT NULL DEFAULT ('private');
+
+CREATE TABLE "shelf_visibilities"
+(
+    "user_id"    bigint         NOT NULL,
+    "status"     reading_status NOT NULL,
+    "visibility" visibility     NOT NULL,
+    "updated_at" timestamptz    NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("user_id", "status")
+);
+
+COMMENT
+ON COLUMN "users"."profile_visibility" IS 'Who can see the user''s profile.';
+
+COMMENT
+ON COLUMN "users"."library_visibility" IS 'Default visibility of the user''s shelves.';
+
+COMMENT
+ON TABLE "shelf_visibilities" IS 'Overrides the library visibility for a shelf. A shelf is the set of books with the same reading status.';
+
+ALTER TABLE "shelf_visibilities"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS club_posts;
+
+DROP TABLE IF EXISTS club_sections;
+
+DROP TABLE IF EXISTS club_invitations;
+
+DROP TABLE IF EXISTS club_members;
+
+DROP TABLE IF EXISTS book_clubs;
+
+DROP TYPE IF EXISTS club_role;
\ No newline at end of file
@@ -0,0 +1,116 @@
+CREATE TYPE "club_role" AS ENUM (
+  'owner',
+  'moderator',
+  'member'
+);
+
+CREATE TABLE "book_clubs"
+(
+    "id"              bigserial PRIMARY KEY,
+    "name"            varchar(255) NOT NULL,
+    "description"     text,
+    "current_book_id" bigint,
+    "created_at"      timestamptz  NOT NULL DEFAULT (now()),
+    "updated_at"      timestamptz  NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "club_members"
+(
+    "club_id"             bigint      NOT NULL,
+    "user_id"             bigint      NOT NULL,
+    "role"                club_role   NOT NULL,
+    "current_page"        integer     NOT NULL DEFAULT (0),
+    "progress_updated_at" timestamptz,
+    "joined_at"           timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("club_id", "user_id")
+);
+
+CREATE TABLE "club_invitations"
+(
+    "club_id"    bigint      NOT NULL,
+    "invitee_id" bigint      NOT NULL,
+    "inviter_id" bigint      NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("club_id", "invitee_id")
+);
+
+CREATE TABLE "club_sections"
+(
+    "id"          bigserial PRIMARY KEY,
+    "club_id"     bigint       NOT NULL,
+    "book_id"     bigint       NOT NULL,
+    "position"    integer      NOT NULL,
+    "title"       varchar(255) NOT NULL,
+    "chapter"     varchar(255),
+    "target_page" integer,
+    "due_date"    date         NOT NULL,
+    "created_at"  timestamptz  NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "club_posts"
+(
+    "id"         bigserial PRIMARY KEY,
+    "section_id" bigint      NOT NULL,
+    "user_id"    bigint      NOT NULL,
+    "body"       text        NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "club_members" ("club_id") WHERE "role" = 'owner';
+
+CREATE INDEX ON "club_members" ("user_id");
+
+CREATE UNIQUE INDEX ON "club_sections" ("club_id", "book_id", "position");
+
+CREATE INDEX ON "club_posts" ("section_id", "id");
+
+ALTER TABLE "club_members"
+    ADD CONSTRAINT "club_members_current_page_check" CHECK ("current_page" >= 0);
+
+ALTER TABLE "club_sections"
+    ADD CONSTRAINT "club_sections_target_check" CHECK ("chapter" IS NOT NULL OR "target_page" IS NOT NULL);
+
+COMMENT
+ON TABLE "book_clubs" IS 'Stores book clubs. Members read current_book_id together following club_sections.';
+
+COMMENT
+ON COLUMN "club_members"."current_page" IS 'Reading progress of the current book. Reset when the current book changes.';
+
+COMMENT
+ON TABLE "club_invitations" IS 'Stores pending invitations. An invitation is deleted when the invitee joins the club.';
+
+COMMENT
+ON TABLE "club_sections" IS 'Stores the reading schedule of a club. Each section is a chapter or page to reach by due_date.';
+
+COMMENT
+ON TABLE "club_posts" IS 'Stores discussion posts. Each section has its own thread.';
+
+ALTER TABLE "book_clubs"
+    ADD FOREIGN KEY ("current_book_id") REFERENCES "books" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "club_members"
+    ADD FOREIGN KEY ("club_id") REFERENCES "book_clubs" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_members"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_invitations"
+    ADD FOREIGN KEY ("club_id") REFERENCES "book_clubs" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_invitations"
+    ADD FOREIGN KEY ("invitee_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_invitations"
+    ADD FOREIGN KEY ("inviter_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_sections"
+    ADD FOREIGN KEY ("club_id") REFERENCES "book_clubs" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_sections"
+    ADD FOREIGN KEY ("book_id") REFERENCES "books" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_posts"
+    ADD FOREIGN KEY ("section_id") REFERENCES "club_sections" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "club_posts"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
\ No newline at end of file
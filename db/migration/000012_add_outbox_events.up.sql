@@ -0,0 +1,16 @@
+CREATE TABLE "outbox_events"
+(
+    "id"           bigserial PRIMARY KEY,
+    "event_type"   varchar(64) NOT NULL,
+    "payload"      jsonb       NOT NULL,
+    "created_at"   timestamptz NOT NULL DEFAULT (now()),
+    "published_at" timestamptz
+);
+
+CREATE INDEX ON "outbox_events" ("id") WHERE "published_at" IS NULL;
+
+COMMENT
+ON TABLE "outbox_events" IS 'Domain events written in the same transaction as the change. The relay publishes them in id order.';
+
+COMMENT
+ON COLUMN "outbox_events"."published_at" IS 'When the event was delivered to all sinks. NULL means it is waiting for the relay.';
\ No newline at end of file
@@ -0,0 +1,56 @@
+CREATE TYPE "webhook_delivery_status" AS ENUM (
+  'pending',
+  'succeeded',
+  'dead'
+);
+
+CREATE TABLE "webhook_endpoints"
+(
+    "id"          bigserial PRIMARY KEY,
+    "user_id"     bigint        NOT NULL,
+    "url"         varchar(2048) NOT NULL,
+    "secret"      varchar(64)   NOT NULL,
+    "event_types" varchar(64)[] NOT NULL,
+    "created_at"  timestamptz   NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "webhook_deliveries"
+(
+    "id"                   bigserial PRIMARY KEY,
+    "endpoint_id"          bigint                  NOT NULL,
+    "event_id"             bigint                  NOT NULL,
+    "event_type"           varchar(64)             NOT NULL,
+    "body"                 jsonb                   NOT NULL,
+    "status"               webhook_delivery_status NOT NULL DEFAULT ('pending'),
+    "attempts"             int                     NOT NULL DEFAULT 0,
+    "next_attempt_at"      timestamptz             NOT NULL DEFAULT (now()),
+    "last_response_status" int,
+    "last_error"           text,
+    "delivered_at"         timestamptz,
+    "created_at"           timestamptz             NOT NULL DEFAULT (now()),
+    "updated_at"           timestamptz             NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "webhook_endpoints" ("user_id");
+
+CREATE UNIQUE INDEX ON "webhook_deliveries" ("endpoint_id", "event_id");
+
+CREATE INDEX ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'pending';
+
+COMMENT
+ON TABLE "webhook_endpoints" IS 'Stores URLs registered by users to receive domain events.';
+
+COMMENT
+ON COLUMN "webhook_endpoints"."secret" IS 'Key used to sign the request body with HMAC-SHA256.';
+
+COMMENT
+ON TABLE "webhook_deliveries" IS 'Stores each event sent to a webhook endpoint and the result of the last attempt.';
+
+COMMENT
+ON COLUMN "webhook_deliveries"."next_attempt_at" IS 'When the next attempt is made. Also pushed forward while an attempt is in progress.';
+
+ALTER TABLE "webhook_endpoints"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "webhook_deliveries"
+    ADD FOREIGN KEY ("endpoint_id") REFERENCES "webhook_endpoints" ("id") ON DELETE CASCADE;
\ No newline at end of file
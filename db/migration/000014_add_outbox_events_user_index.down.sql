@@ -0,0 +1 @@
+DROP INDEX IF EXISTS outbox_events_user_id_idx;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE INDEX "outbox_events_user_id_idx" ON "outbox_events" ((("payload" ->> 'user_id')::bigint), "id") WHERE "published_at" IS NOT NULL;
\ No newline at end of file
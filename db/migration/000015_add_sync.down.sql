@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS sync_tombstones;
+
+DROP TABLE IF EXISTS book_notes;
+
+DROP TABLE IF EXISTS book_tags;
+
+DROP INDEX IF EXISTS reading_histories_user_id_updated_at_idx;
\ No newline at end of file
@@ -0,0 +1,62 @@
+CREATE TABLE "book_tags"
+(
+    "user_id"    bigint      NOT NULL,
+    "book_id"    bigint      NOT NULL,
+    "name"       varchar(50) NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz NOT NULL DEFAULT (now()),
+    PRIMARY KEY ("user_id", "book_id", "name")
+);
+
+CREATE TABLE "book_notes"
+(
+    "id"         uuid PRIMARY KEY,
+    "user_id"    bigint        NOT NULL,
+    "book_id"    bigint        NOT NULL,
+    "body"       varchar(2000) NOT NULL,
+    "created_at" timestamptz   NOT NULL DEFAULT (now()),
+    "updated_at" timestamptz   NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "sync_tombstones"
+(
+    "id"          bigserial PRIMARY KEY,
+    "user_id"     bigint       NOT NULL,
+    "entity_type" varchar(16)  NOT NULL,
+    "entity_key"  varchar(100) NOT NULL,
+    "deleted_at"  timestamptz  NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "reading_histories" ("user_id", "updated_at");
+
+CREATE INDEX ON "book_tags" ("user_id", "updated_at");
+
+CREATE INDEX ON "book_notes" ("user_id", "updated_at");
+
+CREATE UNIQUE INDEX ON "sync_tombstones" ("user_id", "entity_type", "entity_key");
+
+CREATE INDEX ON "sync_tombstones" ("user_id", "deleted_at");
+
+COMMENT
+ON TABLE "book_tags" IS 'Stores tags users attach to books in their library.';
+
+COMMENT
+ON TABLE "book_notes" IS 'Stores notes users write about books in their library.';
+
+COMMENT
+ON COLUMN "book_notes"."id" IS 'Generated by the client so that notes can be created offline.';
+
+COMMENT
+ON TABLE "sync_tombstones" IS 'Records deleted books, tags and notes so that clients can remove them during sync.';
+
+COMMENT
+ON COLUMN "sync_tombstones"."entity_key" IS 'Book id for books, "<book_id>/<name>" for tags and the note id for notes.';
+
+ALTER TABLE "book_tags"
+    ADD FOREIGN KEY ("user_id", "book_id") REFERENCES "reading_histories" ("user_id", "book_id") ON DELETE CASCADE;
+
+ALTER TABLE "book_notes"
+    ADD FOREIGN KEY ("user_id", "book_id") REFERENCES "reading_histories" ("user_id", "book_id") ON DELETE CASCADE;
+
+ALTER TABLE "sync_tombstones"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
\ No newline at end of file
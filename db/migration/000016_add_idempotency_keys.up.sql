@@ -0,0 +1,25 @@
+CREATE TABLE "idempotency_keys"
+(
+    "user_id"      bigint       NOT NULL,
+    "key"          varchar(255) NOT NULL,
+    "method"       varchar(100) NOT NULL,
+    "request_hash" varchar(64)  NOT NULL,
+    "response"     bytea,
+    "created_at"   timestamptz  NOT NULL DEFAULT (now()),
+    "expires_at"   timestamptz  NOT NULL,
+    PRIMARY KEY ("user_id", "key")
+);
+
+CREATE INDEX ON "idempotency_keys" ("user_id", "expires_at");
+
+COMMENT
+ON TABLE "idempotency_keys" IS 'Stores responses of mutating requests so that retried requests with the same Idempotency-Key are not executed twice.';
+
+COMMENT
+ON COLUMN "idempotency_keys"."request_hash" IS 'SHA-256 of the request payload, used to reject a reused key with a different payload.';
+
+COMMENT
+ON COLUMN "idempotency_keys"."response" IS 'Serialized response. NULL while the first request is still being processed.';
+
+ALTER TABLE "idempotency_keys"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE "reading_histories"
+    DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "books"
+    DROP COLUMN IF EXISTS "version";
\ No newline at end of file
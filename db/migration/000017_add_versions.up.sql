@@ -0,0 +1,11 @@
+ALTER TABLE "books"
+    ADD COLUMN "version" bigint NOT NULL DEFAULT (1);
+
+ALTER TABLE "reading_histories"
+    ADD COLUMN "version" bigint NOT NULL DEFAULT (1);
+
+COMMENT
+ON COLUMN "books"."version" IS 'Incremented on every update. Used for optimistic concurrency control.';
+
+COMMENT
+ON COLUMN "reading_histories"."version" IS 'Incremented on every update. Exposed to clients as an etag.';
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TYPE "user_role" AS ENUM (
+  'user',
+  'librarian',
+  'admin'
+);
+
+ALTER TABLE "users"
+    ADD COLUMN "role" user_role NOT NULL DEFAULT ('user');
+
+COMMENT
+ON COLUMN "users"."role" IS 'librarian can edit the shared catalog. admin can also manage users.';
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS audit_logs;
+
+ALTER TABLE "users"
+    DROP COLUMN IF EXISTS "disabled_at";
\ No newline at end of file
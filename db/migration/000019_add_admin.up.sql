@@ -0,0 +1,29 @@
+ALTER TABLE "users"
+    ADD COLUMN "disabled_at" timestamptz;
+
+CREATE TABLE "audit_logs"
+(
+    "id"          bigserial PRIMARY KEY,
+    "actor_id"    bigint      NOT NULL,
+    "action"      varchar(64) NOT NULL,
+    "target_type" varchar(64) NOT NULL,
+    "target_id"   bigint,
+    "detail"      jsonb       NOT NULL DEFAULT ('{}'),
+    "created_at"  timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "audit_logs" ("created_at");
+
+CREATE INDEX ON "audit_logs" ("target_type", "target_id");
+
+COMMENT
+ON COLUMN "users"."disabled_at" IS 'When an admin disabled the account. NULL means the user can sign in.';
+
+COMMENT
+ON TABLE "audit_logs" IS 'Stores actions taken by admins.';
+
+COMMENT
+ON COLUMN "audit_logs"."actor_id" IS 'Not a foreign key so that the logs remain after the admin is deleted.';
+
+COMMENT
+ON COLUMN "audit_logs"."target_id" IS 'ID of the user or book identified by target_type. NULL when the action has no single target, such as searching users.';
\ No newline at end of file
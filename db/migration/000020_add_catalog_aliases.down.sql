@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS author_aliases;
+
+DROP TABLE IF EXISTS publisher_aliases;
+
+DROP TABLE IF EXISTS genre_aliases;
+
+DROP FUNCTION IF EXISTS normalize_catalog_name;
\ No newline at end of file
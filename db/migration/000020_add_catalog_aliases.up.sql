@@ -0,0 +1,52 @@
+CREATE TABLE "author_aliases"
+(
+    "alias"          varchar(255) PRIMARY KEY,
+    "canonical_name" varchar(255) NOT NULL,
+    "created_at"     timestamptz  NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "publisher_aliases"
+(
+    "alias"          varchar(255) PRIMARY KEY,
+    "canonical_name" varchar(255) NOT NULL,
+    "created_at"     timestamptz  NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "genre_aliases"
+(
+    "alias"          varchar(255) PRIMARY KEY,
+    "canonical_name" varchar(255) NOT NULL,
+    "created_at"     timestamptz  NOT NULL DEFAULT (now())
+);
+
+CREATE FUNCTION normalize_catalog_name(name varchar) RETURNS varchar AS
+$$
+SELECT lower(regexp_replace(normalize(name, NFKC), '\s', '', 'g'))
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE INDEX ON "author_aliases" ("canonical_name");
+
+CREATE INDEX ON "publisher_aliases" ("canonical_name");
+
+CREATE INDEX ON "genre_aliases" ("canonical_name");
+
+COMMENT
+ON FUNCTION normalize_catalog_name IS 'Folds width, case and whitespace so that variant spellings such as "村上春樹" and "村上 春樹" compare equal.';
+
+COMMENT
+ON TABLE "author_aliases" IS 'Stores names of authors merged into another author. New registrations with an alias resolve to the canonical name.';
+
+COMMENT
+ON TABLE "publisher_aliases" IS 'Stores names of publishers merged into another publisher. New registrations with an alias resolve to the canonical name.';
+
+COMMENT
+ON TABLE "genre_aliases" IS 'Stores names of genres merged into another genre. New registrations with an alias resolve to the canonical name.';
+
+ALTER TABLE "author_aliases"
+    ADD FOREIGN KEY ("canonical_name") REFERENCES "authors" ("name") ON DELETE CASCADE;
+
+ALTER TABLE "publisher_aliases"
+    ADD FOREIGN KEY ("canonical_name") REFERENCES "publishers" ("name") ON DELETE CASCADE;
+
+ALTER TABLE "genre_aliases"
+    ADD FOREIGN KEY ("canonical_name") REFERENCES "genres" ("name") ON DELETE CASCADE;
\ No newline at end of file
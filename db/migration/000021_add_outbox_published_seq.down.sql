@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS outbox_events_user_id_idx;
+
+CREATE INDEX "outbox_events_user_id_idx" ON "outbox_events" ((("payload" ->> 'user_id')::bigint), "id") WHERE "published_at" IS NOT NULL;
+
+ALTER TABLE "outbox_events"
+    DROP COLUMN IF EXISTS "published_seq";
+
+DROP SEQUENCE IF EXISTS "outbox_events_published_seq";
\ No newline at end of file
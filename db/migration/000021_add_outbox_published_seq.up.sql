@@ -0,0 +1,21 @@
+CREATE SEQUENCE "outbox_events_published_seq";
+
+ALTER TABLE "outbox_events"
+    ADD COLUMN "published_seq" bigint UNIQUE;
+
+UPDATE "outbox_events" o
+SET "published_seq" = p.seq
+FROM (SELECT "id", row_number() OVER (ORDER BY "id") AS seq
+      FROM "outbox_events"
+      WHERE "published_at" IS NOT NULL) p
+WHERE o."id" = p."id";
+
+SELECT setval('outbox_events_published_seq', COALESCE(MAX("published_seq"), 0) + 1, false)
+FROM "outbox_events";
+
+DROP INDEX IF EXISTS outbox_events_user_id_idx;
+
+CREATE INDEX "outbox_events_user_id_idx" ON "outbox_events" ((("payload" ->> 'user_id')::bigint), "published_seq") WHERE "published_seq" IS NOT NULL;
+
+COMMENT
+ON COLUMN "outbox_events"."published_seq" IS 'Order in which the relay published the event. ids are assigned at insert time and may commit out of order, so subscribers resume from this instead.';
\ No newline at end of file
@@ -0,0 +1,19 @@
+-- name: CreateAuditLog :one
+INSERT INTO audit_logs (actor_id,
+                        action,
+                        target_type,
+                        target_id,
+                        detail)
+VALUES ($1,
+        $2,
+        $3,
+        $4,
+        $5) RETURNING *;
+
+-- name: GetAuditLogs :many
+SELECT *
+FROM audit_logs
+WHERE (sqlc.narg(target_type)::varchar IS NULL OR target_type = sqlc.narg(target_type)::varchar)
+  AND (sqlc.narg(target_id)::bigint IS NULL OR target_id = sqlc.narg(target_id)::bigint)
+ORDER BY id DESC LIMIT sqlc.arg(page_size)
+OFFSET sqlc.arg(page_offset);
\ No newline at end of file
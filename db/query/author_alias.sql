@@ -0,0 +1,13 @@
+-- name: GetCanonicalAuthorName :one
+SELECT canonical_name
+FROM author_aliases
+WHERE alias = $1;
+
+-- name: CreateAuthorAlias :one
+INSERT INTO author_aliases (alias, canonical_name)
+VALUES ($1, $2) ON CONFLICT (alias) DO UPDATE SET canonical_name = EXCLUDED.canonical_name RETURNING *;
+
+-- name: UpdateAuthorAliasesCanonicalName :execrows
+UPDATE author_aliases
+SET canonical_name = sqlc.arg(new_name)
+WHERE canonical_name = sqlc.arg(old_name);
\ No newline at end of file
@@ -9,6 +9,8 @@ SELECT b.id,
        b.publisher_name,
        b.published_date,
        b.isbn,
+       b.page_count,
+       b.version,
        b.created_at,
        b.updated_at
 FROM books b
@@ -106,8 +108,9 @@ INTO books (title,
             author_name,
             publisher_name,
             published_date,
-            isbn)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
+            isbn,
+            page_count)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING *;
 
 -- name: UpdateBook :one
 UPDATE books
@@ -119,10 +122,27 @@ SET title           = $2,
     publisher_name  = $7,
     published_date  = $8,
     isbn            = $9,
+    page_count      = $10,
+    version         = version + 1,
     updated_at      = now()
-WHERE id = $1 RETURNING *;
+WHERE id = $1
+  AND (sqlc.narg(expected_version)::bigint IS NULL OR version = sqlc.narg(expected_version)::bigint) RETURNING *;
 
 -- name: DeleteBook :execrows
 DELETE
 FROM books
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: UpdateBooksAuthorName :execrows
+UPDATE books
+SET author_name = sqlc.arg(new_name),
+    version     = version + 1,
+    updated_at  = now()
+WHERE author_name = sqlc.arg(old_name);
+
+-- name: UpdateBooksPublisherName :execrows
+UPDATE books
+SET publisher_name = sqlc.arg(new_name),
+    version        = version + 1,
+    updated_at     = now()
+WHERE publisher_name = sqlc.arg(old_name);
\ No newline at end of file
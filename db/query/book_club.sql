@@ -0,0 +1,14 @@
+-- name: CreateBookClub :one
+INSERT INTO book_clubs (name, description)
+VALUES ($1, $2) RETURNING *;
+
+-- name: GetBookClubByID :one
+SELECT *
+FROM book_clubs
+WHERE id = $1;
+
+-- name: UpdateBookClubCurrentBook :one
+UPDATE book_clubs
+SET current_book_id = $2,
+    updated_at      = now()
+WHERE id = $1 RETURNING *;
\ No newline at end of file
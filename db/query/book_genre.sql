@@ -11,4 +11,15 @@ WHERE book_id = $1;
 DELETE
 FROM book_genres
 WHERE book_id = $1
-  AND genre_name = $2;
\ No newline at end of file
+  AND genre_name = $2;
+
+-- name: CopyBookGenres :execrows
+INSERT INTO book_genres (book_id, genre_name)
+SELECT book_id, sqlc.arg(new_name)::varchar
+FROM book_genres
+WHERE genre_name = sqlc.arg(old_name) ON CONFLICT DO NOTHING;
+
+-- name: DeleteBookGenresByGenre :execrows
+DELETE
+FROM book_genres
+WHERE genre_name = $1;
\ No newline at end of file
@@ -0,0 +1,25 @@
+-- name: DeleteBookNote :execrows
+DELETE
+FROM book_notes
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: GetBookNote :one
+SELECT *
+FROM book_notes
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: GetBookNotesUpdatedSince :many
+SELECT *
+FROM book_notes
+WHERE user_id = $1
+  AND updated_at > $2
+ORDER BY updated_at, id;
+
+-- name: UpsertBookNote :one
+INSERT INTO book_notes (id, user_id, book_id, body)
+VALUES ($1, $2, $3, $4) ON CONFLICT (id) DO
+UPDATE SET body = EXCLUDED.body, updated_at = now()
+WHERE book_notes.user_id = EXCLUDED.user_id
+  AND book_notes.book_id = EXCLUDED.book_id RETURNING *;
\ No newline at end of file
@@ -0,0 +1,25 @@
+-- name: DeleteBookTag :execrows
+DELETE
+FROM book_tags
+WHERE user_id = $1
+  AND book_id = $2
+  AND name = $3;
+
+-- name: GetBookTag :one
+SELECT *
+FROM book_tags
+WHERE user_id = $1
+  AND book_id = $2
+  AND name = $3;
+
+-- name: GetBookTagsUpdatedSince :many
+SELECT *
+FROM book_tags
+WHERE user_id = $1
+  AND updated_at > $2
+ORDER BY updated_at, book_id, name;
+
+-- name: UpsertBookTag :one
+INSERT INTO book_tags (user_id, book_id, name)
+VALUES ($1, $2, $3) ON CONFLICT (user_id, book_id, name) DO
+UPDATE SET updated_at = now() RETURNING *;
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- name: CreateClubInvitation :one
+INSERT INTO club_invitations (club_id, invitee_id, inviter_id)
+VALUES ($1, $2, $3) RETURNING *;
+
+-- name: DeleteClubInvitation :execrows
+DELETE
+FROM club_invitations
+WHERE club_id = $1
+  AND invitee_id = $2;
\ No newline at end of file
@@ -0,0 +1,46 @@
+-- name: CreateClubMember :one
+INSERT INTO club_members (club_id, user_id, role)
+VALUES ($1, $2, $3) RETURNING *;
+
+-- name: DeleteClubMember :execrows
+DELETE
+FROM club_members
+WHERE club_id = $1
+  AND user_id = $2;
+
+-- name: GetClubMember :one
+SELECT *
+FROM club_members
+WHERE club_id = $1
+  AND user_id = $2;
+
+-- name: GetClubMembers :many
+SELECT cm.user_id,
+       u.name,
+       cm.role,
+       cm.current_page,
+       cm.progress_updated_at,
+       cm.joined_at
+FROM club_members cm
+         JOIN users u ON u.id = cm.user_id
+WHERE cm.club_id = $1
+ORDER BY cm.role, cm.joined_at, cm.user_id;
+
+-- name: ResetClubProgress :exec
+UPDATE club_members
+SET current_page        = 0,
+    progress_updated_at = NULL
+WHERE club_id = $1;
+
+-- name: UpdateClubMemberProgress :one
+UPDATE club_members
+SET current_page        = $3,
+    progress_updated_at = now()
+WHERE club_id = $1
+  AND user_id = $2 RETURNING *;
+
+-- name: UpdateClubMemberRole :one
+UPDATE club_members
+SET role = $3
+WHERE club_id = $1
+  AND user_id = $2 RETURNING *;
\ No newline at end of file
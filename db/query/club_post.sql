@@ -0,0 +1,15 @@
+-- name: CreateClubPost :one
+INSERT INTO club_posts (section_id, user_id, body)
+VALUES ($1, $2, $3) RETURNING *;
+
+-- name: GetClubPosts :many
+SELECT p.id,
+       p.user_id,
+       u.name AS user_name,
+       p.body,
+       p.created_at
+FROM club_posts p
+         JOIN users u ON u.id = p.user_id
+WHERE p.section_id = $1
+ORDER BY p.id LIMIT $2
+OFFSET $3;
\ No newline at end of file
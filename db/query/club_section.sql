@@ -0,0 +1,27 @@
+-- name: DeleteClubSectionsAfter :exec
+DELETE
+FROM club_sections
+WHERE club_id = $1
+  AND book_id = $2
+  AND position > $3;
+
+-- name: GetClubSectionByID :one
+SELECT *
+FROM club_sections
+WHERE id = $1;
+
+-- name: GetClubSections :many
+SELECT *
+FROM club_sections
+WHERE club_id = $1
+  AND book_id = $2
+ORDER BY position;
+
+-- name: UpsertClubSection :one
+INSERT INTO club_sections (club_id, book_id, position, title, chapter, target_page, due_date)
+VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (club_id, book_id, position) DO
+UPDATE
+SET title       = EXCLUDED.title,
+    chapter     = EXCLUDED.chapter,
+    target_page = EXCLUDED.target_page,
+    due_date    = EXCLUDED.due_date RETURNING *;
\ No newline at end of file
@@ -0,0 +1,22 @@
+-- name: CreateFeedEvent :one
+INSERT INTO feed_events (user_id, book_id, event_type)
+VALUES ($1, $2, $3) RETURNING *;
+
+-- name: GetFeed :many
+SELECT fe.id,
+       fe.event_type,
+       fe.created_at,
+       u.id   AS user_id,
+       u.name AS user_name,
+       b.id   AS book_id,
+       b.title,
+       b.author_name,
+       b.cover_image_url
+FROM feed_events fe
+         JOIN follows f ON f.followee_id = fe.user_id
+         JOIN users u ON u.id = fe.user_id
+         JOIN books b ON b.id = fe.book_id
+WHERE f.follower_id = sqlc.arg(user_id)
+  AND u.activity_visibility <> 'private'
+  AND (sqlc.narg(before_id)::bigint IS NULL OR fe.id < sqlc.narg(before_id)::bigint)
+ORDER BY fe.id DESC LIMIT sqlc.arg(page_size);
\ No newline at end of file
@@ -0,0 +1,29 @@
+-- name: CreateFollow :one
+INSERT INTO follows (follower_id, followee_id)
+VALUES ($1, $2) RETURNING *;
+
+-- name: DeleteFollow :execrows
+DELETE
+FROM follows
+WHERE follower_id = $1
+  AND followee_id = $2;
+
+-- name: GetFollowers :many
+SELECT u.id,
+       u.name,
+       f.created_at AS followed_at
+FROM follows f
+         JOIN users u ON u.id = f.follower_id
+WHERE f.followee_id = $1
+ORDER BY f.created_at DESC, u.id DESC LIMIT $2
+OFFSET $3;
+
+-- name: GetFollowing :many
+SELECT u.id,
+       u.name,
+       f.created_at AS followed_at
+FROM follows f
+         JOIN users u ON u.id = f.followee_id
+WHERE f.follower_id = $1
+ORDER BY f.created_at DESC, u.id DESC LIMIT $2
+OFFSET $3;
\ No newline at end of file
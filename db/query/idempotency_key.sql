@@ -0,0 +1,28 @@
+-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (user_id, key, method, request_hash, expires_at)
+VALUES ($1, $2, $3, $4, $5) ON CONFLICT (user_id, key) DO NOTHING
+RETURNING *;
+
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE
+FROM idempotency_keys
+WHERE user_id = $1
+  AND expires_at <= now();
+
+-- name: DeleteIdempotencyKey :exec
+DELETE
+FROM idempotency_keys
+WHERE user_id = $1
+  AND key = $2;
+
+-- name: GetIdempotencyKey :one
+SELECT *
+FROM idempotency_keys
+WHERE user_id = $1
+  AND key = $2;
+
+-- name: UpdateIdempotencyKeyResponse :exec
+UPDATE idempotency_keys
+SET response = $3
+WHERE user_id = $1
+  AND key = $2;
\ No newline at end of file
@@ -0,0 +1,135 @@
+-- name: CreateLoan :one
+INSERT INTO loans (user_id, book_id, borrower_name, borrower_user_id, loan_date, due_date)
+VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
+
+-- name: GetActiveLoanByBook :one
+SELECT *
+FROM loans
+WHERE user_id = $1
+  AND book_id = $2
+  AND return_date IS NULL;
+
+-- name: GetLoanByID :one
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT l.id,
+       l.borrower_name,
+       l.borrower_user_id,
+       l.loan_date,
+       l.due_date,
+       l.return_date,
+       COALESCE(l.return_date IS NULL AND l.due_date < (now() AT TIME ZONE u.timezone)::date, false)::boolean AS overdue,
+       b.id                                                                                                   AS book_id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned
+FROM loans l
+         JOIN users u ON u.id = l.user_id
+         JOIN reading_histories rh ON rh.user_id = l.user_id AND rh.book_id = l.book_id
+         JOIN books b ON b.id = l.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE l.id = $1
+  AND l.user_id = $2;
+
+-- name: GetActiveLoans :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT l.id,
+       l.borrower_name,
+       l.borrower_user_id,
+       l.loan_date,
+       l.due_date,
+       l.return_date,
+       COALESCE(l.due_date < (now() AT TIME ZONE u.timezone)::date, false)::boolean AS overdue,
+       b.id                                                                         AS book_id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned
+FROM loans l
+         JOIN users u ON u.id = l.user_id
+         JOIN reading_histories rh ON rh.user_id = l.user_id AND rh.book_id = l.book_id
+         JOIN books b ON b.id = l.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE l.user_id = $1
+  AND l.return_date IS NULL
+ORDER BY l.loan_date, l.id;
+
+-- name: GetOverdueLoans :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT l.id,
+       l.borrower_name,
+       l.borrower_user_id,
+       l.loan_date,
+       l.due_date,
+       l.return_date,
+       true::boolean AS overdue,
+       b.id          AS book_id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned
+FROM loans l
+         JOIN users u ON u.id = l.user_id
+         JOIN reading_histories rh ON rh.user_id = l.user_id AND rh.book_id = l.book_id
+         JOIN books b ON b.id = l.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE l.user_id = $1
+  AND l.return_date IS NULL
+  AND l.due_date < (now() AT TIME ZONE u.timezone)::date
+ORDER BY l.due_date, l.id;
+
+-- name: ReturnLoan :one
+UPDATE loans
+SET return_date = $3,
+    updated_at  = now()
+WHERE id = $1
+  AND user_id = $2
+  AND return_date IS NULL RETURNING *;
\ No newline at end of file
@@ -0,0 +1,33 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox_events (event_type, payload)
+VALUES ($1, $2) RETURNING *;
+
+-- name: GetLatestOutboxPublishedSeq :one
+SELECT COALESCE(MAX(published_seq), 0)::bigint
+FROM outbox_events
+WHERE published_seq IS NOT NULL;
+
+-- name: GetNextOutboxPublishedSeq :one
+SELECT nextval('outbox_events_published_seq')::bigint;
+
+-- name: GetPublishedOutboxEventsByUser :many
+SELECT *
+FROM outbox_events
+WHERE published_seq IS NOT NULL
+  AND (payload ->> 'user_id')::bigint = sqlc.arg(user_id)::bigint
+  AND published_seq > sqlc.arg(after_seq)::bigint
+  AND event_type = ANY (sqlc.arg(event_types)::varchar[])
+ORDER BY published_seq LIMIT sqlc.arg(page_size);
+
+-- name: GetUnpublishedOutboxEvents :many
+SELECT *
+FROM outbox_events
+WHERE published_at IS NULL
+ORDER BY id LIMIT $1
+FOR UPDATE;
+
+-- name: MarkOutboxEventPublished :exec
+UPDATE outbox_events
+SET published_at  = now(),
+    published_seq = sqlc.arg(published_seq)
+WHERE id = sqlc.arg(id);
\ No newline at end of file
@@ -10,9 +10,17 @@ WHERE name = $1;
 
 -- name: CreatePublisher :one
 INSERT INTO publishers (name)
-VALUES ($1) RETURNING *;
+VALUES ($1) ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING *;
 
 -- name: DeletePublisher :exec
 DELETE
 FROM publishers
-WHERE name = $1;
\ No newline at end of file
+WHERE name = $1;
+
+-- name: GetDuplicatePublishers :many
+SELECT normalize_catalog_name(name)::varchar   AS normalized_name,
+       array_agg(name ORDER BY name)::varchar[] AS names
+FROM publishers
+GROUP BY normalized_name
+HAVING count(*) > 1
+ORDER BY normalized_name;
\ No newline at end of file
@@ -0,0 +1,18 @@
+-- name: CreateReadingActivity :one
+INSERT INTO reading_activities (user_id, book_id, activity_type, occurred_at)
+VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: GetActivityDates :many
+SELECT DISTINCT (occurred_at AT TIME ZONE sqlc.arg(timezone)::text)::date AS activity_date
+FROM reading_activities
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY activity_date DESC;
+
+-- name: GetDailyActivityCounts :many
+SELECT (occurred_at AT TIME ZONE sqlc.arg(timezone)::text)::date AS activity_date,
+       COUNT(*)                                                   AS activity_count
+FROM reading_activities
+WHERE user_id = sqlc.arg(user_id)
+  AND (occurred_at AT TIME ZONE sqlc.arg(timezone)::text)::date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+GROUP BY activity_date
+ORDER BY activity_date;
\ No newline at end of file
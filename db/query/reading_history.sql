@@ -1,6 +1,7 @@
 -- name: CreateReadingHistory :one
-INSERT INTO reading_histories (user_id, book_id, status, start_date, end_date)
-VALUES ($1, $2, $3, $4, $5) RETURNING *;
+INSERT INTO reading_histories (user_id, book_id, status, start_date, end_date, priority, queue_position, owned, format,
+                               purchase_price, purchase_currency, purchase_store, purchase_date, duration_minutes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING *;
 
 -- name: GetReadingHistoryByUser :many
 WITH genre_aggregation AS (SELECT bg.book_id,
@@ -21,7 +22,26 @@ SELECT b.id,
        b.isbn,
        rh.status,
        rh.start_date,
-       rh.end_date
+       rh.end_date,
+       b.page_count,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.format,
+       rh.purchase_price,
+       rh.purchase_currency,
+       rh.purchase_store,
+       rh.purchase_date,
+       rh.duration_minutes,
+       rh.version,
+       b.version AS book_version,
+       EXISTS (SELECT 1
+               FROM loans l
+                        JOIN users u ON u.id = l.user_id
+               WHERE l.user_id = rh.user_id
+                 AND l.book_id = rh.book_id
+                 AND l.return_date IS NULL
+                 AND l.due_date < (now() AT TIME ZONE u.timezone)::date) AS overdue
 FROM reading_histories rh
          LEFT JOIN books b ON b.id = rh.book_id
          LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
@@ -48,7 +68,8 @@ SELECT b.id,
        b.isbn,
        rh.status,
        rh.start_date,
-       rh.end_date
+       rh.end_date,
+       rh.owned
 FROM reading_histories rh
          LEFT JOIN books b ON b.id = rh.book_id
          LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
@@ -83,17 +104,203 @@ WHERE rh.user_id = $1
 ORDER BY rh.created_at LIMIT $3
 OFFSET $4;
 
+-- name: GetPublicReadingHistoryByUser :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       b.page_count,
+       rh.status,
+       rh.start_date,
+       rh.end_date
+FROM reading_histories rh
+         JOIN users u ON u.id = rh.user_id
+         LEFT JOIN shelf_visibilities sv ON sv.user_id = rh.user_id AND sv.status = rh.status
+         LEFT JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = $1
+  AND COALESCE(sv.visibility, u.library_visibility) = 'public'
+ORDER BY rh.updated_at DESC, b.id DESC LIMIT $2
+OFFSET $3;
+
+-- name: GetReadingQueue :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.version,
+       EXISTS (SELECT 1
+               FROM loans l
+                        JOIN users u ON u.id = l.user_id
+               WHERE l.user_id = rh.user_id
+                 AND l.book_id = rh.book_id
+                 AND l.return_date IS NULL
+                 AND l.due_date < (now() AT TIME ZONE u.timezone)::date) AS overdue
+FROM reading_histories rh
+         LEFT JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = $1
+  AND rh.status = 'unread'
+  AND rh.queue_position IS NOT NULL
+ORDER BY rh.queue_position, rh.priority DESC, rh.created_at;
+
+-- name: GetReadingHistoriesUpdatedSince :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       b.page_count,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.format,
+       rh.purchase_price,
+       rh.purchase_currency,
+       rh.purchase_store,
+       rh.purchase_date,
+       rh.duration_minutes,
+       GREATEST(rh.updated_at, b.updated_at)::timestamptz AS updated_at
+FROM reading_histories rh
+         JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND GREATEST(rh.updated_at, b.updated_at) > sqlc.arg(since)::timestamptz
+ORDER BY updated_at, b.id;
+
+-- name: GetReadingHistoryForSync :one
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       b.page_count,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.format,
+       rh.purchase_price,
+       rh.purchase_currency,
+       rh.purchase_store,
+       rh.purchase_date,
+       rh.duration_minutes,
+       GREATEST(rh.updated_at, b.updated_at)::timestamptz AS updated_at
+FROM reading_histories rh
+         JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = $1
+  AND rh.book_id = $2;
+
+-- name: CountReadingHistoriesByBook :one
+SELECT COUNT(*)
+FROM reading_histories
+WHERE book_id = $1;
+
+-- name: GetNextQueuePosition :one
+SELECT (COALESCE(MAX(queue_position), 0) + 1)::integer AS next_position
+FROM reading_histories
+WHERE user_id = $1;
+
 -- name: UpdateReadingHistory :one
 UPDATE reading_histories
-SET status     = $3,
-    start_date = $4,
-    end_date   = $5,
-    updated_at = now()
+SET status         = $3,
+    start_date     = $4,
+    end_date       = $5,
+    queue_position = CASE
+                         WHEN $3 <> 'unread'::reading_status THEN NULL
+                         WHEN queue_position IS NOT NULL THEN queue_position
+                         ELSE (SELECT COALESCE(MAX(rh.queue_position), 0) + 1
+                               FROM reading_histories rh
+                               WHERE rh.user_id = $1) END,
+    version        = version + 1,
+    updated_at     = now()
 WHERE user_id = $1
   AND book_id = $2 RETURNING *;
 
+-- name: UpdateQueuePosition :execrows
+UPDATE reading_histories
+SET queue_position = $3,
+    version        = version + 1,
+    updated_at     = now()
+WHERE user_id = $1
+  AND book_id = $2
+  AND status = 'unread';
+
+-- name: UpdateWishlistEntry :one
+UPDATE reading_histories
+SET priority   = $3,
+    owned      = $4,
+    version    = version + 1,
+    updated_at = now()
+WHERE user_id = $1
+  AND book_id = $2
+  AND (sqlc.narg(expected_version)::bigint IS NULL OR version = sqlc.narg(expected_version)::bigint) RETURNING *;
+
 -- name: DeleteReadingHistory :execrows
 DELETE
 FROM reading_histories
 WHERE user_id = $1
-  AND book_id = $2;
\ No newline at end of file
+  AND book_id = $2;
+
+-- name: DeleteReadingHistoriesByBook :many
+DELETE
+FROM reading_histories
+WHERE book_id = $1 RETURNING user_id;
\ No newline at end of file
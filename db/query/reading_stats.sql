@@ -0,0 +1,93 @@
+-- name: GetFinishedBookCountsByMonth :many
+SELECT DATE_TRUNC('month', rh.end_date)::date AS month,
+       COUNT(*)                               AS book_count
+FROM reading_histories rh
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.status = 'done'
+  AND rh.end_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+GROUP BY month
+ORDER BY month;
+
+-- name: GetFinishedGenreCounts :many
+SELECT bg.genre_name,
+       COUNT(*) AS book_count
+FROM reading_histories rh
+         JOIN book_genres bg ON rh.book_id = bg.book_id
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.status = 'done'
+  AND rh.end_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+GROUP BY bg.genre_name
+ORDER BY book_count DESC, bg.genre_name;
+
+-- name: GetFinishedAuthorCounts :many
+SELECT b.author_name,
+       COUNT(*) AS book_count
+FROM reading_histories rh
+         JOIN books b ON rh.book_id = b.id
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.status = 'done'
+  AND rh.end_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+  AND b.author_name IS NOT NULL
+GROUP BY b.author_name
+ORDER BY book_count DESC, b.author_name LIMIT sqlc.arg(top_limit);
+
+-- name: GetFinishedPublisherCounts :many
+SELECT b.publisher_name,
+       COUNT(*) AS book_count
+FROM reading_histories rh
+         JOIN books b ON rh.book_id = b.id
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.status = 'done'
+  AND rh.end_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+  AND b.publisher_name IS NOT NULL
+GROUP BY b.publisher_name
+ORDER BY book_count DESC, b.publisher_name LIMIT sqlc.arg(top_limit);
+
+-- name: GetAverageReadingDays :one
+SELECT COUNT(*)                                               AS book_count,
+       COALESCE(AVG(rh.end_date - rh.start_date), 0)::float8 AS average_days
+FROM reading_histories rh
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.status = 'done'
+  AND rh.start_date IS NOT NULL
+  AND rh.end_date >= rh.start_date
+  AND rh.end_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date;
+
+-- name: GetFinishedBooks :many
+SELECT b.id,
+       b.title,
+       b.author_name,
+       b.cover_image_url,
+       b.page_count,
+       rh.start_date,
+       rh.end_date
+FROM reading_histories rh
+         JOIN books b ON rh.book_id = b.id
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.status = 'done'
+  AND rh.end_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+ORDER BY rh.end_date, b.id;
+
+-- name: GetMonthlySpending :many
+SELECT DATE_TRUNC('month', rh.purchase_date)::date AS month,
+       rh.purchase_currency::text                  AS currency,
+       SUM(rh.purchase_price)::bigint              AS amount,
+       COUNT(*)                                    AS book_count
+FROM reading_histories rh
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.purchase_price IS NOT NULL
+  AND rh.purchase_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+GROUP BY month, currency
+ORDER BY month, currency;
+
+-- name: GetSpendingByFormat :many
+SELECT rh.format,
+       rh.purchase_currency::text     AS currency,
+       SUM(rh.purchase_price)::bigint AS amount,
+       COUNT(*)                       AS book_count
+FROM reading_histories rh
+WHERE rh.user_id = sqlc.arg(user_id)
+  AND rh.purchase_price IS NOT NULL
+  AND rh.purchase_date BETWEEN sqlc.arg(from_date)::date AND sqlc.arg(to_date)::date
+GROUP BY rh.format, currency
+ORDER BY rh.format NULLS LAST, currency;
\ No newline at end of file
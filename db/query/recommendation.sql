@@ -0,0 +1,108 @@
+-- name: CreateBookRecommendation :one
+INSERT INTO book_recommendations (user_id, book_id, rank, score, reason, reason_name, reason_count)
+VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *;
+
+-- name: DeleteBookRecommendationsByUser :exec
+DELETE
+FROM book_recommendations
+WHERE user_id = $1;
+
+-- name: GetBookRecommendations :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT br.book_id,
+       br.rank,
+       br.score,
+       br.reason,
+       br.reason_name,
+       br.reason_count,
+       br.created_at,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn
+FROM book_recommendations br
+         JOIN books b ON b.id = br.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE br.user_id = sqlc.arg(user_id)
+  AND NOT EXISTS (SELECT 1
+                  FROM reading_histories rh
+                  WHERE rh.user_id = br.user_id
+                    AND rh.book_id = br.book_id)
+ORDER BY br.rank LIMIT sqlc.arg(result_limit);
+
+-- name: GetRecommendationCandidates :many
+WITH finished AS (SELECT rh.book_id
+                  FROM reading_histories rh
+                  WHERE rh.user_id = sqlc.arg(user_id)
+                    AND rh.status = 'done'),
+     genre_weights AS (SELECT bg.genre_name,
+                              COUNT(*) AS weight
+                       FROM finished f
+                                JOIN book_genres bg ON f.book_id = bg.book_id
+                       GROUP BY bg.genre_name),
+     author_weights AS (SELECT b.author_name,
+                               COUNT(*) AS weight
+                        FROM finished f
+                                 JOIN books b ON f.book_id = b.id
+                        WHERE b.author_name IS NOT NULL
+                        GROUP BY b.author_name)
+
+SELECT b.id                                                         AS book_id,
+       b.author_name,
+       COALESCE(aw.weight, 0)::bigint                               AS author_count,
+       tg.genre_name,
+       COALESCE(tg.weight, 0)::bigint                               AS genre_count,
+       (COALESCE(aw.weight, 0) * 2 + COALESCE(gs.weight, 0))::bigint AS score
+FROM books b
+         LEFT JOIN author_weights aw ON b.author_name = aw.author_name
+         LEFT JOIN LATERAL (SELECT SUM(gw.weight) AS weight
+                            FROM book_genres bg
+                                     JOIN genre_weights gw ON bg.genre_name = gw.genre_name
+                            WHERE bg.book_id = b.id) gs ON true
+         LEFT JOIN LATERAL (SELECT gw.genre_name, gw.weight
+                            FROM book_genres bg
+                                     JOIN genre_weights gw ON bg.genre_name = gw.genre_name
+                            WHERE bg.book_id = b.id
+                            ORDER BY gw.weight DESC, gw.genre_name LIMIT 1) tg ON true
+WHERE NOT EXISTS (SELECT 1
+                  FROM reading_histories rh
+                  WHERE rh.user_id = sqlc.arg(user_id)
+                    AND rh.book_id = b.id)
+  AND (aw.weight IS NOT NULL OR tg.weight IS NOT NULL)
+ORDER BY score DESC, b.id DESC LIMIT sqlc.arg(candidate_limit);
+
+-- name: GetRecommendationRefresh :one
+SELECT *
+FROM recommendation_refreshes
+WHERE user_id = $1;
+
+-- name: GetStaleRecommendationUsers :many
+SELECT u.id
+FROM users u
+         LEFT JOIN recommendation_refreshes rr ON rr.user_id = u.id
+WHERE EXISTS (SELECT 1
+              FROM reading_histories rh
+              WHERE rh.user_id = u.id
+                AND rh.status = 'done')
+  AND (rr.refreshed_at IS NULL
+    OR rr.refreshed_at < sqlc.arg(stale_before)
+    OR EXISTS (SELECT 1
+               FROM reading_histories rh
+               WHERE rh.user_id = u.id
+                 AND rh.updated_at > rr.refreshed_at))
+ORDER BY rr.refreshed_at NULLS FIRST, u.id LIMIT sqlc.arg(batch_size);
+
+-- name: UpsertRecommendationRefresh :one
+INSERT INTO recommendation_refreshes (user_id)
+VALUES ($1) ON CONFLICT (user_id) DO
+UPDATE SET refreshed_at = now() RETURNING *;
\ No newline at end of file
@@ -32,6 +32,13 @@ SET refresh_token = $2,
     revoked_at    = $7
 WHERE id = $1 RETURNING *;
 
+-- name: RevokeSessionsByUserID :execrows
+UPDATE sessions
+SET revoked    = true,
+    revoked_at = now()
+WHERE user_id = $1
+  AND revoked = false;
+
 -- name: DeleteSessionByUserID :execrows
 DELETE
 FROM sessions
@@ -0,0 +1,12 @@
+-- name: GetShelfVisibilities :many
+SELECT *
+FROM shelf_visibilities
+WHERE user_id = $1
+ORDER BY status;
+
+-- name: UpsertShelfVisibility :one
+INSERT INTO shelf_visibilities (user_id, status, visibility)
+VALUES ($1, $2, $3) ON CONFLICT (user_id, status) DO
+UPDATE
+SET visibility = EXCLUDED.visibility,
+    updated_at = now() RETURNING *;
\ No newline at end of file
@@ -0,0 +1,25 @@
+-- name: CreateSyncTombstone :exec
+INSERT INTO sync_tombstones (user_id, entity_type, entity_key)
+VALUES ($1, $2, $3) ON CONFLICT (user_id, entity_type, entity_key) DO
+UPDATE SET deleted_at = now();
+
+-- name: DeleteSyncTombstone :exec
+DELETE
+FROM sync_tombstones
+WHERE user_id = $1
+  AND entity_type = $2
+  AND entity_key = $3;
+
+-- name: GetSyncTombstone :one
+SELECT *
+FROM sync_tombstones
+WHERE user_id = $1
+  AND entity_type = $2
+  AND entity_key = $3;
+
+-- name: GetSyncTombstonesSince :many
+SELECT *
+FROM sync_tombstones
+WHERE user_id = $1
+  AND deleted_at > $2
+ORDER BY deleted_at, id;
\ No newline at end of file
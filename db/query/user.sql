@@ -1,8 +1,11 @@
 -- name: GetAllUsers :many
 SELECT *
 FROM users
-ORDER BY id LIMIT $1
-OFFSET $2;
+WHERE sqlc.narg(query)::text IS NULL
+   OR name ILIKE '%' || replace(replace(replace(sqlc.narg(query)::text, '\', '\\'), '%', '\%'), '_', '\_') || '%' ESCAPE '\'
+   OR email ILIKE '%' || replace(replace(replace(sqlc.narg(query)::text, '\', '\\'), '%', '\%'), '_', '\_') || '%' ESCAPE '\'
+ORDER BY id LIMIT sqlc.arg(page_size)
+OFFSET sqlc.arg(page_offset);
 
 -- name: GetUserByID :one
 SELECT *
@@ -30,6 +33,26 @@ SET name            = $2,
     updated_at      = now()
 WHERE id = $1 RETURNING *;
 
+-- name: UpdateUserTimezone :one
+UPDATE users
+SET timezone   = $2,
+    updated_at = now()
+WHERE id = $1 RETURNING *;
+
+-- name: UpdateUserPrivacy :one
+UPDATE users
+SET activity_visibility = $2,
+    profile_visibility  = $3,
+    library_visibility  = $4,
+    updated_at          = now()
+WHERE id = $1 RETURNING *;
+
+-- name: UpdateUserDisabledAt :one
+UPDATE users
+SET disabled_at = $2,
+    updated_at  = now()
+WHERE id = $1 RETURNING *;
+
 -- name: DeleteUser :exec
 DELETE
 FROM users
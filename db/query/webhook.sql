@@ -0,0 +1,64 @@
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg(lease_until),
+    updated_at      = now()
+FROM webhook_endpoints e
+WHERE d.id IN (SELECT id
+               FROM webhook_deliveries
+               WHERE status = 'pending'
+                 AND next_attempt_at <= now()
+               ORDER BY next_attempt_at, id LIMIT sqlc.arg(page_size)
+               FOR UPDATE SKIP LOCKED)
+  AND e.id = d.endpoint_id
+RETURNING d.id, d.event_id, d.event_type, d.body, d.attempts, e.url, e.secret;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (endpoint_id, event_id, event_type, body)
+VALUES ($1, $2, $3, $4) ON CONFLICT (endpoint_id, event_id) DO NOTHING;
+
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (user_id, url, secret, event_types)
+VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE
+FROM webhook_endpoints
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: GetWebhookDeliveriesByEndpoint :many
+SELECT *
+FROM webhook_deliveries
+WHERE endpoint_id = $1
+ORDER BY id DESC LIMIT $2
+OFFSET $3;
+
+-- name: GetWebhookEndpointByID :one
+SELECT *
+FROM webhook_endpoints
+WHERE id = $1
+  AND user_id = $2;
+
+-- name: GetWebhookEndpointsByEvent :many
+SELECT *
+FROM webhook_endpoints
+WHERE user_id = sqlc.arg(user_id)
+  AND sqlc.arg(event_type)::varchar = ANY (event_types)
+ORDER BY id;
+
+-- name: GetWebhookEndpointsByUser :many
+SELECT *
+FROM webhook_endpoints
+WHERE user_id = $1
+ORDER BY id;
+
+-- name: UpdateWebhookDeliveryResult :exec
+UPDATE webhook_deliveries
+SET status               = $2,
+    attempts             = attempts + 1,
+    last_response_status = $3,
+    last_error           = $4,
+    next_attempt_at      = $5,
+    delivered_at         = $6,
+    updated_at           = now()
+WHERE id = $1;
\ No newline at end of file
@@ -7,11 +7,13 @@ package db
 
 import (
 	"context"
+
+	"github.com/lib/pq"
 )
 
 const createAuthor = `-- name: CreateAuthor :one
 INSERT INTO authors (name)
-VALUES ($1) RETURNING name, created_at
+VALUES ($1) ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING name, created_at
 `
 
 func (q *Queries) CreateAuthor(ctx context.Context, name string) (Author, error) {
@@ -78,3 +80,40 @@ func (q *Queries) GetAuthorByName(ctx context.Context, name string) (Author, err
 	err := row.Scan(&i.Name, &i.CreatedAt)
 	return i, err
 }
+
+const getDuplicateAuthors = `-- name: GetDuplicateAuthors :many
+SELECT normalize_catalog_name(name)::varchar   AS normalized_name,
+       array_agg(name ORDER BY name)::varchar[] AS names
+FROM authors
+GROUP BY normalized_name
+HAVING count(*) > 1
+ORDER BY normalized_name
+`
+
+type GetDuplicateAuthorsRow struct {
+	NormalizedName string   `json:"normalized_name"`
+	Names          []string `json:"names"`
+}
+
+func (q *Queries) GetDuplicateAuthors(ctx context.Context) ([]GetDuplicateAuthorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDuplicateAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDuplicateAuthorsRow{}
+	for rows.Next() {
+		var i GetDuplicateAuthorsRow
+		if err := rows.Scan(&i.NormalizedName, pq.Array(&i.Names)); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	"readly/testdata"
+	"testing"
+)
+
+func TestCreateAuthorAlias(t *testing.T) {
+	canonical := createRandomAuthor(t)
+	alias := testdata.RandomString(6)
+	res, err := querier.CreateAuthorAlias(context.Background(), CreateAuthorAliasParams{
+		Alias:         alias,
+		CanonicalName: canonical.Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, alias, res.Alias)
+	require.Equal(t, canonical.Name, res.CanonicalName)
+
+	name, err := querier.GetCanonicalAuthorName(context.Background(), alias)
+	require.NoError(t, err)
+	require.Equal(t, canonical.Name, name)
+
+	_, err = querier.GetCanonicalAuthorName(context.Background(), canonical.Name)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+func TestUpdateAuthorAliasesCanonicalName(t *testing.T) {
+	oldAuthor := createRandomAuthor(t)
+	newAuthor := createRandomAuthor(t)
+	alias := testdata.RandomString(6)
+	_, err := querier.CreateAuthorAlias(context.Background(), CreateAuthorAliasParams{
+		Alias:         alias,
+		CanonicalName: oldAuthor.Name,
+	})
+	require.NoError(t, err)
+
+	rowsAffected, err := querier.UpdateAuthorAliasesCanonicalName(context.Background(), UpdateAuthorAliasesCanonicalNameParams{
+		NewName: newAuthor.Name,
+		OldName: oldAuthor.Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rowsAffected)
+
+	name, err := querier.GetCanonicalAuthorName(context.Background(), alias)
+	require.NoError(t, err)
+	require.Equal(t, newAuthor.Name, name)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"github.com/stretchr/testify/require"
 	"readly/testdata"
+	"strings"
 	"testing"
 	"time"
 )
@@ -59,3 +60,24 @@ func TestGetAllAuthors(t *testing.T) {
 		require.NotEmpty(t, author)
 	}
 }
+
+func TestGetDuplicateAuthors(t *testing.T) {
+	// 全角の空白と大文字の違いは正規化すると同じ名前になる
+	name := strings.ToLower(testdata.RandomString(12))
+	variant := strings.ToUpper(name[:3]) + "　" + name[3:]
+	_, err := querier.CreateAuthor(context.Background(), name)
+	require.NoError(t, err)
+	_, err = querier.CreateAuthor(context.Background(), variant)
+	require.NoError(t, err)
+
+	duplicates, err := querier.GetDuplicateAuthors(context.Background())
+	require.NoError(t, err)
+	var found *GetDuplicateAuthorsRow
+	for _, d := range duplicates {
+		if d.NormalizedName == name {
+			found = &d
+		}
+	}
+	require.NotNil(t, found)
+	require.ElementsMatch(t, []string{name, variant}, found.Names)
+}
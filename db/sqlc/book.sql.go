@@ -20,8 +20,9 @@ INTO books (title,
             author_name,
             publisher_name,
             published_date,
-            isbn)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, title, description, cover_image_url, url, author_name, publisher_name, published_date, isbn, created_at, updated_at
+            isbn,
+            page_count)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id, title, description, cover_image_url, url, author_name, publisher_name, published_date, isbn, created_at, updated_at, page_count, version
 `
 
 type CreateBookParams struct {
@@ -33,6 +34,7 @@ type CreateBookParams struct {
 	PublisherName sql.NullString `json:"publisher_name"`
 	PublishedDate sql.NullTime   `json:"published_date"`
 	Isbn          sql.NullString `json:"isbn"`
+	PageCount     sql.NullInt32  `json:"page_count"`
 }
 
 func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, error) {
@@ -45,6 +47,7 @@ func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, e
 		arg.PublisherName,
 		arg.PublishedDate,
 		arg.Isbn,
+		arg.PageCount,
 	)
 	var i Book
 	err := row.Scan(
@@ -59,6 +62,8 @@ func (q *Queries) CreateBook(ctx context.Context, arg CreateBookParams) (Book, e
 		&i.Isbn,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PageCount,
+		&i.Version,
 	)
 	return i, err
 }
@@ -160,6 +165,8 @@ SELECT b.id,
        b.publisher_name,
        b.published_date,
        b.isbn,
+       b.page_count,
+       b.version,
        b.created_at,
        b.updated_at
 FROM books b
@@ -180,6 +187,8 @@ type GetBooksByIDRow struct {
 	PublisherName sql.NullString `json:"publisher_name"`
 	PublishedDate sql.NullTime   `json:"published_date"`
 	Isbn          sql.NullString `json:"isbn"`
+	PageCount     sql.NullInt32  `json:"page_count"`
+	Version       int64          `json:"version"`
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 }
@@ -198,6 +207,8 @@ func (q *Queries) GetBooksByID(ctx context.Context, id int64) (GetBooksByIDRow,
 		&i.PublisherName,
 		&i.PublishedDate,
 		&i.Isbn,
+		&i.PageCount,
+		&i.Version,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -430,20 +441,25 @@ SET title           = $2,
     publisher_name  = $7,
     published_date  = $8,
     isbn            = $9,
+    page_count      = $10,
+    version         = version + 1,
     updated_at      = now()
-WHERE id = $1 RETURNING id, title, description, cover_image_url, url, author_name, publisher_name, published_date, isbn, created_at, updated_at
+WHERE id = $1
+  AND ($11::bigint IS NULL OR version = $11::bigint) RETURNING id, title, description, cover_image_url, url, author_name, publisher_name, published_date, isbn, created_at, updated_at, page_count, version
 `
 
 type UpdateBookParams struct {
-	ID            int64          `json:"id"`
-	Title         string         `json:"title"`
-	Description   sql.NullString `json:"description"`
-	CoverImageUrl sql.NullString `json:"cover_image_url"`
-	Url           sql.NullString `json:"url"`
-	AuthorName    sql.NullString `json:"author_name"`
-	PublisherName sql.NullString `json:"publisher_name"`
-	PublishedDate sql.NullTime   `json:"published_date"`
-	Isbn          sql.NullString `json:"isbn"`
+	ID              int64          `json:"id"`
+	Title           string         `json:"title"`
+	Description     sql.NullString `json:"description"`
+	CoverImageUrl   sql.NullString `json:"cover_image_url"`
+	Url             sql.NullString `json:"url"`
+	AuthorName      sql.NullString `json:"author_name"`
+	PublisherName   sql.NullString `json:"publisher_name"`
+	PublishedDate   sql.NullTime   `json:"published_date"`
+	Isbn            sql.NullString `json:"isbn"`
+	PageCount       sql.NullInt32  `json:"page_count"`
+	ExpectedVersion sql.NullInt64  `json:"expected_version"`
 }
 
 func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error) {
@@ -457,6 +473,8 @@ func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, e
 		arg.PublisherName,
 		arg.PublishedDate,
 		arg.Isbn,
+		arg.PageCount,
+		arg.ExpectedVersion,
 	)
 	var i Book
 	err := row.Scan(
@@ -471,6 +489,50 @@ func (q *Queries) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, e
 		&i.Isbn,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PageCount,
+		&i.Version,
 	)
 	return i, err
 }
+
+const updateBooksAuthorName = `-- name: UpdateBooksAuthorName :execrows
+UPDATE books
+SET author_name = $1,
+    version     = version + 1,
+    updated_at  = now()
+WHERE author_name = $2
+`
+
+type UpdateBooksAuthorNameParams struct {
+	NewName sql.NullString `json:"new_name"`
+	OldName sql.NullString `json:"old_name"`
+}
+
+func (q *Queries) UpdateBooksAuthorName(ctx context.Context, arg UpdateBooksAuthorNameParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateBooksAuthorName, arg.NewName, arg.OldName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateBooksPublisherName = `-- name: UpdateBooksPublisherName :execrows
+UPDATE books
+SET publisher_name = $1,
+    version        = version + 1,
+    updated_at     = now()
+WHERE publisher_name = $2
+`
+
+type UpdateBooksPublisherNameParams struct {
+	NewName sql.NullString `json:"new_name"`
+	OldName sql.NullString `json:"old_name"`
+}
+
+func (q *Queries) UpdateBooksPublisherName(ctx context.Context, arg UpdateBooksPublisherNameParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateBooksPublisherName, arg.NewName, arg.OldName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
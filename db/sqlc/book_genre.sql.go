@@ -9,6 +9,26 @@ import (
 	"context"
 )
 
+const copyBookGenres = `-- name: CopyBookGenres :execrows
+INSERT INTO book_genres (book_id, genre_name)
+SELECT book_id, $1::varchar
+FROM book_genres
+WHERE genre_name = $2 ON CONFLICT DO NOTHING
+`
+
+type CopyBookGenresParams struct {
+	NewName string `json:"new_name"`
+	OldName string `json:"old_name"`
+}
+
+func (q *Queries) CopyBookGenres(ctx context.Context, arg CopyBookGenresParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, copyBookGenres, arg.NewName, arg.OldName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createBookGenre = `-- name: CreateBookGenre :one
 INSERT INTO book_genres (book_id, genre_name)
 VALUES ($1, $2) RETURNING book_id, genre_name
@@ -46,6 +66,20 @@ func (q *Queries) DeleteBookGenre(ctx context.Context, arg DeleteBookGenreParams
 	return result.RowsAffected()
 }
 
+const deleteBookGenresByGenre = `-- name: DeleteBookGenresByGenre :execrows
+DELETE
+FROM book_genres
+WHERE genre_name = $1
+`
+
+func (q *Queries) DeleteBookGenresByGenre(ctx context.Context, genreName string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteBookGenresByGenre, genreName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getGenresByBookID = `-- name: GetGenresByBookID :many
 SELECT genre_name
 FROM book_genres
@@ -64,3 +64,31 @@ func TestDeleteBookGenre(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, genres, 2)
 }
+
+func TestCopyBookGenres(t *testing.T) {
+	book1 := createTestBook(t, testdata.RandomString(6), "", "", testdata.RandomString(13))
+	book2 := createTestBook(t, testdata.RandomString(6), "", "", testdata.RandomString(13))
+	oldGenre := createRandomGenre(t)
+	newGenre := createRandomGenre(t)
+	createRandomBookGenre(t, book1, oldGenre)
+	createRandomBookGenre(t, book2, oldGenre)
+	// 既に移動先のジャンルを持つ本は重複させない
+	createRandomBookGenre(t, book2, newGenre)
+
+	copied, err := querier.CopyBookGenres(context.Background(), CopyBookGenresParams{
+		NewName: newGenre.Name,
+		OldName: oldGenre.Name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), copied)
+
+	deleted, err := querier.DeleteBookGenresByGenre(context.Background(), oldGenre.Name)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), deleted)
+
+	for _, book := range []Book{book1, book2} {
+		genres, err := querier.GetGenresByBookID(context.Background(), book.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{newGenre.Name}, genres)
+	}
+}
@@ -17,6 +17,6 @@ func (a *Adapter) Connect(dbDriver string, dbSource string) (DBTX, Querier) {
 	if err != nil {
 		log.Fatal("cannot connect to db:", err)
 	}
-	q := New(db)
+	q := New(NewTxDB(db))
 	return db, q
 }
@@ -28,8 +28,10 @@ func (q *FakeQuerier) CreateBook(ctx context.Context, arg CreateBookParams) (Boo
 		PublisherName: arg.PublisherName,
 		PublishedDate: arg.PublishedDate,
 		Isbn:          arg.Isbn,
+		PageCount:     arg.PageCount,
 		CreatedAt:     now,
 		UpdatedAt:     now,
+		Version:       1,
 	}
 	bookTable.Columns = append(bookTable.Columns, b)
 	bookTable.NextID++
@@ -81,6 +83,7 @@ func (q *FakeQuerier) GetBooksByID(_ context.Context, id int64) (GetBooksByIDRow
 				PublisherName: b.PublisherName,
 				PublishedDate: b.PublishedDate,
 				Isbn:          b.Isbn,
+				PageCount:     b.PageCount,
 				CreatedAt:     b.CreatedAt,
 				UpdatedAt:     b.UpdatedAt,
 			}, nil
@@ -158,6 +161,9 @@ func (q *FakeQuerier) GetBooksByTitle(ctx context.Context, title string) ([]GetB
 func (q *FakeQuerier) UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error) {
 	for i, b := range bookTable.Columns {
 		if b.ID == arg.ID {
+			if arg.ExpectedVersion.Valid && b.Version != arg.ExpectedVersion.Int64 {
+				return Book{}, sql.ErrNoRows
+			}
 			bookTable.Columns[i].Title = arg.Title
 			bookTable.Columns[i].Description = arg.Description
 			bookTable.Columns[i].CoverImageUrl = arg.CoverImageUrl
@@ -166,6 +172,8 @@ func (q *FakeQuerier) UpdateBook(ctx context.Context, arg UpdateBookParams) (Boo
 			bookTable.Columns[i].PublisherName = arg.PublisherName
 			bookTable.Columns[i].PublishedDate = arg.PublishedDate
 			bookTable.Columns[i].Isbn = arg.Isbn
+			bookTable.Columns[i].PageCount = arg.PageCount
+			bookTable.Columns[i].Version++
 			bookTable.Columns[i].UpdatedAt = time.Now().UTC()
 			return bookTable.Columns[i], nil
 		}
@@ -0,0 +1,29 @@
+//go:build test
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+type FeedEventTable struct {
+	// 自動インクリメンタル用
+	NextID  int64
+	Columns []FeedEvent
+}
+
+var feedEventTable = FeedEventTable{NextID: 1}
+
+func (q *FakeQuerier) CreateFeedEvent(_ context.Context, arg CreateFeedEventParams) (FeedEvent, error) {
+	e := FeedEvent{
+		ID:        feedEventTable.NextID,
+		UserID:    arg.UserID,
+		BookID:    arg.BookID,
+		EventType: arg.EventType,
+		CreatedAt: time.Now().UTC(),
+	}
+	feedEventTable.Columns = append(feedEventTable.Columns, e)
+	feedEventTable.NextID++
+	return e, nil
+}
@@ -0,0 +1,28 @@
+//go:build test
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxEventTable struct {
+	// 自動インクリメンタル用
+	NextID  int64
+	Columns []OutboxEvent
+}
+
+var outboxEventTable = OutboxEventTable{NextID: 1}
+
+func (q *FakeQuerier) CreateOutboxEvent(_ context.Context, arg CreateOutboxEventParams) (OutboxEvent, error) {
+	e := OutboxEvent{
+		ID:        outboxEventTable.NextID,
+		EventType: arg.EventType,
+		Payload:   arg.Payload,
+		CreatedAt: time.Now().UTC(),
+	}
+	outboxEventTable.Columns = append(outboxEventTable.Columns, e)
+	outboxEventTable.NextID++
+	return e, nil
+}
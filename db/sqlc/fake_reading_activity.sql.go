@@ -0,0 +1,81 @@
+//go:build test
+
+package db
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+type ReadingActivityTable struct {
+	// 自動インクリメンタル用
+	NextID  int64
+	Columns []ReadingActivity
+}
+
+var readingActivityTable = ReadingActivityTable{NextID: 1}
+
+func (q *FakeQuerier) CreateReadingActivity(_ context.Context, arg CreateReadingActivityParams) (ReadingActivity, error) {
+	a := ReadingActivity{
+		ID:           readingActivityTable.NextID,
+		UserID:       arg.UserID,
+		BookID:       arg.BookID,
+		ActivityType: arg.ActivityType,
+		OccurredAt:   arg.OccurredAt,
+	}
+	readingActivityTable.Columns = append(readingActivityTable.Columns, a)
+	readingActivityTable.NextID++
+	return a, nil
+}
+
+func (q *FakeQuerier) GetActivityDates(_ context.Context, arg GetActivityDatesParams) ([]time.Time, error) {
+	counts, err := countActivitiesByDate(arg.UserID, arg.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]time.Time, 0, len(counts))
+	for d := range counts {
+		dates = append(dates, d)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].After(dates[j]) })
+	return dates, nil
+}
+
+func (q *FakeQuerier) GetDailyActivityCounts(_ context.Context, arg GetDailyActivityCountsParams) ([]GetDailyActivityCountsRow, error) {
+	counts, err := countActivitiesByDate(arg.UserID, arg.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	from := truncateDate(arg.FromDate, time.UTC)
+	to := truncateDate(arg.ToDate, time.UTC)
+	rows := []GetDailyActivityCountsRow{}
+	for d, c := range counts {
+		if d.Before(from) || d.After(to) {
+			continue
+		}
+		rows = append(rows, GetDailyActivityCountsRow{ActivityDate: d, ActivityCount: c})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ActivityDate.Before(rows[j].ActivityDate) })
+	return rows, nil
+}
+
+func countActivitiesByDate(userID int64, timezone string) (map[time.Time]int64, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	counts := map[time.Time]int64{}
+	for _, a := range readingActivityTable.Columns {
+		if a.UserID == userID {
+			counts[truncateDate(a.OccurredAt, loc)]++
+		}
+	}
+	return counts, nil
+}
+
+// truncateDate date型と同様にタイムゾーン上の日付のみをUTCで保持する
+func truncateDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -16,6 +16,16 @@ type ReadingHistoryTable struct {
 
 var readingHistoryTable = ReadingHistoryTable{}
 
+func (q *FakeQuerier) CountReadingHistoriesByBook(_ context.Context, bookID int64) (int64, error) {
+	var count int64
+	for _, h := range readingHistoryTable.Columns {
+		if h.BookID == bookID {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func (q *FakeQuerier) CreateReadingHistory(_ context.Context, arg CreateReadingHistoryParams) (ReadingHistory, error) {
 	for _, h := range readingHistoryTable.Columns {
 		if h.UserID == arg.UserID && h.BookID == arg.BookID {
@@ -23,13 +33,23 @@ func (q *FakeQuerier) CreateReadingHistory(_ context.Context, arg CreateReadingH
 		}
 	}
 	h := ReadingHistory{
-		UserID:    arg.UserID,
-		BookID:    arg.BookID,
-		Status:    arg.Status,
-		StartDate: arg.StartDate,
-		EndDate:   arg.EndDate,
-		CreatedAt: time.Now().UTC(),
-		UpdatedAt: time.Now().UTC(),
+		UserID:           arg.UserID,
+		BookID:           arg.BookID,
+		Status:           arg.Status,
+		StartDate:        arg.StartDate,
+		EndDate:          arg.EndDate,
+		CreatedAt:        time.Now().UTC(),
+		UpdatedAt:        time.Now().UTC(),
+		Priority:         arg.Priority,
+		QueuePosition:    arg.QueuePosition,
+		Owned:            arg.Owned,
+		Format:           arg.Format,
+		PurchasePrice:    arg.PurchasePrice,
+		PurchaseCurrency: arg.PurchaseCurrency,
+		PurchaseStore:    arg.PurchaseStore,
+		PurchaseDate:     arg.PurchaseDate,
+		DurationMinutes:  arg.DurationMinutes,
+		Version:          1,
 	}
 	readingHistoryTable.Columns = append(readingHistoryTable.Columns, h)
 	return h, nil
@@ -45,6 +65,16 @@ func (q *FakeQuerier) DeleteReadingHistory(_ context.Context, arg DeleteReadingH
 	return 0, nil
 }
 
+func (q *FakeQuerier) GetNextQueuePosition(_ context.Context, userID int64) (int32, error) {
+	var maxPosition int32
+	for _, h := range readingHistoryTable.Columns {
+		if h.UserID == userID && h.QueuePosition.Valid && h.QueuePosition.Int32 > maxPosition {
+			maxPosition = h.QueuePosition.Int32
+		}
+	}
+	return maxPosition + 1, nil
+}
+
 func (q *FakeQuerier) GetReadingHistoryByUser(ctx context.Context, arg GetReadingHistoryByUserParams) ([]GetReadingHistoryByUserRow, error) {
 	// TODO:ページング対応
 	var rows []GetReadingHistoryByUserRow
@@ -60,19 +90,30 @@ func (q *FakeQuerier) GetReadingHistoryByUser(ctx context.Context, arg GetReadin
 			}
 			genres := chars(g).toByte()
 			rows = append(rows, GetReadingHistoryByUserRow{
-				ID:            sql.NullInt64{Int64: b.ID, Valid: true},
-				Title:         sql.NullString{String: b.Title, Valid: true},
-				Genres:        genres,
-				Description:   b.Description,
-				CoverImageUrl: b.CoverImageUrl,
-				Url:           b.Url,
-				AuthorName:    b.AuthorName,
-				PublisherName: b.PublisherName,
-				PublishedDate: b.PublishedDate,
-				Isbn:          b.Isbn,
-				Status:        r.Status,
-				StartDate:     r.StartDate,
-				EndDate:       r.EndDate,
+				ID:               sql.NullInt64{Int64: b.ID, Valid: true},
+				Title:            sql.NullString{String: b.Title, Valid: true},
+				Genres:           genres,
+				Description:      b.Description,
+				CoverImageUrl:    b.CoverImageUrl,
+				Url:              b.Url,
+				AuthorName:       b.AuthorName,
+				PublisherName:    b.PublisherName,
+				PublishedDate:    b.PublishedDate,
+				Isbn:             b.Isbn,
+				Status:           r.Status,
+				StartDate:        r.StartDate,
+				EndDate:          r.EndDate,
+				PageCount:        b.PageCount,
+				Priority:         r.Priority,
+				QueuePosition:    r.QueuePosition,
+				Owned:            r.Owned,
+				Format:           r.Format,
+				PurchasePrice:    r.PurchasePrice,
+				PurchaseCurrency: r.PurchaseCurrency,
+				PurchaseStore:    r.PurchaseStore,
+				PurchaseDate:     r.PurchaseDate,
+				DurationMinutes:  r.DurationMinutes,
+				Version:          r.Version,
 			})
 		}
 	}
@@ -105,6 +146,7 @@ func (q *FakeQuerier) GetReadingHistoryByUserAndBook(ctx context.Context, arg Ge
 				Status:        r.Status,
 				StartDate:     r.StartDate,
 				EndDate:       r.EndDate,
+				Owned:         r.Owned,
 			}, nil
 		}
 	}
@@ -151,6 +193,10 @@ func (q *FakeQuerier) UpdateReadingHistory(ctx context.Context, arg UpdateReadin
 			readingHistoryTable.Columns[i].Status = arg.Status
 			readingHistoryTable.Columns[i].StartDate = arg.StartDate
 			readingHistoryTable.Columns[i].EndDate = arg.EndDate
+			if arg.Status != ReadingStatusUnread {
+				readingHistoryTable.Columns[i].QueuePosition = sql.NullInt32{}
+			}
+			readingHistoryTable.Columns[i].Version++
 			readingHistoryTable.Columns[i].UpdatedAt = time.Now().UTC()
 			return readingHistoryTable.Columns[i], nil
 		}
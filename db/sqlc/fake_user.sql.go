@@ -31,6 +31,12 @@ func (q *FakeQuerier) CreateUser(_ context.Context, arg CreateUserParams) (User,
 		HashedPassword: arg.HashedPassword,
 		CreatedAt:      now,
 		UpdatedAt:      now,
+		Timezone:       "UTC",
+		// DBのデフォルト値に合わせる
+		ActivityVisibility: VisibilityFollowers,
+		ProfileVisibility:  VisibilityPrivate,
+		LibraryVisibility:  VisibilityPrivate,
+		Role:               UserRoleUser,
 	}
 	userTable.Columns = append(userTable.Columns, u)
 	userTable.NextID++
@@ -75,6 +81,41 @@ func (q *FakeQuerier) UpdateUser(_ context.Context, arg UpdateUserParams) (User,
 	return User{}, sql.ErrNoRows
 }
 
+func (q *FakeQuerier) UpdateUserDisabledAt(_ context.Context, arg UpdateUserDisabledAtParams) (User, error) {
+	for i, u := range userTable.Columns {
+		if u.ID == arg.ID {
+			userTable.Columns[i].DisabledAt = arg.DisabledAt
+			userTable.Columns[i].UpdatedAt = time.Now().UTC()
+			return userTable.Columns[i], nil
+		}
+	}
+	return User{}, sql.ErrNoRows
+}
+
+func (q *FakeQuerier) UpdateUserPrivacy(_ context.Context, arg UpdateUserPrivacyParams) (User, error) {
+	for i, u := range userTable.Columns {
+		if u.ID == arg.ID {
+			userTable.Columns[i].ActivityVisibility = arg.ActivityVisibility
+			userTable.Columns[i].ProfileVisibility = arg.ProfileVisibility
+			userTable.Columns[i].LibraryVisibility = arg.LibraryVisibility
+			userTable.Columns[i].UpdatedAt = time.Now().UTC()
+			return userTable.Columns[i], nil
+		}
+	}
+	return User{}, sql.ErrNoRows
+}
+
+func (q *FakeQuerier) UpdateUserTimezone(_ context.Context, arg UpdateUserTimezoneParams) (User, error) {
+	for i, u := range userTable.Columns {
+		if u.ID == arg.ID {
+			userTable.Columns[i].Timezone = arg.Timezone
+			userTable.Columns[i].UpdatedAt = time.Now().UTC()
+			return userTable.Columns[i], nil
+		}
+	}
+	return User{}, sql.ErrNoRows
+}
+
 func scanUser(id int64) (User, error) {
 	for _, user := range userTable.Columns {
 		if user.ID == id {
@@ -7,11 +7,13 @@ package db
 
 import (
 	"context"
+
+	"github.com/lib/pq"
 )
 
 const createGenre = `-- name: CreateGenre :one
 INSERT INTO genres (name)
-VALUES ($1) RETURNING name, created_at
+VALUES ($1) ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING name, created_at
 `
 
 func (q *Queries) CreateGenre(ctx context.Context, name string) (Genre, error) {
@@ -66,6 +68,43 @@ func (q *Queries) GetAllGenres(ctx context.Context, arg GetAllGenresParams) ([]G
 	return items, nil
 }
 
+const getDuplicateGenres = `-- name: GetDuplicateGenres :many
+SELECT normalize_catalog_name(name)::varchar   AS normalized_name,
+       array_agg(name ORDER BY name)::varchar[] AS names
+FROM genres
+GROUP BY normalized_name
+HAVING count(*) > 1
+ORDER BY normalized_name
+`
+
+type GetDuplicateGenresRow struct {
+	NormalizedName string   `json:"normalized_name"`
+	Names          []string `json:"names"`
+}
+
+func (q *Queries) GetDuplicateGenres(ctx context.Context) ([]GetDuplicateGenresRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDuplicateGenres)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDuplicateGenresRow{}
+	for rows.Next() {
+		var i GetDuplicateGenresRow
+		if err := rows.Scan(&i.NormalizedName, pq.Array(&i.Names)); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getGenreByName = `-- name: GetGenreByName :one
 SELECT name, created_at
 FROM genres
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func createTestLoan(t *testing.T, user User, book Book, dueDate sql.NullTime) Loan {
+	arg := CreateLoanParams{
+		UserID:       user.ID,
+		BookID:       book.ID,
+		BorrowerName: testdata.RandomString(10),
+		LoanDate:     time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:      dueDate,
+	}
+	l, err := querier.CreateLoan(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, l.ID)
+	require.Equal(t, arg.UserID, l.UserID)
+	require.Equal(t, arg.BookID, l.BookID)
+	require.Equal(t, arg.BorrowerName, l.BorrowerName)
+	require.False(t, l.BorrowerUserID.Valid)
+	require.Equal(t, arg.LoanDate, l.LoanDate.UTC())
+	compareNullTimes(t, arg.DueDate, l.DueDate)
+	require.False(t, l.ReturnDate.Valid)
+	return l
+}
+
+func TestCreateLoan(t *testing.T) {
+	user := createRandomUser(t)
+	b, _, _ := createRandomReadingHistory(t, user, 0, ReadingStatusDone)
+	createTestLoan(t, user, b, sql.NullTime{})
+
+	// 貸出中の本は重複して貸し出せない
+	_, err := querier.CreateLoan(context.Background(), CreateLoanParams{
+		UserID:       user.ID,
+		BookID:       b.ID,
+		BorrowerName: testdata.RandomString(10),
+		LoanDate:     time.Now(),
+	})
+	require.Error(t, err)
+}
+
+func TestLoans(t *testing.T) {
+	user := createRandomUser(t)
+	b1, _, _ := createRandomReadingHistory(t, user, 0, ReadingStatusDone)
+	b2, _, _ := createRandomReadingHistory(t, user, 0, ReadingStatusUnread)
+	b3, _, _ := createRandomReadingHistory(t, user, 0, ReadingStatusDone)
+	overdue := createTestLoan(t, user, b1, sql.NullTime{Time: time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), Valid: true})
+	notDue := createTestLoan(t, user, b2, sql.NullTime{Time: time.Now().AddDate(0, 1, 0), Valid: true})
+	returned := createTestLoan(t, user, b3, sql.NullTime{})
+
+	r, err := querier.ReturnLoan(context.Background(), ReturnLoanParams{
+		ID:         returned.ID,
+		UserID:     user.ID,
+		ReturnDate: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	require.NoError(t, err)
+	require.True(t, r.ReturnDate.Valid)
+	_, err = querier.ReturnLoan(context.Background(), ReturnLoanParams{
+		ID:         returned.ID,
+		UserID:     user.ID,
+		ReturnDate: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	active, err := querier.GetActiveLoans(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	require.Equal(t, overdue.ID, active[0].ID)
+	require.Equal(t, b1.Title, active[0].Title)
+	require.True(t, active[0].Overdue)
+	require.Equal(t, notDue.ID, active[1].ID)
+	require.False(t, active[1].Overdue)
+
+	overdueLoans, err := querier.GetOverdueLoans(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, overdueLoans, 1)
+	require.Equal(t, overdue.ID, overdueLoans[0].ID)
+
+	loan, err := querier.GetLoanByID(context.Background(), GetLoanByIDParams{ID: returned.ID, UserID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, b3.ID, loan.BookID)
+	require.True(t, loan.ReturnDate.Valid)
+	require.False(t, loan.Overdue)
+
+	_, err = querier.GetActiveLoanByBook(context.Background(), GetActiveLoanByBookParams{UserID: user.ID, BookID: b3.ID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	other := createRandomUser(t)
+	_, err = querier.GetLoanByID(context.Background(), GetLoanByIDParams{ID: overdue.ID, UserID: other.ID})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
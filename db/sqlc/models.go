@@ -7,19 +7,194 @@ package db
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type ActivityType string
+
+const (
+	ActivityTypeStatusChanged   ActivityType = "status_changed"
+	ActivityTypeProgressUpdated ActivityType = "progress_updated"
+)
+
+func (e *ActivityType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ActivityType(s)
+	case string:
+		*e = ActivityType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ActivityType: %T", src)
+	}
+	return nil
+}
+
+type NullActivityType struct {
+	ActivityType ActivityType `json:"activity_type"`
+	Valid        bool         `json:"valid"` // Valid is true if ActivityType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullActivityType) Scan(value interface{}) error {
+	if value == nil {
+		ns.ActivityType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ActivityType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullActivityType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ActivityType), nil
+}
+
+type BookFormat string
+
+const (
+	BookFormatPaperback BookFormat = "paperback"
+	BookFormatHardcover BookFormat = "hardcover"
+	BookFormatEbook     BookFormat = "ebook"
+	BookFormatAudiobook BookFormat = "audiobook"
+)
+
+func (e *BookFormat) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BookFormat(s)
+	case string:
+		*e = BookFormat(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BookFormat: %T", src)
+	}
+	return nil
+}
+
+type NullBookFormat struct {
+	BookFormat BookFormat `json:"book_format"`
+	Valid      bool       `json:"valid"` // Valid is true if BookFormat is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBookFormat) Scan(value interface{}) error {
+	if value == nil {
+		ns.BookFormat, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BookFormat.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBookFormat) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BookFormat), nil
+}
+
+type ClubRole string
+
+const (
+	ClubRoleOwner     ClubRole = "owner"
+	ClubRoleModerator ClubRole = "moderator"
+	ClubRoleMember    ClubRole = "member"
+)
+
+func (e *ClubRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ClubRole(s)
+	case string:
+		*e = ClubRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ClubRole: %T", src)
+	}
+	return nil
+}
+
+type NullClubRole struct {
+	ClubRole ClubRole `json:"club_role"`
+	Valid    bool     `json:"valid"` // Valid is true if ClubRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullClubRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.ClubRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ClubRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullClubRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ClubRole), nil
+}
+
+type FeedEventType string
+
+const (
+	FeedEventTypeAddedBook       FeedEventType = "added_book"
+	FeedEventTypeStartedReading  FeedEventType = "started_reading"
+	FeedEventTypeFinishedReading FeedEventType = "finished_reading"
+)
+
+func (e *FeedEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FeedEventType(s)
+	case string:
+		*e = FeedEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FeedEventType: %T", src)
+	}
+	return nil
+}
+
+type NullFeedEventType struct {
+	FeedEventType FeedEventType `json:"feed_event_type"`
+	Valid         bool          `json:"valid"` // Valid is true if FeedEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFeedEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.FeedEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FeedEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFeedEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FeedEventType), nil
+}
+
 type ReadingStatus string
 
 const (
-	ReadingStatusUnread  ReadingStatus = "unread"
-	ReadingStatusReading ReadingStatus = "reading"
-	ReadingStatusDone    ReadingStatus = "done"
-	ReadingStatusUnknown ReadingStatus = "unknown"
+	ReadingStatusUnread    ReadingStatus = "unread"
+	ReadingStatusReading   ReadingStatus = "reading"
+	ReadingStatusDone      ReadingStatus = "done"
+	ReadingStatusUnknown   ReadingStatus = "unknown"
+	ReadingStatusAbandoned ReadingStatus = "abandoned"
+	ReadingStatusOnHold    ReadingStatus = "on_hold"
 )
 
 func (e *ReadingStatus) Scan(src interface{}) error {
@@ -57,12 +232,203 @@ func (ns NullReadingStatus) Value() (driver.Value, error) {
 	return string(ns.ReadingStatus), nil
 }
 
+type RecommendationReason string
+
+const (
+	RecommendationReasonAuthor RecommendationReason = "author"
+	RecommendationReasonGenre  RecommendationReason = "genre"
+)
+
+func (e *RecommendationReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RecommendationReason(s)
+	case string:
+		*e = RecommendationReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RecommendationReason: %T", src)
+	}
+	return nil
+}
+
+type NullRecommendationReason struct {
+	RecommendationReason RecommendationReason `json:"recommendation_reason"`
+	Valid                bool                 `json:"valid"` // Valid is true if RecommendationReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRecommendationReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.RecommendationReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RecommendationReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRecommendationReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RecommendationReason), nil
+}
+
+type UserRole string
+
+const (
+	UserRoleUser      UserRole = "user"
+	UserRoleLibrarian UserRole = "librarian"
+	UserRoleAdmin     UserRole = "admin"
+)
+
+func (e *UserRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserRole(s)
+	case string:
+		*e = UserRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserRole: %T", src)
+	}
+	return nil
+}
+
+type NullUserRole struct {
+	UserRole UserRole `json:"user_role"`
+	Valid    bool     `json:"valid"` // Valid is true if UserRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserRole), nil
+}
+
+type Visibility string
+
+const (
+	VisibilityPrivate   Visibility = "private"
+	VisibilityFollowers Visibility = "followers"
+	VisibilityPublic    Visibility = "public"
+)
+
+func (e *Visibility) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = Visibility(s)
+	case string:
+		*e = Visibility(s)
+	default:
+		return fmt.Errorf("unsupported scan type for Visibility: %T", src)
+	}
+	return nil
+}
+
+type NullVisibility struct {
+	Visibility Visibility `json:"visibility"`
+	Valid      bool       `json:"valid"` // Valid is true if Visibility is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullVisibility) Scan(value interface{}) error {
+	if value == nil {
+		ns.Visibility, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.Visibility.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullVisibility) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.Visibility), nil
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus `json:"webhook_delivery_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+// Stores actions taken by admins.
+type AuditLog struct {
+	ID int64 `json:"id"`
+	// Not a foreign key so that the logs remain after the admin is deleted.
+	ActorID    int64  `json:"actor_id"`
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	// ID of the user or book identified by target_type. NULL when the action has no single target, such as searching users.
+	TargetID  sql.NullInt64   `json:"target_id"`
+	Detail    json.RawMessage `json:"detail"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
 // Stores author data.
 type Author struct {
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Stores names of authors merged into another author. New registrations with an alias resolve to the canonical name.
+type AuthorAlias struct {
+	Alias         string    `json:"alias"`
+	CanonicalName string    `json:"canonical_name"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
 // Stores book data.
 type Book struct {
 	ID            int64          `json:"id"`
@@ -76,6 +442,19 @@ type Book struct {
 	Isbn          sql.NullString `json:"isbn"`
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
+	PageCount     sql.NullInt32  `json:"page_count"`
+	// Incremented on every update. Used for optimistic concurrency control.
+	Version int64 `json:"version"`
+}
+
+// Stores book clubs. Members read current_book_id together following club_sections.
+type BookClub struct {
+	ID            int64          `json:"id"`
+	Name          string         `json:"name"`
+	Description   sql.NullString `json:"description"`
+	CurrentBookID sql.NullInt64  `json:"current_book_id"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
 }
 
 // Stores book and genre. Normalize using intermediate tables because of the many-to-many relationship between books and genres.
@@ -84,18 +463,171 @@ type BookGenre struct {
 	GenreName string `json:"genre_name"`
 }
 
+// Stores notes users write about books in their library.
+type BookNote struct {
+	// Generated by the client so that notes can be created offline.
+	ID        uuid.UUID `json:"id"`
+	UserID    int64     `json:"user_id"`
+	BookID    int64     `json:"book_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Stores cached book recommendations per user. Rebuilt by the background job.
+type BookRecommendation struct {
+	UserID int64                `json:"user_id"`
+	BookID int64                `json:"book_id"`
+	Rank   int32                `json:"rank"`
+	Score  int64                `json:"score"`
+	Reason RecommendationReason `json:"reason"`
+	// Author or genre name that explains the recommendation.
+	ReasonName string `json:"reason_name"`
+	// Number of finished books that share the author or genre.
+	ReasonCount int64     `json:"reason_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Stores tags users attach to books in their library.
+type BookTag struct {
+	UserID    int64     `json:"user_id"`
+	BookID    int64     `json:"book_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Stores pending invitations. An invitation is deleted when the invitee joins the club.
+type ClubInvitation struct {
+	ClubID    int64     `json:"club_id"`
+	InviteeID int64     `json:"invitee_id"`
+	InviterID int64     `json:"inviter_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ClubMember struct {
+	ClubID int64    `json:"club_id"`
+	UserID int64    `json:"user_id"`
+	Role   ClubRole `json:"role"`
+	// Reading progress of the current book. Reset when the current book changes.
+	CurrentPage       int32        `json:"current_page"`
+	ProgressUpdatedAt sql.NullTime `json:"progress_updated_at"`
+	JoinedAt          time.Time    `json:"joined_at"`
+}
+
+// Stores discussion posts. Each section has its own thread.
+type ClubPost struct {
+	ID        int64     `json:"id"`
+	SectionID int64     `json:"section_id"`
+	UserID    int64     `json:"user_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Stores the reading schedule of a club. Each section is a chapter or page to reach by due_date.
+type ClubSection struct {
+	ID         int64          `json:"id"`
+	ClubID     int64          `json:"club_id"`
+	BookID     int64          `json:"book_id"`
+	Position   int32          `json:"position"`
+	Title      string         `json:"title"`
+	Chapter    sql.NullString `json:"chapter"`
+	TargetPage sql.NullInt32  `json:"target_page"`
+	DueDate    time.Time      `json:"due_date"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+// Stores user activities shown in the feed of followers. Visibility is applied when reading.
+type FeedEvent struct {
+	ID        int64         `json:"id"`
+	UserID    int64         `json:"user_id"`
+	BookID    int64         `json:"book_id"`
+	EventType FeedEventType `json:"event_type"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// Stores follow relationships between users.
+type Follow struct {
+	FollowerID int64     `json:"follower_id"`
+	FolloweeID int64     `json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
 // Stores genre data.
 type Genre struct {
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Stores names of genres merged into another genre. New registrations with an alias resolve to the canonical name.
+type GenreAlias struct {
+	Alias         string    `json:"alias"`
+	CanonicalName string    `json:"canonical_name"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Stores responses of mutating requests so that retried requests with the same Idempotency-Key are not executed twice.
+type IdempotencyKey struct {
+	UserID int64  `json:"user_id"`
+	Key    string `json:"key"`
+	Method string `json:"method"`
+	// SHA-256 of the request payload, used to reject a reused key with a different payload.
+	RequestHash string `json:"request_hash"`
+	// Serialized response. NULL while the first request is still being processed.
+	Response  []byte    `json:"response"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Stores books lent to other people. A loan without return_date is active.
+type Loan struct {
+	ID           int64  `json:"id"`
+	UserID       int64  `json:"user_id"`
+	BookID       int64  `json:"book_id"`
+	BorrowerName string `json:"borrower_name"`
+	// Set when the borrower is a registered user.
+	BorrowerUserID sql.NullInt64 `json:"borrower_user_id"`
+	LoanDate       time.Time     `json:"loan_date"`
+	DueDate        sql.NullTime  `json:"due_date"`
+	ReturnDate     sql.NullTime  `json:"return_date"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// Domain events written in the same transaction as the change. The relay publishes them in id order.
+type OutboxEvent struct {
+	ID        int64           `json:"id"`
+	EventType string          `json:"event_type"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+	// When the event was delivered to all sinks. NULL means it is waiting for the relay.
+	PublishedAt sql.NullTime `json:"published_at"`
+	// Order in which the relay published the event. ids are assigned at insert time and may commit out of order, so subscribers resume from this instead.
+	PublishedSeq sql.NullInt64 `json:"published_seq"`
+}
+
 // Stores publisher data.
 type Publisher struct {
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// Stores names of publishers merged into another publisher. New registrations with an alias resolve to the canonical name.
+type PublisherAlias struct {
+	Alias         string    `json:"alias"`
+	CanonicalName string    `json:"canonical_name"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Stores reading activities such as status changes and progress updates. Used to compute reading streaks.
+type ReadingActivity struct {
+	ID           int64        `json:"id"`
+	UserID       int64        `json:"user_id"`
+	BookID       int64        `json:"book_id"`
+	ActivityType ActivityType `json:"activity_type"`
+	OccurredAt   time.Time    `json:"occurred_at"`
+}
+
 // Stores reading history.
 type ReadingHistory struct {
 	UserID    int64         `json:"user_id"`
@@ -105,6 +637,27 @@ type ReadingHistory struct {
 	EndDate   sql.NullTime  `json:"end_date"`
 	CreatedAt time.Time     `json:"created_at"`
 	UpdatedAt time.Time     `json:"updated_at"`
+	Priority  int16         `json:"priority"`
+	// Position in the want-to-read queue. Only unread histories have a position.
+	QueuePosition sql.NullInt32  `json:"queue_position"`
+	Owned         bool           `json:"owned"`
+	Format        NullBookFormat `json:"format"`
+	// Price in the smallest unit of purchase_currency (e.g. yen, cents).
+	PurchasePrice sql.NullInt64 `json:"purchase_price"`
+	// ISO 4217 currency code.
+	PurchaseCurrency sql.NullString `json:"purchase_currency"`
+	PurchaseStore    sql.NullString `json:"purchase_store"`
+	PurchaseDate     sql.NullTime   `json:"purchase_date"`
+	// Length of the audiobook. Only audiobooks have a duration.
+	DurationMinutes sql.NullInt32 `json:"duration_minutes"`
+	// Incremented on every update. Exposed to clients as an etag.
+	Version int64 `json:"version"`
+}
+
+// Stores when the recommendations of each user were last rebuilt.
+type RecommendationRefresh struct {
+	UserID      int64     `json:"user_id"`
+	RefreshedAt time.Time `json:"refreshed_at"`
 }
 
 // Stores session data.
@@ -120,6 +673,24 @@ type Session struct {
 	RevokedAt    sql.NullTime   `json:"revoked_at"`
 }
 
+// Overrides the library visibility for a shelf. A shelf is the set of books with the same reading status.
+type ShelfVisibility struct {
+	UserID     int64         `json:"user_id"`
+	Status     ReadingStatus `json:"status"`
+	Visibility Visibility    `json:"visibility"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
+// Records deleted books, tags and notes so that clients can remove them during sync.
+type SyncTombstone struct {
+	ID         int64  `json:"id"`
+	UserID     int64  `json:"user_id"`
+	EntityType string `json:"entity_type"`
+	// Book id for books, "<book_id>/<name>" for tags and the note id for notes.
+	EntityKey string    `json:"entity_key"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
 // Stores user data.
 type User struct {
 	ID             int64     `json:"id"`
@@ -128,4 +699,44 @@ type User struct {
 	HashedPassword string    `json:"hashed_password"`
 	CreatedAt      time.Time `json:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at"`
+	Timezone       string    `json:"timezone"`
+	// Who can see the user's activities in their feed.
+	ActivityVisibility Visibility `json:"activity_visibility"`
+	// Who can see the user's profile.
+	ProfileVisibility Visibility `json:"profile_visibility"`
+	// Default visibility of the user's shelves.
+	LibraryVisibility Visibility `json:"library_visibility"`
+	// librarian can edit the shared catalog. admin can also manage users.
+	Role UserRole `json:"role"`
+	// When an admin disabled the account. NULL means the user can sign in.
+	DisabledAt sql.NullTime `json:"disabled_at"`
+}
+
+// Stores each event sent to a webhook endpoint and the result of the last attempt.
+type WebhookDelivery struct {
+	ID         int64                 `json:"id"`
+	EndpointID int64                 `json:"endpoint_id"`
+	EventID    int64                 `json:"event_id"`
+	EventType  string                `json:"event_type"`
+	Body       json.RawMessage       `json:"body"`
+	Status     WebhookDeliveryStatus `json:"status"`
+	Attempts   int32                 `json:"attempts"`
+	// When the next attempt is made. Also pushed forward while an attempt is in progress.
+	NextAttemptAt      time.Time      `json:"next_attempt_at"`
+	LastResponseStatus sql.NullInt32  `json:"last_response_status"`
+	LastError          sql.NullString `json:"last_error"`
+	DeliveredAt        sql.NullTime   `json:"delivered_at"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+}
+
+// Stores URLs registered by users to receive domain events.
+type WebhookEndpoint struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"user_id"`
+	Url    string `json:"url"`
+	// Key used to sign the request body with HMAC-SHA256.
+	Secret     string    `json:"secret"`
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at"`
 }
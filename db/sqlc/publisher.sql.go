@@ -7,11 +7,13 @@ package db
 
 import (
 	"context"
+
+	"github.com/lib/pq"
 )
 
 const createPublisher = `-- name: CreatePublisher :one
 INSERT INTO publishers (name)
-VALUES ($1) RETURNING name, created_at
+VALUES ($1) ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name RETURNING name, created_at
 `
 
 func (q *Queries) CreatePublisher(ctx context.Context, name string) (Publisher, error) {
@@ -66,6 +68,43 @@ func (q *Queries) GetAllPublishers(ctx context.Context, arg GetAllPublishersPara
 	return items, nil
 }
 
+const getDuplicatePublishers = `-- name: GetDuplicatePublishers :many
+SELECT normalize_catalog_name(name)::varchar   AS normalized_name,
+       array_agg(name ORDER BY name)::varchar[] AS names
+FROM publishers
+GROUP BY normalized_name
+HAVING count(*) > 1
+ORDER BY normalized_name
+`
+
+type GetDuplicatePublishersRow struct {
+	NormalizedName string   `json:"normalized_name"`
+	Names          []string `json:"names"`
+}
+
+func (q *Queries) GetDuplicatePublishers(ctx context.Context) ([]GetDuplicatePublishersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDuplicatePublishers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDuplicatePublishersRow{}
+	for rows.Next() {
+		var i GetDuplicatePublishersRow
+		if err := rows.Scan(&i.NormalizedName, pq.Array(&i.Names)); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPublisherByName = `-- name: GetPublisherByName :one
 SELECT name, created_at
 FROM publishers
@@ -7,51 +7,165 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error)
+	CopyBookGenres(ctx context.Context, arg CopyBookGenresParams) (int64, error)
+	CountReadingHistoriesByBook(ctx context.Context, bookID int64) (int64, error)
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) (AuditLog, error)
 	CreateAuthor(ctx context.Context, name string) (Author, error)
+	CreateAuthorAlias(ctx context.Context, arg CreateAuthorAliasParams) (AuthorAlias, error)
 	CreateBook(ctx context.Context, arg CreateBookParams) (Book, error)
+	CreateBookClub(ctx context.Context, arg CreateBookClubParams) (BookClub, error)
 	CreateBookGenre(ctx context.Context, arg CreateBookGenreParams) (BookGenre, error)
+	CreateBookRecommendation(ctx context.Context, arg CreateBookRecommendationParams) (BookRecommendation, error)
+	CreateClubInvitation(ctx context.Context, arg CreateClubInvitationParams) (ClubInvitation, error)
+	CreateClubMember(ctx context.Context, arg CreateClubMemberParams) (ClubMember, error)
+	CreateClubPost(ctx context.Context, arg CreateClubPostParams) (ClubPost, error)
+	CreateFeedEvent(ctx context.Context, arg CreateFeedEventParams) (FeedEvent, error)
+	CreateFollow(ctx context.Context, arg CreateFollowParams) (Follow, error)
 	CreateGenre(ctx context.Context, name string) (Genre, error)
+	CreateGenreAlias(ctx context.Context, arg CreateGenreAliasParams) (GenreAlias, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (OutboxEvent, error)
 	CreatePublisher(ctx context.Context, name string) (Publisher, error)
+	CreatePublisherAlias(ctx context.Context, arg CreatePublisherAliasParams) (PublisherAlias, error)
+	CreateReadingActivity(ctx context.Context, arg CreateReadingActivityParams) (ReadingActivity, error)
 	CreateReadingHistory(ctx context.Context, arg CreateReadingHistoryParams) (ReadingHistory, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateSyncTombstone(ctx context.Context, arg CreateSyncTombstoneParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
 	DeleteAuthor(ctx context.Context, name string) error
 	DeleteBook(ctx context.Context, id int64) (int64, error)
 	DeleteBookGenre(ctx context.Context, arg DeleteBookGenreParams) (int64, error)
+	DeleteBookGenresByGenre(ctx context.Context, genreName string) (int64, error)
+	DeleteBookNote(ctx context.Context, arg DeleteBookNoteParams) (int64, error)
+	DeleteBookRecommendationsByUser(ctx context.Context, userID int64) error
+	DeleteBookTag(ctx context.Context, arg DeleteBookTagParams) (int64, error)
+	DeleteClubInvitation(ctx context.Context, arg DeleteClubInvitationParams) (int64, error)
+	DeleteClubMember(ctx context.Context, arg DeleteClubMemberParams) (int64, error)
+	DeleteClubSectionsAfter(ctx context.Context, arg DeleteClubSectionsAfterParams) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, userID int64) error
+	DeleteFollow(ctx context.Context, arg DeleteFollowParams) (int64, error)
 	DeleteGenre(ctx context.Context, name string) error
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error
 	DeletePublisher(ctx context.Context, name string) error
+	DeleteReadingHistoriesByBook(ctx context.Context, bookID int64) ([]int64, error)
 	DeleteReadingHistory(ctx context.Context, arg DeleteReadingHistoryParams) (int64, error)
 	DeleteSessionByUserID(ctx context.Context, arg DeleteSessionByUserIDParams) (int64, error)
+	DeleteSyncTombstone(ctx context.Context, arg DeleteSyncTombstoneParams) error
 	DeleteUser(ctx context.Context, id int64) error
+	DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error)
+	GetActiveLoanByBook(ctx context.Context, arg GetActiveLoanByBookParams) (Loan, error)
+	GetActiveLoans(ctx context.Context, userID int64) ([]GetActiveLoansRow, error)
+	GetActivityDates(ctx context.Context, arg GetActivityDatesParams) ([]time.Time, error)
 	GetAllAuthors(ctx context.Context, arg GetAllAuthorsParams) ([]Author, error)
 	GetAllGenres(ctx context.Context, arg GetAllGenresParams) ([]Genre, error)
 	GetAllPublishers(ctx context.Context, arg GetAllPublishersParams) ([]Publisher, error)
 	GetAllUsers(ctx context.Context, arg GetAllUsersParams) ([]User, error)
+	GetAuditLogs(ctx context.Context, arg GetAuditLogsParams) ([]AuditLog, error)
 	GetAuthorByName(ctx context.Context, name string) (Author, error)
+	GetAverageReadingDays(ctx context.Context, arg GetAverageReadingDaysParams) (GetAverageReadingDaysRow, error)
+	GetBookClubByID(ctx context.Context, id int64) (BookClub, error)
+	GetBookNote(ctx context.Context, arg GetBookNoteParams) (BookNote, error)
+	GetBookNotesUpdatedSince(ctx context.Context, arg GetBookNotesUpdatedSinceParams) ([]BookNote, error)
+	GetBookRecommendations(ctx context.Context, arg GetBookRecommendationsParams) ([]GetBookRecommendationsRow, error)
+	GetBookTag(ctx context.Context, arg GetBookTagParams) (BookTag, error)
+	GetBookTagsUpdatedSince(ctx context.Context, arg GetBookTagsUpdatedSinceParams) ([]BookTag, error)
 	GetBooksByAuthor(ctx context.Context, authorName sql.NullString) ([]GetBooksByAuthorRow, error)
 	GetBooksByID(ctx context.Context, id int64) (GetBooksByIDRow, error)
 	GetBooksByISBN(ctx context.Context, isbn sql.NullString) ([]GetBooksByISBNRow, error)
 	GetBooksByPublisher(ctx context.Context, publisherName sql.NullString) ([]GetBooksByPublisherRow, error)
 	GetBooksByTitle(ctx context.Context, title string) ([]GetBooksByTitleRow, error)
+	GetCanonicalAuthorName(ctx context.Context, alias string) (string, error)
+	GetCanonicalGenreName(ctx context.Context, alias string) (string, error)
+	GetCanonicalPublisherName(ctx context.Context, alias string) (string, error)
+	GetClubMember(ctx context.Context, arg GetClubMemberParams) (ClubMember, error)
+	GetClubMembers(ctx context.Context, clubID int64) ([]GetClubMembersRow, error)
+	GetClubPosts(ctx context.Context, arg GetClubPostsParams) ([]GetClubPostsRow, error)
+	GetClubSectionByID(ctx context.Context, id int64) (ClubSection, error)
+	GetClubSections(ctx context.Context, arg GetClubSectionsParams) ([]ClubSection, error)
+	GetDailyActivityCounts(ctx context.Context, arg GetDailyActivityCountsParams) ([]GetDailyActivityCountsRow, error)
+	GetDuplicateAuthors(ctx context.Context) ([]GetDuplicateAuthorsRow, error)
+	GetDuplicateGenres(ctx context.Context) ([]GetDuplicateGenresRow, error)
+	GetDuplicatePublishers(ctx context.Context) ([]GetDuplicatePublishersRow, error)
+	GetFeed(ctx context.Context, arg GetFeedParams) ([]GetFeedRow, error)
+	GetFinishedAuthorCounts(ctx context.Context, arg GetFinishedAuthorCountsParams) ([]GetFinishedAuthorCountsRow, error)
+	GetFinishedBookCountsByMonth(ctx context.Context, arg GetFinishedBookCountsByMonthParams) ([]GetFinishedBookCountsByMonthRow, error)
+	GetFinishedBooks(ctx context.Context, arg GetFinishedBooksParams) ([]GetFinishedBooksRow, error)
+	GetFinishedGenreCounts(ctx context.Context, arg GetFinishedGenreCountsParams) ([]GetFinishedGenreCountsRow, error)
+	GetFinishedPublisherCounts(ctx context.Context, arg GetFinishedPublisherCountsParams) ([]GetFinishedPublisherCountsRow, error)
+	GetFollowers(ctx context.Context, arg GetFollowersParams) ([]GetFollowersRow, error)
+	GetFollowing(ctx context.Context, arg GetFollowingParams) ([]GetFollowingRow, error)
 	GetGenreByName(ctx context.Context, name string) (Genre, error)
 	GetGenresByBookID(ctx context.Context, bookID int64) ([]string, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetLatestOutboxPublishedSeq(ctx context.Context) (int64, error)
+	GetLoanByID(ctx context.Context, arg GetLoanByIDParams) (GetLoanByIDRow, error)
+	GetMonthlySpending(ctx context.Context, arg GetMonthlySpendingParams) ([]GetMonthlySpendingRow, error)
+	GetNextOutboxPublishedSeq(ctx context.Context) (int64, error)
+	GetNextQueuePosition(ctx context.Context, userID int64) (int32, error)
+	GetOverdueLoans(ctx context.Context, userID int64) ([]GetOverdueLoansRow, error)
+	GetPublicReadingHistoryByUser(ctx context.Context, arg GetPublicReadingHistoryByUserParams) ([]GetPublicReadingHistoryByUserRow, error)
+	GetPublishedOutboxEventsByUser(ctx context.Context, arg GetPublishedOutboxEventsByUserParams) ([]OutboxEvent, error)
 	GetPublisherByName(ctx context.Context, name string) (Publisher, error)
+	GetReadingHistoriesUpdatedSince(ctx context.Context, arg GetReadingHistoriesUpdatedSinceParams) ([]GetReadingHistoriesUpdatedSinceRow, error)
 	GetReadingHistoryByUser(ctx context.Context, arg GetReadingHistoryByUserParams) ([]GetReadingHistoryByUserRow, error)
 	GetReadingHistoryByUserAndBook(ctx context.Context, arg GetReadingHistoryByUserAndBookParams) (GetReadingHistoryByUserAndBookRow, error)
 	GetReadingHistoryByUserAndStatus(ctx context.Context, arg GetReadingHistoryByUserAndStatusParams) ([]GetReadingHistoryByUserAndStatusRow, error)
+	GetReadingHistoryForSync(ctx context.Context, arg GetReadingHistoryForSyncParams) (GetReadingHistoryForSyncRow, error)
+	GetReadingQueue(ctx context.Context, userID int64) ([]GetReadingQueueRow, error)
+	GetRecommendationCandidates(ctx context.Context, arg GetRecommendationCandidatesParams) ([]GetRecommendationCandidatesRow, error)
+	GetRecommendationRefresh(ctx context.Context, userID int64) (RecommendationRefresh, error)
 	GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error)
 	GetSessionByUserID(ctx context.Context, userID int64) ([]Session, error)
+	GetShelfVisibilities(ctx context.Context, userID int64) ([]ShelfVisibility, error)
+	GetSpendingByFormat(ctx context.Context, arg GetSpendingByFormatParams) ([]GetSpendingByFormatRow, error)
+	GetStaleRecommendationUsers(ctx context.Context, arg GetStaleRecommendationUsersParams) ([]int64, error)
+	GetSyncTombstone(ctx context.Context, arg GetSyncTombstoneParams) (SyncTombstone, error)
+	GetSyncTombstonesSince(ctx context.Context, arg GetSyncTombstonesSinceParams) ([]SyncTombstone, error)
+	GetUnpublishedOutboxEvents(ctx context.Context, limit int32) ([]OutboxEvent, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id int64) (User, error)
+	GetWebhookDeliveriesByEndpoint(ctx context.Context, arg GetWebhookDeliveriesByEndpointParams) ([]WebhookDelivery, error)
+	GetWebhookEndpointByID(ctx context.Context, arg GetWebhookEndpointByIDParams) (WebhookEndpoint, error)
+	GetWebhookEndpointsByEvent(ctx context.Context, arg GetWebhookEndpointsByEventParams) ([]WebhookEndpoint, error)
+	GetWebhookEndpointsByUser(ctx context.Context, userID int64) ([]WebhookEndpoint, error)
+	MarkOutboxEventPublished(ctx context.Context, arg MarkOutboxEventPublishedParams) error
+	ResetClubProgress(ctx context.Context, clubID int64) error
+	ReturnLoan(ctx context.Context, arg ReturnLoanParams) (Loan, error)
+	RevokeSessionsByUserID(ctx context.Context, userID int64) (int64, error)
+	UpdateAuthorAliasesCanonicalName(ctx context.Context, arg UpdateAuthorAliasesCanonicalNameParams) (int64, error)
 	UpdateBook(ctx context.Context, arg UpdateBookParams) (Book, error)
+	UpdateBookClubCurrentBook(ctx context.Context, arg UpdateBookClubCurrentBookParams) (BookClub, error)
+	UpdateBooksAuthorName(ctx context.Context, arg UpdateBooksAuthorNameParams) (int64, error)
+	UpdateBooksPublisherName(ctx context.Context, arg UpdateBooksPublisherNameParams) (int64, error)
+	UpdateClubMemberProgress(ctx context.Context, arg UpdateClubMemberProgressParams) (ClubMember, error)
+	UpdateClubMemberRole(ctx context.Context, arg UpdateClubMemberRoleParams) (ClubMember, error)
+	UpdateGenreAliasesCanonicalName(ctx context.Context, arg UpdateGenreAliasesCanonicalNameParams) (int64, error)
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) error
+	UpdatePublisherAliasesCanonicalName(ctx context.Context, arg UpdatePublisherAliasesCanonicalNameParams) (int64, error)
+	UpdateQueuePosition(ctx context.Context, arg UpdateQueuePositionParams) (int64, error)
 	UpdateReadingHistory(ctx context.Context, arg UpdateReadingHistoryParams) (ReadingHistory, error)
 	UpdateSession(ctx context.Context, arg UpdateSessionParams) (Session, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserDisabledAt(ctx context.Context, arg UpdateUserDisabledAtParams) (User, error)
+	UpdateUserPrivacy(ctx context.Context, arg UpdateUserPrivacyParams) (User, error)
+	UpdateUserTimezone(ctx context.Context, arg UpdateUserTimezoneParams) (User, error)
+	UpdateWebhookDeliveryResult(ctx context.Context, arg UpdateWebhookDeliveryResultParams) error
+	UpdateWishlistEntry(ctx context.Context, arg UpdateWishlistEntryParams) (ReadingHistory, error)
+	UpsertBookNote(ctx context.Context, arg UpsertBookNoteParams) (BookNote, error)
+	UpsertBookTag(ctx context.Context, arg UpsertBookTagParams) (BookTag, error)
+	UpsertClubSection(ctx context.Context, arg UpsertClubSectionParams) (ClubSection, error)
+	UpsertRecommendationRefresh(ctx context.Context, userID int64) (RecommendationRefresh, error)
+	UpsertShelfVisibility(ctx context.Context, arg UpsertShelfVisibilityParams) (ShelfVisibility, error)
 }
 
 var _ Querier = (*Queries)(nil)
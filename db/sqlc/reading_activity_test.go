@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func createTestReadingActivity(t *testing.T, user User, book Book, occurredAt time.Time) ReadingActivity {
+	arg := CreateReadingActivityParams{
+		UserID:       user.ID,
+		BookID:       book.ID,
+		ActivityType: ActivityTypeStatusChanged,
+		OccurredAt:   occurredAt,
+	}
+	a, err := querier.CreateReadingActivity(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.UserID, a.UserID)
+	require.Equal(t, arg.BookID, a.BookID)
+	require.Equal(t, arg.ActivityType, a.ActivityType)
+	require.WithinDuration(t, arg.OccurredAt, a.OccurredAt, time.Second)
+	return a
+}
+
+func TestCreateReadingActivity(t *testing.T) {
+	user := createRandomUser(t)
+	book := createTestBook(t, testdata.RandomString(6), "", "", testdata.RandomString(13))
+	createTestReadingActivity(t, user, book, time.Now())
+}
+
+func TestReadingActivityDates(t *testing.T) {
+	user := createRandomUser(t)
+	book := createTestBook(t, testdata.RandomString(6), "", "", testdata.RandomString(13))
+
+	// UTCでは1/1だが、Asia/Tokyoでは1/2になる
+	createTestReadingActivity(t, user, book, time.Date(2024, 1, 1, 16, 0, 0, 0, time.UTC))
+	createTestReadingActivity(t, user, book, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC))
+	createTestReadingActivity(t, user, book, time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name     string
+		timezone string
+		dates    []time.Time
+	}{
+		{
+			name:     "UTC",
+			timezone: "UTC",
+			dates: []time.Time{
+				time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "Asia/Tokyo",
+			timezone: "Asia/Tokyo",
+			dates: []time.Time{
+				time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dates, err := querier.GetActivityDates(context.Background(), GetActivityDatesParams{
+				Timezone: tc.timezone,
+				UserID:   user.ID,
+			})
+			require.NoError(t, err)
+			require.Len(t, dates, len(tc.dates))
+			for i, d := range dates {
+				require.True(t, tc.dates[i].Equal(d))
+			}
+		})
+	}
+
+	counts, err := querier.GetDailyActivityCounts(context.Background(), GetDailyActivityCountsParams{
+		Timezone: "UTC",
+		UserID:   user.ID,
+		FromDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+	require.Equal(t, int64(2), counts[0].ActivityCount)
+}
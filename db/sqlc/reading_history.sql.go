@@ -8,19 +8,43 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
+const countReadingHistoriesByBook = `-- name: CountReadingHistoriesByBook :one
+SELECT COUNT(*)
+FROM reading_histories
+WHERE book_id = $1
+`
+
+func (q *Queries) CountReadingHistoriesByBook(ctx context.Context, bookID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countReadingHistoriesByBook, bookID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createReadingHistory = `-- name: CreateReadingHistory :one
-INSERT INTO reading_histories (user_id, book_id, status, start_date, end_date)
-VALUES ($1, $2, $3, $4, $5) RETURNING user_id, book_id, status, start_date, end_date, created_at, updated_at
+INSERT INTO reading_histories (user_id, book_id, status, start_date, end_date, priority, queue_position, owned, format,
+                               purchase_price, purchase_currency, purchase_store, purchase_date, duration_minutes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) RETURNING user_id, book_id, status, start_date, end_date, created_at, updated_at, priority, queue_position, owned, format, purchase_price, purchase_currency, purchase_store, purchase_date, duration_minutes, version
 `
 
 type CreateReadingHistoryParams struct {
-	UserID    int64         `json:"user_id"`
-	BookID    int64         `json:"book_id"`
-	Status    ReadingStatus `json:"status"`
-	StartDate sql.NullTime  `json:"start_date"`
-	EndDate   sql.NullTime  `json:"end_date"`
+	UserID           int64          `json:"user_id"`
+	BookID           int64          `json:"book_id"`
+	Status           ReadingStatus  `json:"status"`
+	StartDate        sql.NullTime   `json:"start_date"`
+	EndDate          sql.NullTime   `json:"end_date"`
+	Priority         int16          `json:"priority"`
+	QueuePosition    sql.NullInt32  `json:"queue_position"`
+	Owned            bool           `json:"owned"`
+	Format           NullBookFormat `json:"format"`
+	PurchasePrice    sql.NullInt64  `json:"purchase_price"`
+	PurchaseCurrency sql.NullString `json:"purchase_currency"`
+	PurchaseStore    sql.NullString `json:"purchase_store"`
+	PurchaseDate     sql.NullTime   `json:"purchase_date"`
+	DurationMinutes  sql.NullInt32  `json:"duration_minutes"`
 }
 
 func (q *Queries) CreateReadingHistory(ctx context.Context, arg CreateReadingHistoryParams) (ReadingHistory, error) {
@@ -30,6 +54,15 @@ func (q *Queries) CreateReadingHistory(ctx context.Context, arg CreateReadingHis
 		arg.Status,
 		arg.StartDate,
 		arg.EndDate,
+		arg.Priority,
+		arg.QueuePosition,
+		arg.Owned,
+		arg.Format,
+		arg.PurchasePrice,
+		arg.PurchaseCurrency,
+		arg.PurchaseStore,
+		arg.PurchaseDate,
+		arg.DurationMinutes,
 	)
 	var i ReadingHistory
 	err := row.Scan(
@@ -40,10 +73,49 @@ func (q *Queries) CreateReadingHistory(ctx context.Context, arg CreateReadingHis
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Priority,
+		&i.QueuePosition,
+		&i.Owned,
+		&i.Format,
+		&i.PurchasePrice,
+		&i.PurchaseCurrency,
+		&i.PurchaseStore,
+		&i.PurchaseDate,
+		&i.DurationMinutes,
+		&i.Version,
 	)
 	return i, err
 }
 
+const deleteReadingHistoriesByBook = `-- name: DeleteReadingHistoriesByBook :many
+DELETE
+FROM reading_histories
+WHERE book_id = $1 RETURNING user_id
+`
+
+func (q *Queries) DeleteReadingHistoriesByBook(ctx context.Context, bookID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, deleteReadingHistoriesByBook, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var user_id int64
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteReadingHistory = `-- name: DeleteReadingHistory :execrows
 DELETE
 FROM reading_histories
@@ -64,7 +136,20 @@ func (q *Queries) DeleteReadingHistory(ctx context.Context, arg DeleteReadingHis
 	return result.RowsAffected()
 }
 
-const getReadingHistoryByUser = `-- name: GetReadingHistoryByUser :many
+const getNextQueuePosition = `-- name: GetNextQueuePosition :one
+SELECT (COALESCE(MAX(queue_position), 0) + 1)::integer AS next_position
+FROM reading_histories
+WHERE user_id = $1
+`
+
+func (q *Queries) GetNextQueuePosition(ctx context.Context, userID int64) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getNextQueuePosition, userID)
+	var next_position int32
+	err := row.Scan(&next_position)
+	return next_position, err
+}
+
+const getPublicReadingHistoryByUser = `-- name: GetPublicReadingHistoryByUser :many
 WITH genre_aggregation AS (SELECT bg.book_id,
                                   STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
                            FROM book_genres bg
@@ -81,24 +166,28 @@ SELECT b.id,
        b.publisher_name,
        b.published_date,
        b.isbn,
+       b.page_count,
        rh.status,
        rh.start_date,
        rh.end_date
 FROM reading_histories rh
+         JOIN users u ON u.id = rh.user_id
+         LEFT JOIN shelf_visibilities sv ON sv.user_id = rh.user_id AND sv.status = rh.status
          LEFT JOIN books b ON b.id = rh.book_id
          LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
 WHERE rh.user_id = $1
-ORDER BY rh.created_at LIMIT $2
+  AND COALESCE(sv.visibility, u.library_visibility) = 'public'
+ORDER BY rh.updated_at DESC, b.id DESC LIMIT $2
 OFFSET $3
 `
 
-type GetReadingHistoryByUserParams struct {
+type GetPublicReadingHistoryByUserParams struct {
 	UserID int64 `json:"user_id"`
 	Limit  int32 `json:"limit"`
 	Offset int32 `json:"offset"`
 }
 
-type GetReadingHistoryByUserRow struct {
+type GetPublicReadingHistoryByUserRow struct {
 	ID            sql.NullInt64  `json:"id"`
 	Title         sql.NullString `json:"title"`
 	Genres        []byte         `json:"genres"`
@@ -109,11 +198,251 @@ type GetReadingHistoryByUserRow struct {
 	PublisherName sql.NullString `json:"publisher_name"`
 	PublishedDate sql.NullTime   `json:"published_date"`
 	Isbn          sql.NullString `json:"isbn"`
+	PageCount     sql.NullInt32  `json:"page_count"`
 	Status        ReadingStatus  `json:"status"`
 	StartDate     sql.NullTime   `json:"start_date"`
 	EndDate       sql.NullTime   `json:"end_date"`
 }
 
+func (q *Queries) GetPublicReadingHistoryByUser(ctx context.Context, arg GetPublicReadingHistoryByUserParams) ([]GetPublicReadingHistoryByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPublicReadingHistoryByUser, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetPublicReadingHistoryByUserRow{}
+	for rows.Next() {
+		var i GetPublicReadingHistoryByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Genres,
+			&i.Description,
+			&i.CoverImageUrl,
+			&i.Url,
+			&i.AuthorName,
+			&i.PublisherName,
+			&i.PublishedDate,
+			&i.Isbn,
+			&i.PageCount,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReadingHistoriesUpdatedSince = `-- name: GetReadingHistoriesUpdatedSince :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       b.page_count,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.format,
+       rh.purchase_price,
+       rh.purchase_currency,
+       rh.purchase_store,
+       rh.purchase_date,
+       rh.duration_minutes,
+       GREATEST(rh.updated_at, b.updated_at)::timestamptz AS updated_at
+FROM reading_histories rh
+         JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = $1
+  AND GREATEST(rh.updated_at, b.updated_at) > $2::timestamptz
+ORDER BY updated_at, b.id
+`
+
+type GetReadingHistoriesUpdatedSinceParams struct {
+	UserID int64     `json:"user_id"`
+	Since  time.Time `json:"since"`
+}
+
+type GetReadingHistoriesUpdatedSinceRow struct {
+	ID               int64          `json:"id"`
+	Title            string         `json:"title"`
+	Genres           []byte         `json:"genres"`
+	Description      sql.NullString `json:"description"`
+	CoverImageUrl    sql.NullString `json:"cover_image_url"`
+	Url              sql.NullString `json:"url"`
+	AuthorName       sql.NullString `json:"author_name"`
+	PublisherName    sql.NullString `json:"publisher_name"`
+	PublishedDate    sql.NullTime   `json:"published_date"`
+	Isbn             sql.NullString `json:"isbn"`
+	PageCount        sql.NullInt32  `json:"page_count"`
+	Status           ReadingStatus  `json:"status"`
+	StartDate        sql.NullTime   `json:"start_date"`
+	EndDate          sql.NullTime   `json:"end_date"`
+	Priority         int16          `json:"priority"`
+	QueuePosition    sql.NullInt32  `json:"queue_position"`
+	Owned            bool           `json:"owned"`
+	Format           NullBookFormat `json:"format"`
+	PurchasePrice    sql.NullInt64  `json:"purchase_price"`
+	PurchaseCurrency sql.NullString `json:"purchase_currency"`
+	PurchaseStore    sql.NullString `json:"purchase_store"`
+	PurchaseDate     sql.NullTime   `json:"purchase_date"`
+	DurationMinutes  sql.NullInt32  `json:"duration_minutes"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+}
+
+func (q *Queries) GetReadingHistoriesUpdatedSince(ctx context.Context, arg GetReadingHistoriesUpdatedSinceParams) ([]GetReadingHistoriesUpdatedSinceRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReadingHistoriesUpdatedSince, arg.UserID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReadingHistoriesUpdatedSinceRow{}
+	for rows.Next() {
+		var i GetReadingHistoriesUpdatedSinceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Genres,
+			&i.Description,
+			&i.CoverImageUrl,
+			&i.Url,
+			&i.AuthorName,
+			&i.PublisherName,
+			&i.PublishedDate,
+			&i.Isbn,
+			&i.PageCount,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Priority,
+			&i.QueuePosition,
+			&i.Owned,
+			&i.Format,
+			&i.PurchasePrice,
+			&i.PurchaseCurrency,
+			&i.PurchaseStore,
+			&i.PurchaseDate,
+			&i.DurationMinutes,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReadingHistoryByUser = `-- name: GetReadingHistoryByUser :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       b.page_count,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.format,
+       rh.purchase_price,
+       rh.purchase_currency,
+       rh.purchase_store,
+       rh.purchase_date,
+       rh.duration_minutes,
+       rh.version,
+       b.version AS book_version,
+       EXISTS (SELECT 1
+               FROM loans l
+                        JOIN users u ON u.id = l.user_id
+               WHERE l.user_id = rh.user_id
+                 AND l.book_id = rh.book_id
+                 AND l.return_date IS NULL
+                 AND l.due_date < (now() AT TIME ZONE u.timezone)::date) AS overdue
+FROM reading_histories rh
+         LEFT JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = $1
+ORDER BY rh.created_at LIMIT $2
+OFFSET $3
+`
+
+type GetReadingHistoryByUserParams struct {
+	UserID int64 `json:"user_id"`
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type GetReadingHistoryByUserRow struct {
+	ID               sql.NullInt64  `json:"id"`
+	Title            sql.NullString `json:"title"`
+	Genres           []byte         `json:"genres"`
+	Description      sql.NullString `json:"description"`
+	CoverImageUrl    sql.NullString `json:"cover_image_url"`
+	Url              sql.NullString `json:"url"`
+	AuthorName       sql.NullString `json:"author_name"`
+	PublisherName    sql.NullString `json:"publisher_name"`
+	PublishedDate    sql.NullTime   `json:"published_date"`
+	Isbn             sql.NullString `json:"isbn"`
+	Status           ReadingStatus  `json:"status"`
+	StartDate        sql.NullTime   `json:"start_date"`
+	EndDate          sql.NullTime   `json:"end_date"`
+	PageCount        sql.NullInt32  `json:"page_count"`
+	Priority         int16          `json:"priority"`
+	QueuePosition    sql.NullInt32  `json:"queue_position"`
+	Owned            bool           `json:"owned"`
+	Format           NullBookFormat `json:"format"`
+	PurchasePrice    sql.NullInt64  `json:"purchase_price"`
+	PurchaseCurrency sql.NullString `json:"purchase_currency"`
+	PurchaseStore    sql.NullString `json:"purchase_store"`
+	PurchaseDate     sql.NullTime   `json:"purchase_date"`
+	DurationMinutes  sql.NullInt32  `json:"duration_minutes"`
+	Version          int64          `json:"version"`
+	BookVersion      sql.NullInt64  `json:"book_version"`
+	Overdue          bool           `json:"overdue"`
+}
+
 func (q *Queries) GetReadingHistoryByUser(ctx context.Context, arg GetReadingHistoryByUserParams) ([]GetReadingHistoryByUserRow, error) {
 	rows, err := q.db.QueryContext(ctx, getReadingHistoryByUser, arg.UserID, arg.Limit, arg.Offset)
 	if err != nil {
@@ -137,6 +466,19 @@ func (q *Queries) GetReadingHistoryByUser(ctx context.Context, arg GetReadingHis
 			&i.Status,
 			&i.StartDate,
 			&i.EndDate,
+			&i.PageCount,
+			&i.Priority,
+			&i.QueuePosition,
+			&i.Owned,
+			&i.Format,
+			&i.PurchasePrice,
+			&i.PurchaseCurrency,
+			&i.PurchaseStore,
+			&i.PurchaseDate,
+			&i.DurationMinutes,
+			&i.Version,
+			&i.BookVersion,
+			&i.Overdue,
 		); err != nil {
 			return nil, err
 		}
@@ -170,7 +512,8 @@ SELECT b.id,
        b.isbn,
        rh.status,
        rh.start_date,
-       rh.end_date
+       rh.end_date,
+       rh.owned
 FROM reading_histories rh
          LEFT JOIN books b ON b.id = rh.book_id
          LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
@@ -197,6 +540,7 @@ type GetReadingHistoryByUserAndBookRow struct {
 	Status        ReadingStatus  `json:"status"`
 	StartDate     sql.NullTime   `json:"start_date"`
 	EndDate       sql.NullTime   `json:"end_date"`
+	Owned         bool           `json:"owned"`
 }
 
 func (q *Queries) GetReadingHistoryByUserAndBook(ctx context.Context, arg GetReadingHistoryByUserAndBookParams) (GetReadingHistoryByUserAndBookRow, error) {
@@ -216,6 +560,7 @@ func (q *Queries) GetReadingHistoryByUserAndBook(ctx context.Context, arg GetRea
 		&i.Status,
 		&i.StartDate,
 		&i.EndDate,
+		&i.Owned,
 	)
 	return i, err
 }
@@ -314,14 +659,250 @@ func (q *Queries) GetReadingHistoryByUserAndStatus(ctx context.Context, arg GetR
 	return items, nil
 }
 
+const getReadingHistoryForSync = `-- name: GetReadingHistoryForSync :one
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       b.page_count,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.format,
+       rh.purchase_price,
+       rh.purchase_currency,
+       rh.purchase_store,
+       rh.purchase_date,
+       rh.duration_minutes,
+       GREATEST(rh.updated_at, b.updated_at)::timestamptz AS updated_at
+FROM reading_histories rh
+         JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = $1
+  AND rh.book_id = $2
+`
+
+type GetReadingHistoryForSyncParams struct {
+	UserID int64 `json:"user_id"`
+	BookID int64 `json:"book_id"`
+}
+
+type GetReadingHistoryForSyncRow struct {
+	ID               int64          `json:"id"`
+	Title            string         `json:"title"`
+	Genres           []byte         `json:"genres"`
+	Description      sql.NullString `json:"description"`
+	CoverImageUrl    sql.NullString `json:"cover_image_url"`
+	Url              sql.NullString `json:"url"`
+	AuthorName       sql.NullString `json:"author_name"`
+	PublisherName    sql.NullString `json:"publisher_name"`
+	PublishedDate    sql.NullTime   `json:"published_date"`
+	Isbn             sql.NullString `json:"isbn"`
+	PageCount        sql.NullInt32  `json:"page_count"`
+	Status           ReadingStatus  `json:"status"`
+	StartDate        sql.NullTime   `json:"start_date"`
+	EndDate          sql.NullTime   `json:"end_date"`
+	Priority         int16          `json:"priority"`
+	QueuePosition    sql.NullInt32  `json:"queue_position"`
+	Owned            bool           `json:"owned"`
+	Format           NullBookFormat `json:"format"`
+	PurchasePrice    sql.NullInt64  `json:"purchase_price"`
+	PurchaseCurrency sql.NullString `json:"purchase_currency"`
+	PurchaseStore    sql.NullString `json:"purchase_store"`
+	PurchaseDate     sql.NullTime   `json:"purchase_date"`
+	DurationMinutes  sql.NullInt32  `json:"duration_minutes"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+}
+
+func (q *Queries) GetReadingHistoryForSync(ctx context.Context, arg GetReadingHistoryForSyncParams) (GetReadingHistoryForSyncRow, error) {
+	row := q.db.QueryRowContext(ctx, getReadingHistoryForSync, arg.UserID, arg.BookID)
+	var i GetReadingHistoryForSyncRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Genres,
+		&i.Description,
+		&i.CoverImageUrl,
+		&i.Url,
+		&i.AuthorName,
+		&i.PublisherName,
+		&i.PublishedDate,
+		&i.Isbn,
+		&i.PageCount,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Priority,
+		&i.QueuePosition,
+		&i.Owned,
+		&i.Format,
+		&i.PurchasePrice,
+		&i.PurchaseCurrency,
+		&i.PurchaseStore,
+		&i.PurchaseDate,
+		&i.DurationMinutes,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReadingQueue = `-- name: GetReadingQueue :many
+WITH genre_aggregation AS (SELECT bg.book_id,
+                                  STRING_AGG(g.name, ', ' ORDER BY g.name) AS genres
+                           FROM book_genres bg
+                                    LEFT JOIN genres g ON bg.genre_name = g.name
+                           GROUP BY bg.book_id)
+
+SELECT b.id,
+       b.title,
+       ga.genres,
+       b.description,
+       b.cover_image_url,
+       b.url,
+       b.author_name,
+       b.publisher_name,
+       b.published_date,
+       b.isbn,
+       rh.status,
+       rh.start_date,
+       rh.end_date,
+       rh.priority,
+       rh.queue_position,
+       rh.owned,
+       rh.version,
+       EXISTS (SELECT 1
+               FROM loans l
+                        JOIN users u ON u.id = l.user_id
+               WHERE l.user_id = rh.user_id
+                 AND l.book_id = rh.book_id
+                 AND l.return_date IS NULL
+                 AND l.due_date < (now() AT TIME ZONE u.timezone)::date) AS overdue
+FROM reading_histories rh
+         LEFT JOIN books b ON b.id = rh.book_id
+         LEFT JOIN genre_aggregation ga ON b.id = ga.book_id
+WHERE rh.user_id = $1
+  AND rh.status = 'unread'
+  AND rh.queue_position IS NOT NULL
+ORDER BY rh.queue_position, rh.priority DESC, rh.created_at
+`
+
+type GetReadingQueueRow struct {
+	ID            sql.NullInt64  `json:"id"`
+	Title         sql.NullString `json:"title"`
+	Genres        []byte         `json:"genres"`
+	Description   sql.NullString `json:"description"`
+	CoverImageUrl sql.NullString `json:"cover_image_url"`
+	Url           sql.NullString `json:"url"`
+	AuthorName    sql.NullString `json:"author_name"`
+	PublisherName sql.NullString `json:"publisher_name"`
+	PublishedDate sql.NullTime   `json:"published_date"`
+	Isbn          sql.NullString `json:"isbn"`
+	Status        ReadingStatus  `json:"status"`
+	StartDate     sql.NullTime   `json:"start_date"`
+	EndDate       sql.NullTime   `json:"end_date"`
+	Priority      int16          `json:"priority"`
+	QueuePosition sql.NullInt32  `json:"queue_position"`
+	Owned         bool           `json:"owned"`
+	Version       int64          `json:"version"`
+	Overdue       bool           `json:"overdue"`
+}
+
+func (q *Queries) GetReadingQueue(ctx context.Context, userID int64) ([]GetReadingQueueRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReadingQueue, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReadingQueueRow{}
+	for rows.Next() {
+		var i GetReadingQueueRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Genres,
+			&i.Description,
+			&i.CoverImageUrl,
+			&i.Url,
+			&i.AuthorName,
+			&i.PublisherName,
+			&i.PublishedDate,
+			&i.Isbn,
+			&i.Status,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Priority,
+			&i.QueuePosition,
+			&i.Owned,
+			&i.Version,
+			&i.Overdue,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateQueuePosition = `-- name: UpdateQueuePosition :execrows
+UPDATE reading_histories
+SET queue_position = $3,
+    version        = version + 1,
+    updated_at     = now()
+WHERE user_id = $1
+  AND book_id = $2
+  AND status = 'unread'
+`
+
+type UpdateQueuePositionParams struct {
+	UserID        int64         `json:"user_id"`
+	BookID        int64         `json:"book_id"`
+	QueuePosition sql.NullInt32 `json:"queue_position"`
+}
+
+func (q *Queries) UpdateQueuePosition(ctx context.Context, arg UpdateQueuePositionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateQueuePosition, arg.UserID, arg.BookID, arg.QueuePosition)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateReadingHistory = `-- name: UpdateReadingHistory :one
 UPDATE reading_histories
-SET status     = $3,
-    start_date = $4,
-    end_date   = $5,
-    updated_at = now()
+SET status         = $3,
+    start_date     = $4,
+    end_date       = $5,
+    queue_position = CASE
+                         WHEN $3 <> 'unread'::reading_status THEN NULL
+                         WHEN queue_position IS NOT NULL THEN queue_position
+                         ELSE (SELECT COALESCE(MAX(rh.queue_position), 0) + 1
+                               FROM reading_histories rh
+                               WHERE rh.user_id = $1) END,
+    version        = version + 1,
+    updated_at     = now()
 WHERE user_id = $1
-  AND book_id = $2 RETURNING user_id, book_id, status, start_date, end_date, created_at, updated_at
+  AND book_id = $2 RETURNING user_id, book_id, status, start_date, end_date, created_at, updated_at, priority, queue_position, owned, format, purchase_price, purchase_currency, purchase_store, purchase_date, duration_minutes, version
 `
 
 type UpdateReadingHistoryParams struct {
@@ -349,6 +930,66 @@ func (q *Queries) UpdateReadingHistory(ctx context.Context, arg UpdateReadingHis
 		&i.EndDate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Priority,
+		&i.QueuePosition,
+		&i.Owned,
+		&i.Format,
+		&i.PurchasePrice,
+		&i.PurchaseCurrency,
+		&i.PurchaseStore,
+		&i.PurchaseDate,
+		&i.DurationMinutes,
+		&i.Version,
+	)
+	return i, err
+}
+
+const updateWishlistEntry = `-- name: UpdateWishlistEntry :one
+UPDATE reading_histories
+SET priority   = $3,
+    owned      = $4,
+    version    = version + 1,
+    updated_at = now()
+WHERE user_id = $1
+  AND book_id = $2
+  AND ($5::bigint IS NULL OR version = $5::bigint) RETURNING user_id, book_id, status, start_date, end_date, created_at, updated_at, priority, queue_position, owned, format, purchase_price, purchase_currency, purchase_store, purchase_date, duration_minutes, version
+`
+
+type UpdateWishlistEntryParams struct {
+	UserID          int64         `json:"user_id"`
+	BookID          int64         `json:"book_id"`
+	Priority        int16         `json:"priority"`
+	Owned           bool          `json:"owned"`
+	ExpectedVersion sql.NullInt64 `json:"expected_version"`
+}
+
+func (q *Queries) UpdateWishlistEntry(ctx context.Context, arg UpdateWishlistEntryParams) (ReadingHistory, error) {
+	row := q.db.QueryRowContext(ctx, updateWishlistEntry,
+		arg.UserID,
+		arg.BookID,
+		arg.Priority,
+		arg.Owned,
+		arg.ExpectedVersion,
+	)
+	var i ReadingHistory
+	err := row.Scan(
+		&i.UserID,
+		&i.BookID,
+		&i.Status,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Priority,
+		&i.QueuePosition,
+		&i.Owned,
+		&i.Format,
+		&i.PurchasePrice,
+		&i.PurchaseCurrency,
+		&i.PurchaseStore,
+		&i.PurchaseDate,
+		&i.DurationMinutes,
+		&i.Version,
 	)
 	return i, err
 }
@@ -251,3 +251,38 @@ func TestDeleteReadingHistory(t *testing.T) {
 	require.EqualError(t, err, sql.ErrNoRows.Error())
 	require.Empty(t, readingHistory2)
 }
+
+func TestReadingQueue(t *testing.T) {
+	user := createRandomUser(t)
+	b1, _, _ := createRandomReadingHistory(t, user, 0, ReadingStatusUnread)
+	b2, _, _ := createRandomReadingHistory(t, user, 0, ReadingStatusUnread)
+	createRandomReadingHistory(t, user, 0, ReadingStatusReading)
+
+	next, err := querier.GetNextQueuePosition(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), next)
+
+	queue, err := querier.GetReadingQueue(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Empty(t, queue)
+
+	for i, b := range []Book{b2, b1} {
+		n, err := querier.UpdateQueuePosition(context.Background(), UpdateQueuePositionParams{
+			UserID:        user.ID,
+			BookID:        b.ID,
+			QueuePosition: sql.NullInt32{Int32: int32(i + 1), Valid: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), n)
+	}
+
+	next, err = querier.GetNextQueuePosition(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), next)
+
+	queue, err = querier.GetReadingQueue(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, queue, 2)
+	require.Equal(t, sql.NullInt64{Int64: b2.ID, Valid: true}, queue[0].ID)
+	require.Equal(t, sql.NullInt64{Int64: b1.ID, Valid: true}, queue[1].ID)
+}
@@ -0,0 +1,114 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func createFinishedReadingHistory(t *testing.T, user User, book Book, startDate time.Time, endDate time.Time) ReadingHistory {
+	arg := CreateReadingHistoryParams{
+		UserID:    user.ID,
+		BookID:    book.ID,
+		Status:    ReadingStatusDone,
+		StartDate: sql.NullTime{Time: startDate, Valid: true},
+		EndDate:   sql.NullTime{Time: endDate, Valid: true},
+	}
+	rh, err := querier.CreateReadingHistory(context.Background(), arg)
+	require.NoError(t, err)
+	return rh
+}
+
+func TestReadingStats(t *testing.T) {
+	user := createRandomUser(t)
+	author := testdata.RandomString(8)
+	publisher := testdata.RandomString(8)
+	genre := createRandomGenre(t)
+
+	b1 := createTestBook(t, testdata.RandomString(6), author, publisher, testdata.RandomString(13))
+	b2 := createTestBook(t, testdata.RandomString(6), author, "", testdata.RandomString(13))
+	b3 := createTestBook(t, testdata.RandomString(6), "", publisher, testdata.RandomString(13))
+	createRandomBookGenre(t, b1, genre)
+	createRandomBookGenre(t, b2, genre)
+
+	createFinishedReadingHistory(t, user, b1,
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC))
+	createFinishedReadingHistory(t, user, b2,
+		time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC))
+	// 集計期間外
+	createFinishedReadingHistory(t, user, b3,
+		time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	months, err := querier.GetFinishedBookCountsByMonth(context.Background(), GetFinishedBookCountsByMonthParams{
+		UserID:   user.ID,
+		FromDate: from,
+		ToDate:   to,
+	})
+	require.NoError(t, err)
+	require.Len(t, months, 2)
+	require.Equal(t, time.January, months[0].Month.Month())
+	require.Equal(t, int64(1), months[0].BookCount)
+	require.Equal(t, time.February, months[1].Month.Month())
+	require.Equal(t, int64(1), months[1].BookCount)
+
+	genres, err := querier.GetFinishedGenreCounts(context.Background(), GetFinishedGenreCountsParams{
+		UserID:   user.ID,
+		FromDate: from,
+		ToDate:   to,
+	})
+	require.NoError(t, err)
+	require.Len(t, genres, 1)
+	require.Equal(t, genre.Name, genres[0].GenreName)
+	require.Equal(t, int64(2), genres[0].BookCount)
+
+	authors, err := querier.GetFinishedAuthorCounts(context.Background(), GetFinishedAuthorCountsParams{
+		UserID:   user.ID,
+		FromDate: from,
+		ToDate:   to,
+		TopLimit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, authors, 1)
+	require.Equal(t, author, authors[0].AuthorName.String)
+	require.Equal(t, int64(2), authors[0].BookCount)
+
+	publishers, err := querier.GetFinishedPublisherCounts(context.Background(), GetFinishedPublisherCountsParams{
+		UserID:   user.ID,
+		FromDate: from,
+		ToDate:   to,
+		TopLimit: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, publishers, 1)
+	require.Equal(t, publisher, publishers[0].PublisherName.String)
+	require.Equal(t, int64(1), publishers[0].BookCount)
+
+	books, err := querier.GetFinishedBooks(context.Background(), GetFinishedBooksParams{
+		UserID:   user.ID,
+		FromDate: from,
+		ToDate:   to,
+	})
+	require.NoError(t, err)
+	require.Len(t, books, 2)
+	require.Equal(t, b1.ID, books[0].ID)
+	require.Equal(t, b1.Title, books[0].Title)
+	require.Equal(t, b2.ID, books[1].ID)
+
+	average, err := querier.GetAverageReadingDays(context.Background(), GetAverageReadingDaysParams{
+		UserID:   user.ID,
+		FromDate: from,
+		ToDate:   to,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), average.BookCount)
+	require.InDelta(t, 15.0, average.AverageDays, 0.001)
+}
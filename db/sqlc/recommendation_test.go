@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestGetRecommendationCandidates(t *testing.T) {
+	user := createRandomUser(t)
+	_, genres, _ := createRandomReadingHistory(t, user, 1, ReadingStatusDone)
+	candidate := createTestBook(t, testdata.RandomString(6), "", "", testdata.RandomString(13))
+	createRandomBookGenre(t, candidate, genres[0])
+	// 登録済みの本は候補に含めない
+	registered, _, _ := createRandomReadingHistory(t, user, 0, ReadingStatusUnread)
+	createRandomBookGenre(t, registered, genres[0])
+
+	rows, err := querier.GetRecommendationCandidates(context.Background(), GetRecommendationCandidatesParams{
+		UserID:         user.ID,
+		CandidateLimit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, candidate.ID, rows[0].BookID)
+	require.Equal(t, sql.NullString{String: genres[0].Name, Valid: true}, rows[0].GenreName)
+	require.Equal(t, int64(1), rows[0].GenreCount)
+	require.Zero(t, rows[0].AuthorCount)
+	require.Equal(t, int64(1), rows[0].Score)
+}
+
+func TestBookRecommendations(t *testing.T) {
+	user := createRandomUser(t)
+	createRandomReadingHistory(t, user, 0, ReadingStatusDone)
+	book := createTestBook(t, testdata.RandomString(6), "", "", testdata.RandomString(13))
+
+	_, err := querier.GetRecommendationRefresh(context.Background(), user.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	arg := CreateBookRecommendationParams{
+		UserID:      user.ID,
+		BookID:      book.ID,
+		Rank:        1,
+		Score:       3,
+		Reason:      RecommendationReasonGenre,
+		ReasonName:  testdata.RandomString(6),
+		ReasonCount: 3,
+	}
+	r, err := querier.CreateBookRecommendation(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.BookID, r.BookID)
+	require.Equal(t, arg.Reason, r.Reason)
+
+	rows, err := querier.GetBookRecommendations(context.Background(), GetBookRecommendationsParams{
+		UserID:      user.ID,
+		ResultLimit: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, book.Title, rows[0].Title)
+	require.Equal(t, arg.ReasonName, rows[0].ReasonName)
+
+	stale, err := querier.GetStaleRecommendationUsers(context.Background(), GetStaleRecommendationUsersParams{
+		StaleBefore: time.Now().Add(-time.Hour),
+		BatchSize:   10000,
+	})
+	require.NoError(t, err)
+	require.Contains(t, stale, user.ID)
+
+	refresh, err := querier.UpsertRecommendationRefresh(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, refresh.UserID)
+
+	stale, err = querier.GetStaleRecommendationUsers(context.Background(), GetStaleRecommendationUsersParams{
+		StaleBefore: time.Now().Add(-time.Hour),
+		BatchSize:   10000,
+	})
+	require.NoError(t, err)
+	require.NotContains(t, stale, user.ID)
+
+	err = querier.DeleteBookRecommendationsByUser(context.Background(), user.ID)
+	require.NoError(t, err)
+	rows, err = querier.GetBookRecommendations(context.Background(), GetBookRecommendationsParams{
+		UserID:      user.ID,
+		ResultLimit: 10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, rows)
+}
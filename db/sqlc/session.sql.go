@@ -147,6 +147,22 @@ func (q *Queries) GetSessionByUserID(ctx context.Context, userID int64) ([]Sessi
 	return items, nil
 }
 
+const revokeSessionsByUserID = `-- name: RevokeSessionsByUserID :execrows
+UPDATE sessions
+SET revoked    = true,
+    revoked_at = now()
+WHERE user_id = $1
+  AND revoked = false
+`
+
+func (q *Queries) RevokeSessionsByUserID(ctx context.Context, userID int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, revokeSessionsByUserID, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateSession = `-- name: UpdateSession :one
 UPDATE sessions
 SET refresh_token = $2,
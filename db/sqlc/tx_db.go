@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+type txKey struct{}
+
+// WithTx 以降のクエリをtxで実行させるためにctxへtxを持たせる
+func WithTx(ctx context.Context, tx *sql.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+func TxFromContext(ctx context.Context) (*sql.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sql.Tx)
+	return tx, ok
+}
+
+// TxDB ctxにトランザクションがあればそのトランザクションで、なければdbでクエリを実行する
+type TxDB struct {
+	db *sql.DB
+}
+
+func NewTxDB(db *sql.DB) *TxDB {
+	return &TxDB{db: db}
+}
+
+func (t *TxDB) conn(ctx context.Context) DBTX {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return t.db
+}
+
+func (t *TxDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return t.conn(ctx).ExecContext(ctx, query, args...)
+}
+
+func (t *TxDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return t.conn(ctx).PrepareContext(ctx, query)
+}
+
+func (t *TxDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return t.conn(ctx).QueryContext(ctx, query, args...)
+}
+
+func (t *TxDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return t.conn(ctx).QueryRowContext(ctx, query, args...)
+}
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createUser = `-- name: CreateUser :one
@@ -15,7 +16,7 @@ INSERT INTO users (name,
                    hashed_password)
 VALUES ($1,
         $2,
-        $3) RETURNING id, name, email, hashed_password, created_at, updated_at
+        $3) RETURNING id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
 `
 
 type CreateUserParams struct {
@@ -34,6 +35,12 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Timezone,
+		&i.ActivityVisibility,
+		&i.ProfileVisibility,
+		&i.LibraryVisibility,
+		&i.Role,
+		&i.DisabledAt,
 	)
 	return i, err
 }
@@ -50,19 +57,23 @@ func (q *Queries) DeleteUser(ctx context.Context, id int64) error {
 }
 
 const getAllUsers = `-- name: GetAllUsers :many
-SELECT id, name, email, hashed_password, created_at, updated_at
+SELECT id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
 FROM users
-ORDER BY id LIMIT $1
-OFFSET $2
+WHERE $1::text IS NULL
+   OR name ILIKE '%' || replace(replace(replace($1::text, '\', '\\'), '%', '\%'), '_', '\_') || '%' ESCAPE '\'
+   OR email ILIKE '%' || replace(replace(replace($1::text, '\', '\\'), '%', '\%'), '_', '\_') || '%' ESCAPE '\'
+ORDER BY id LIMIT $2
+OFFSET $3
 `
 
 type GetAllUsersParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Query      sql.NullString `json:"query"`
+	PageSize   int32          `json:"page_size"`
+	PageOffset int32          `json:"page_offset"`
 }
 
 func (q *Queries) GetAllUsers(ctx context.Context, arg GetAllUsersParams) ([]User, error) {
-	rows, err := q.db.QueryContext(ctx, getAllUsers, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getAllUsers, arg.Query, arg.PageSize, arg.PageOffset)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +88,12 @@ func (q *Queries) GetAllUsers(ctx context.Context, arg GetAllUsersParams) ([]Use
 			&i.HashedPassword,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Timezone,
+			&i.ActivityVisibility,
+			&i.ProfileVisibility,
+			&i.LibraryVisibility,
+			&i.Role,
+			&i.DisabledAt,
 		); err != nil {
 			return nil, err
 		}
@@ -92,7 +109,7 @@ func (q *Queries) GetAllUsers(ctx context.Context, arg GetAllUsersParams) ([]Use
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, name, email, hashed_password, created_at, updated_at
+SELECT id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
 FROM users
 WHERE email = $1
 `
@@ -107,12 +124,18 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Timezone,
+		&i.ActivityVisibility,
+		&i.ProfileVisibility,
+		&i.LibraryVisibility,
+		&i.Role,
+		&i.DisabledAt,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, name, email, hashed_password, created_at, updated_at
+SELECT id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
 FROM users
 WHERE id = $1
 `
@@ -127,6 +150,12 @@ func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Timezone,
+		&i.ActivityVisibility,
+		&i.ProfileVisibility,
+		&i.LibraryVisibility,
+		&i.Role,
+		&i.DisabledAt,
 	)
 	return i, err
 }
@@ -137,7 +166,7 @@ SET name            = $2,
     email           = $3,
     hashed_password = $4,
     updated_at      = now()
-WHERE id = $1 RETURNING id, name, email, hashed_password, created_at, updated_at
+WHERE id = $1 RETURNING id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
 `
 
 type UpdateUserParams struct {
@@ -162,6 +191,117 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.HashedPassword,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Timezone,
+		&i.ActivityVisibility,
+		&i.ProfileVisibility,
+		&i.LibraryVisibility,
+		&i.Role,
+		&i.DisabledAt,
+	)
+	return i, err
+}
+
+const updateUserDisabledAt = `-- name: UpdateUserDisabledAt :one
+UPDATE users
+SET disabled_at = $2,
+    updated_at  = now()
+WHERE id = $1 RETURNING id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
+`
+
+type UpdateUserDisabledAtParams struct {
+	ID         int64        `json:"id"`
+	DisabledAt sql.NullTime `json:"disabled_at"`
+}
+
+func (q *Queries) UpdateUserDisabledAt(ctx context.Context, arg UpdateUserDisabledAtParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserDisabledAt, arg.ID, arg.DisabledAt)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.HashedPassword,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+		&i.ActivityVisibility,
+		&i.ProfileVisibility,
+		&i.LibraryVisibility,
+		&i.Role,
+		&i.DisabledAt,
+	)
+	return i, err
+}
+
+const updateUserPrivacy = `-- name: UpdateUserPrivacy :one
+UPDATE users
+SET activity_visibility = $2,
+    profile_visibility  = $3,
+    library_visibility  = $4,
+    updated_at          = now()
+WHERE id = $1 RETURNING id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
+`
+
+type UpdateUserPrivacyParams struct {
+	ID                 int64      `json:"id"`
+	ActivityVisibility Visibility `json:"activity_visibility"`
+	ProfileVisibility  Visibility `json:"profile_visibility"`
+	LibraryVisibility  Visibility `json:"library_visibility"`
+}
+
+func (q *Queries) UpdateUserPrivacy(ctx context.Context, arg UpdateUserPrivacyParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserPrivacy,
+		arg.ID,
+		arg.ActivityVisibility,
+		arg.ProfileVisibility,
+		arg.LibraryVisibility,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.HashedPassword,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+		&i.ActivityVisibility,
+		&i.ProfileVisibility,
+		&i.LibraryVisibility,
+		&i.Role,
+		&i.DisabledAt,
+	)
+	return i, err
+}
+
+const updateUserTimezone = `-- name: UpdateUserTimezone :one
+UPDATE users
+SET timezone   = $2,
+    updated_at = now()
+WHERE id = $1 RETURNING id, name, email, hashed_password, created_at, updated_at, timezone, activity_visibility, profile_visibility, library_visibility, role, disabled_at
+`
+
+type UpdateUserTimezoneParams struct {
+	ID       int64  `json:"id"`
+	Timezone string `json:"timezone"`
+}
+
+func (q *Queries) UpdateUserTimezone(ctx context.Context, arg UpdateUserTimezoneParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserTimezone, arg.ID, arg.Timezone)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.HashedPassword,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+		&i.ActivityVisibility,
+		&i.ProfileVisibility,
+		&i.LibraryVisibility,
+		&i.Role,
+		&i.DisabledAt,
 	)
 	return i, err
 }
@@ -104,8 +104,8 @@ func TestGetAllUsers(t *testing.T) {
 	}
 
 	arg1 := GetAllUsersParams{
-		Limit:  5,
-		Offset: 0,
+		PageSize:   5,
+		PageOffset: 0,
 	}
 
 	users1, err := querier.GetAllUsers(context.Background(), arg1)
@@ -117,8 +117,8 @@ func TestGetAllUsers(t *testing.T) {
 	}
 
 	arg2 := GetAllUsersParams{
-		Limit:  5,
-		Offset: 5,
+		PageSize:   5,
+		PageOffset: 5,
 	}
 
 	users2, err := querier.GetAllUsers(context.Background(), arg2)
@@ -129,3 +129,72 @@ func TestGetAllUsers(t *testing.T) {
 		require.NotEmpty(t, user)
 	}
 }
+
+func TestGetAllUsersByQuery(t *testing.T) {
+	user := createRandomUser(t)
+	createRandomUser(t)
+
+	arg := GetAllUsersParams{
+		Query:      sql.NullString{String: user.Name[2:8], Valid: true},
+		PageSize:   5,
+		PageOffset: 0,
+	}
+	users, err := querier.GetAllUsers(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, users)
+	require.Equal(t, user.ID, users[0].ID)
+}
+
+func TestGetAllUsersByQueryWithWildcard(t *testing.T) {
+	hashedPassword, err := testdata.HashPassword(testdata.RandomString(16))
+	require.NoError(t, err)
+	prefix := testdata.RandomString(8)
+	names := []string{prefix + "_user", prefix + "xuser"}
+	ids := make([]int64, len(names))
+	for i, name := range names {
+		user, err := querier.CreateUser(context.Background(), CreateUserParams{
+			Name:           name,
+			Email:          testdata.RandomEmail(),
+			HashedPassword: hashedPassword,
+		})
+		require.NoError(t, err)
+		ids[i] = user.ID
+	}
+
+	// _が任意の1文字に一致しないこと
+	users, err := querier.GetAllUsers(context.Background(), GetAllUsersParams{
+		Query:      sql.NullString{String: prefix + "_", Valid: true},
+		PageSize:   5,
+		PageOffset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, ids[0], users[0].ID)
+
+	// %がすべての文字列に一致しないこと
+	users, err = querier.GetAllUsers(context.Background(), GetAllUsersParams{
+		Query:      sql.NullString{String: prefix + "%", Valid: true},
+		PageSize:   5,
+		PageOffset: 0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, users)
+}
+
+func TestUpdateUserDisabledAt(t *testing.T) {
+	user := createRandomUser(t)
+	now := time.Now()
+
+	disabled, err := querier.UpdateUserDisabledAt(context.Background(), UpdateUserDisabledAtParams{
+		ID:         user.ID,
+		DisabledAt: sql.NullTime{Time: now, Valid: true},
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, now, disabled.DisabledAt.Time, time.Second)
+
+	enabled, err := querier.UpdateUserDisabledAt(context.Background(), UpdateUserDisabledAtParams{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, enabled.DisabledAt.Valid)
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditSearchUsers    AuditAction = "search_users"
+	AuditDisableUser    AuditAction = "disable_user"
+	AuditEnableUser     AuditAction = "enable_user"
+	AuditRevokeSessions AuditAction = "revoke_sessions"
+	AuditViewLibrary    AuditAction = "view_library"
+	AuditDeleteBook     AuditAction = "delete_book"
+)
+
+type AuditTargetType string
+
+const (
+	AuditTargetUser AuditTargetType = "user"
+	AuditTargetBook AuditTargetType = "book"
+)
+
+// AuditLog 管理者が行った操作の記録
+type AuditLog struct {
+	ID         int64           `json:"id"`
+	ActorID    int64           `json:"actor_id"`
+	Action     AuditAction     `json:"action"`
+	TargetType AuditTargetType `json:"target_type"`
+	// ユーザーの検索など、対象が1つに決まらない操作ではnil
+	TargetID  *int64          `json:"target_id"`
+	Detail    json.RawMessage `json:"detail"`
+	CreatedAt time.Time       `json:"created_at"`
+}
@@ -13,7 +13,23 @@ type Book struct {
 	PublisherName *string       `json:"publisher_name"`
 	PublishDate   *time.Time    `json:"publish_date"`
 	ISBN          *string       `json:"isbn"`
+	PageCount     *int32        `json:"page_count"`
 	Status        ReadingStatus `json:"status"`
 	StartDate     *time.Time    `json:"start_date"`
 	EndDate       *time.Time    `json:"end_date"`
+	Priority      int16         `json:"priority"`
+	QueuePosition *int32        `json:"queue_position"`
+	Owned         bool          `json:"owned"`
+	Overdue       bool          `json:"overdue"`
+	Format        BookFormat    `json:"format"`
+	// 通貨の最小単位(円、セントなど)での金額
+	PurchasePrice    *int64     `json:"purchase_price"`
+	PurchaseCurrency *string    `json:"purchase_currency"`
+	PurchaseStore    *string    `json:"purchase_store"`
+	PurchaseDate     *time.Time `json:"purchase_date"`
+	DurationMinutes  *int32     `json:"duration_minutes"`
+	// 本棚の登録内容が更新されるたびに増える。楽観的排他制御に使う
+	Version int64 `json:"version"`
+	// 共有している書籍の情報が更新されるたびに増える。本棚のVersionとは独立している
+	CatalogVersion int64 `json:"catalog_version"`
 }
@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+type ClubRole int
+
+const (
+	ClubOwner ClubRole = iota
+	ClubModerator
+	ClubMember
+)
+
+type BookClub struct {
+	ID          int64   `json:"id"`
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	// 読書中の本がない場合はnil
+	CurrentBook *Book            `json:"current_book"`
+	Sections    []ClubSection    `json:"sections"`
+	Members     []BookClubMember `json:"members"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+// ClubSection 読書スケジュールの区切り。期日までに到達する章またはページを表す
+type ClubSection struct {
+	ID         int64     `json:"id"`
+	Title      string    `json:"title"`
+	Chapter    *string   `json:"chapter"`
+	TargetPage *int32    `json:"target_page"`
+	DueDate    time.Time `json:"due_date"`
+}
+
+type BookClubMember struct {
+	UserID            int64      `json:"user_id"`
+	Name              string     `json:"name"`
+	Role              ClubRole   `json:"role"`
+	CurrentPage       int32      `json:"current_page"`
+	ProgressUpdatedAt *time.Time `json:"progress_updated_at"`
+	JoinedAt          time.Time  `json:"joined_at"`
+}
+
+type ClubPost struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	UserName  string    `json:"user_name"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
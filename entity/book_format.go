@@ -0,0 +1,11 @@
+package entity
+
+type BookFormat int
+
+const (
+	FormatUnspecified BookFormat = iota
+	Paperback
+	Hardcover
+	Ebook
+	Audiobook
+)
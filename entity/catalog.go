@@ -0,0 +1,17 @@
+package entity
+
+// CatalogKind 書籍から名前で参照される共有カタログの種類
+type CatalogKind string
+
+const (
+	CatalogAuthor    CatalogKind = "author"
+	CatalogPublisher CatalogKind = "publisher"
+	CatalogGenre     CatalogKind = "genre"
+)
+
+// DuplicateCandidate 正規化すると同じになる名前の組。統合するかは人が判断する
+type DuplicateCandidate struct {
+	Kind           CatalogKind `json:"kind"`
+	NormalizedName string      `json:"normalized_name"`
+	Names          []string    `json:"names"`
+}
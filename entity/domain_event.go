@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type DomainEventType string
+
+const (
+	BookRegistered DomainEventType = "book_registered"
+	BookUpdated    DomainEventType = "book_updated"
+	BookDeleted    DomainEventType = "book_deleted"
+	StatusChanged  DomainEventType = "status_changed"
+	UserSignedUp   DomainEventType = "user_signed_up"
+)
+
+// DomainEvent 同じIDのイベントが複数回届くことがあるため、受け取る側はIDで重複を除く
+type DomainEvent struct {
+	ID         int64           `json:"id"`
+	Type       DomainEventType `json:"type"`
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	// 配信した順に増える番号。IDは書き込んだトランザクションのコミット順と一致しないため、再開位置にはこちらを使う
+	PublishedSeq int64 `json:"published_seq"`
+}
+
+// UserID イベントを起こしたユーザー。全てのペイロードがuser_idを持つ
+func (e DomainEvent) UserID() (int64, error) {
+	var p struct {
+		UserID int64 `json:"user_id"`
+	}
+	if err := json.Unmarshal(e.Payload, &p); err != nil {
+		return 0, err
+	}
+	return p.UserID, nil
+}
+
+type BookRegisteredPayload struct {
+	UserID int64  `json:"user_id"`
+	BookID int64  `json:"book_id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+type BookUpdatedPayload struct {
+	UserID   int64 `json:"user_id"`
+	BookID   int64 `json:"book_id"`
+	Priority int16 `json:"priority"`
+	Owned    bool  `json:"owned"`
+}
+
+type BookDeletedPayload struct {
+	UserID int64 `json:"user_id"`
+	BookID int64 `json:"book_id"`
+}
+
+type StatusChangedPayload struct {
+	UserID int64  `json:"user_id"`
+	BookID int64  `json:"book_id"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+type UserSignedUpPayload struct {
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type FeedEventType int
+
+const (
+	AddedBook FeedEventType = iota
+	StartedReading
+	FinishedReading
+)
+
+type FeedEvent struct {
+	ID        int64         `json:"id"`
+	Type      FeedEventType `json:"type"`
+	UserID    int64         `json:"user_id"`
+	UserName  string        `json:"user_name"`
+	Book      Book          `json:"book"`
+	CreatedAt time.Time     `json:"created_at"`
+}
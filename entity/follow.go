@@ -0,0 +1,9 @@
+package entity
+
+import "time"
+
+type FollowUser struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	FollowedAt time.Time `json:"followed_at"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type LibraryEventType int
+
+const (
+	// LibrarySynced 再送が終わり、以降はリアルタイムのイベントであることを示す
+	LibrarySynced LibraryEventType = iota
+	LibraryBookAdded
+	LibraryBookUpdated
+	LibraryBookRemoved
+	LibraryStatusChanged
+)
+
+type LibraryEvent struct {
+	Type           LibraryEventType `json:"type"`
+	BookID         int64            `json:"book_id"`
+	Title          string           `json:"title"`
+	Status         ReadingStatus    `json:"status"`
+	PreviousStatus ReadingStatus    `json:"previous_status"`
+	Priority       int16            `json:"priority"`
+	Owned          bool             `json:"owned"`
+	// ResumeToken 再接続時に渡すと、このイベントより後から受け取れる
+	ResumeToken string    `json:"resume_token"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type Loan struct {
+	ID             int64      `json:"id"`
+	Book           Book       `json:"book"`
+	BorrowerName   string     `json:"borrower_name"`
+	BorrowerUserID *int64     `json:"borrower_user_id"`
+	LoanDate       time.Time  `json:"loan_date"`
+	DueDate        *time.Time `json:"due_date"`
+	ReturnDate     *time.Time `json:"return_date"`
+	Overdue        bool       `json:"overdue"`
+}
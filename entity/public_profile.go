@@ -0,0 +1,7 @@
+package entity
+
+type PublicProfile struct {
+	UserID int64  `json:"user_id"`
+	Name   string `json:"name"`
+	Books  []Book `json:"books"`
+}
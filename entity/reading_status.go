@@ -7,4 +7,40 @@ const (
 	Reading
 	Done
 	Unknown
+	Abandoned
+	OnHold
 )
+
+func (s ReadingStatus) String() string {
+	switch s {
+	case Unread:
+		return "unread"
+	case Reading:
+		return "reading"
+	case Done:
+		return "done"
+	case Abandoned:
+		return "abandoned"
+	case OnHold:
+		return "on_hold"
+	default:
+		return "unknown"
+	}
+}
+
+func ParseReadingStatus(s string) ReadingStatus {
+	switch s {
+	case "unread":
+		return Unread
+	case "reading":
+		return Reading
+	case "done":
+		return Done
+	case "abandoned":
+		return Abandoned
+	case "on_hold":
+		return OnHold
+	default:
+		return Unknown
+	}
+}
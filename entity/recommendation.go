@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type Recommendation struct {
+	Book        Book      `json:"book"`
+	Score       int64     `json:"score"`
+	Explanation string    `json:"explanation"`
+	GeneratedAt time.Time `json:"generated_at"`
+}
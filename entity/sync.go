@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+type SyncEntityType int
+
+const (
+	SyncBook SyncEntityType = iota
+	SyncTag
+	SyncNote
+)
+
+// SyncedBook 本と読書履歴。UpdatedAtはどちらか新しい方の更新日時
+type SyncedBook struct {
+	Book      Book      `json:"book"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BookTag struct {
+	BookID    int64     `json:"book_id"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type BookNote struct {
+	// クライアントが生成するUUID
+	ID        string    `json:"id"`
+	BookID    int64     `json:"book_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SyncTombstone 削除された本、タグ、メモ。本が削除された場合、その本のタグとメモも削除されている
+type SyncTombstone struct {
+	Type      SyncEntityType `json:"type"`
+	Key       string         `json:"key"`
+	DeletedAt time.Time      `json:"deleted_at"`
+}
+
+// SyncConflict サーバーの方が新しいため適用しなかった変更
+type SyncConflict struct {
+	Type SyncEntityType `json:"type"`
+	Key  string         `json:"key"`
+}
+
+func BookSyncKey(bookID int64) string {
+	return strconv.FormatInt(bookID, 10)
+}
+
+// TagSyncKey タグ名に/が含まれても区別できるよう本のIDを先頭に置く
+func TagSyncKey(bookID int64, name string) string {
+	return strconv.FormatInt(bookID, 10) + "/" + name
+}
@@ -1,7 +1,22 @@
 package entity
 
+import "time"
+
+type UserRole int
+
+const (
+	RoleUser UserRole = iota
+	// RoleLibrarian 共有カタログ(本・著者・出版社・ジャンル)を編集できる
+	RoleLibrarian
+	// RoleAdmin 共有カタログの編集に加えてユーザーを管理できる
+	RoleAdmin
+)
+
 type User struct {
-	ID    int64  `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID    int64    `json:"id"`
+	Name  string   `json:"name"`
+	Email string   `json:"email"`
+	Role  UserRole `json:"role"`
+	// nilでない場合は管理者によって無効化されている
+	DisabledAt *time.Time `json:"disabled_at"`
 }
@@ -0,0 +1,15 @@
+package entity
+
+type Visibility int
+
+const (
+	Private Visibility = iota
+	FollowersOnly
+	Public
+)
+
+// ShelfVisibility 同じ読書ステータスの本をまとめた本棚ごとの公開範囲
+type ShelfVisibility struct {
+	Status     ReadingStatus `json:"status"`
+	Visibility Visibility    `json:"visibility"`
+}
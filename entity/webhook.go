@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+type WebhookDeliveryStatus int
+
+const (
+	DeliveryPending WebhookDeliveryStatus = iota
+	DeliverySucceeded
+	// 再試行の上限に達して配信を諦めた
+	DeliveryDead
+)
+
+type Webhook struct {
+	ID         int64             `json:"id"`
+	URL        string            `json:"url"`
+	EventTypes []DomainEventType `json:"event_types"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID                 int64                 `json:"id"`
+	EventID            int64                 `json:"event_id"`
+	EventType          DomainEventType       `json:"event_type"`
+	Status             WebhookDeliveryStatus `json:"status"`
+	Attempts           int32                 `json:"attempts"`
+	LastResponseStatus *int32                `json:"last_response_status"`
+	LastError          *string               `json:"last_error"`
+	NextAttemptAt      *time.Time            `json:"next_attempt_at"`
+	DeliveredAt        *time.Time            `json:"delivered_at"`
+	CreatedAt          time.Time             `json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+type YearInReview struct {
+	Year           int            `json:"year"`
+	FinishedBooks  int64          `json:"finished_books"`
+	TotalPages     int64          `json:"total_pages"`
+	LongestBook    *ReviewedBook  `json:"longest_book"`
+	ShortestBook   *ReviewedBook  `json:"shortest_book"`
+	FavoriteGenres []GenreCount   `json:"favorite_genres"`
+	TopMonth       *MonthCount    `json:"top_month"`
+	Books          []ReviewedBook `json:"books"`
+}
+
+type ReviewedBook struct {
+	ID            int64      `json:"id"`
+	Title         string     `json:"title"`
+	AuthorName    *string    `json:"author_name"`
+	CoverImageURL *string    `json:"cover_image_url"`
+	PageCount     *int32     `json:"page_count"`
+	StartDate     *time.Time `json:"start_date"`
+	EndDate       *time.Time `json:"end_date"`
+}
+
+type GenreCount struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type MonthCount struct {
+	Month time.Month `json:"month"`
+	Count int64      `json:"count"`
+}
@@ -8,13 +8,21 @@ import (
 )
 
 type Config struct {
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	DBDriver                      string        `mapstructure:"DB_DRIVER"`
+	DBSource                      string        `mapstructure:"DB_SOURCE"`
+	HTTPServerAddress             string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress             string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	TokenSymmetricKey             string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration           time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration          time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	RecommendationRefreshInterval time.Duration `mapstructure:"RECOMMENDATION_REFRESH_INTERVAL"`
+	RecommendationMaxAge          time.Duration `mapstructure:"RECOMMENDATION_MAX_AGE"`
+	PublicProfileRateLimit        int           `mapstructure:"PUBLIC_PROFILE_RATE_LIMIT"`
+	PublicProfileCacheMaxAge      time.Duration `mapstructure:"PUBLIC_PROFILE_CACHE_MAX_AGE"`
+	OutboxRelayInterval           time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	WebhookDeliveryInterval       time.Duration `mapstructure:"WEBHOOK_DELIVERY_INTERVAL"`
+	WebhookTimeout                time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+	IdempotencyKeyTTL             time.Duration `mapstructure:"IDEMPOTENCY_KEY_TTL"`
 }
 
 func Load(path string) (config Config, err error) {
@@ -0,0 +1,57 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+	"readly/usecase"
+	"time"
+)
+
+// 1回の実行で配信するイベント数の上限
+const outboxRelayBatchSize = 100
+
+type OutboxRelayJob struct {
+	useCase  usecase.RelayOutboxEventsUseCase
+	interval time.Duration
+}
+
+func NewOutboxRelayJob(
+	useCase usecase.RelayOutboxEventsUseCase,
+	interval time.Duration,
+) *OutboxRelayJob {
+	return &OutboxRelayJob{
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+// Run ctxがキャンセルされるまでintervalごとに未配信のイベントを配信する
+func (j *OutboxRelayJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.relay(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relay 上限まで配信できた場合はまだ残っている可能性があるため続けて配信する
+func (j *OutboxRelayJob) relay(ctx context.Context) {
+	for ctx.Err() == nil {
+		res, err := j.useCase.RelayOutboxEvents(ctx, usecase.RelayOutboxEventsRequest{
+			Limit: outboxRelayBatchSize,
+		})
+		if err != nil {
+			slog.Error("outbox relay job failed", "error", err)
+			return
+		}
+		if res.PublishedEvents < outboxRelayBatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package job
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/usecase"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeRelayOutboxEventsUseCase struct {
+	mu sync.Mutex
+	// 呼び出しごとに配信したことにするイベント数。使い切った後は0を返す
+	published []int
+	reqs      []usecase.RelayOutboxEventsRequest
+}
+
+func (f *fakeRelayOutboxEventsUseCase) RelayOutboxEvents(_ context.Context, req usecase.RelayOutboxEventsRequest) (*usecase.RelayOutboxEventsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reqs = append(f.reqs, req)
+	n := 0
+	if len(f.published) > 0 {
+		n = f.published[0]
+		f.published = f.published[1:]
+	}
+	return &usecase.RelayOutboxEventsResponse{PublishedEvents: n}, nil
+}
+
+func (f *fakeRelayOutboxEventsUseCase) calls() []usecase.RelayOutboxEventsRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]usecase.RelayOutboxEventsRequest(nil), f.reqs...)
+}
+
+func TestOutboxRelayJob_Run(t *testing.T) {
+	useCase := &fakeRelayOutboxEventsUseCase{}
+	job := NewOutboxRelayJob(useCase, 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(useCase.calls()) >= 2
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job did not stop after context was canceled")
+	}
+
+	calls := useCase.calls()
+	require.Equal(t, int32(outboxRelayBatchSize), calls[0].Limit)
+}
+
+func TestOutboxRelayJob_RelayDrainsFullBatches(t *testing.T) {
+	useCase := &fakeRelayOutboxEventsUseCase{
+		published: []int{outboxRelayBatchSize, outboxRelayBatchSize, 3},
+	}
+	job := NewOutboxRelayJob(useCase, time.Hour)
+
+	job.relay(context.Background())
+
+	require.Len(t, useCase.calls(), 3)
+}
@@ -0,0 +1,58 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+	"readly/usecase"
+	"time"
+)
+
+// 1回の実行で作り直すユーザー数の上限
+const recommendationBatchSize = 100
+
+type RecommendationJob struct {
+	useCase  usecase.RefreshRecommendationsUseCase
+	interval time.Duration
+	maxAge   time.Duration
+}
+
+func NewRecommendationJob(
+	useCase usecase.RefreshRecommendationsUseCase,
+	interval time.Duration,
+	maxAge time.Duration,
+) *RecommendationJob {
+	return &RecommendationJob{
+		useCase:  useCase,
+		interval: interval,
+		maxAge:   maxAge,
+	}
+}
+
+// Run ctxがキャンセルされるまでintervalごとに古くなったおすすめを作り直す
+func (j *RecommendationJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.refresh(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *RecommendationJob) refresh(ctx context.Context) {
+	res, err := j.useCase.RefreshRecommendations(ctx, usecase.RefreshRecommendationsRequest{
+		StaleBefore: time.Now().Add(-j.maxAge),
+		Limit:       recommendationBatchSize,
+	})
+	if err != nil {
+		slog.Error("recommendation job failed", "error", err)
+		return
+	}
+	if res.RefreshedUsers > 0 || res.FailedUsers > 0 {
+		slog.Info("recommendation job finished", "refreshed", res.RefreshedUsers, "failed", res.FailedUsers)
+	}
+}
@@ -0,0 +1,55 @@
+package job
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/usecase"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeRefreshRecommendationsUseCase struct {
+	mu   sync.Mutex
+	reqs []usecase.RefreshRecommendationsRequest
+}
+
+func (f *fakeRefreshRecommendationsUseCase) RefreshRecommendations(_ context.Context, req usecase.RefreshRecommendationsRequest) (*usecase.RefreshRecommendationsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reqs = append(f.reqs, req)
+	return &usecase.RefreshRecommendationsResponse{RefreshedUsers: 1}, nil
+}
+
+func (f *fakeRefreshRecommendationsUseCase) calls() []usecase.RefreshRecommendationsRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]usecase.RefreshRecommendationsRequest(nil), f.reqs...)
+}
+
+func TestRecommendationJob_Run(t *testing.T) {
+	useCase := &fakeRefreshRecommendationsUseCase{}
+	job := NewRecommendationJob(useCase, 10*time.Millisecond, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(useCase.calls()) >= 2
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job did not stop after context was canceled")
+	}
+
+	calls := useCase.calls()
+	require.Equal(t, int32(recommendationBatchSize), calls[0].Limit)
+	require.WithinDuration(t, time.Now().Add(-time.Hour), calls[0].StaleBefore, time.Second)
+}
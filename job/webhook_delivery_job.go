@@ -0,0 +1,54 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+	"readly/usecase"
+	"time"
+)
+
+// 1回の実行で送信する配信数の上限
+const webhookDeliveryBatchSize = 50
+
+type WebhookDeliveryJob struct {
+	useCase  usecase.DeliverWebhooksUseCase
+	interval time.Duration
+}
+
+func NewWebhookDeliveryJob(
+	useCase usecase.DeliverWebhooksUseCase,
+	interval time.Duration,
+) *WebhookDeliveryJob {
+	return &WebhookDeliveryJob{
+		useCase:  useCase,
+		interval: interval,
+	}
+}
+
+// Run ctxがキャンセルされるまでintervalごとに配信時刻を過ぎたWebhookを送信する
+func (j *WebhookDeliveryJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.deliver(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *WebhookDeliveryJob) deliver(ctx context.Context) {
+	res, err := j.useCase.DeliverWebhooks(ctx, usecase.DeliverWebhooksRequest{
+		Limit: webhookDeliveryBatchSize,
+	})
+	if err != nil {
+		slog.Error("webhook delivery job failed", "error", err)
+		return
+	}
+	if res.Succeeded > 0 || res.Failed > 0 || res.Dead > 0 {
+		slog.Info("webhook delivery job finished", "succeeded", res.Succeeded, "failed", res.Failed, "dead", res.Dead)
+	}
+}
@@ -0,0 +1,54 @@
+package job
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/usecase"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeDeliverWebhooksUseCase struct {
+	mu   sync.Mutex
+	reqs []usecase.DeliverWebhooksRequest
+}
+
+func (f *fakeDeliverWebhooksUseCase) DeliverWebhooks(_ context.Context, req usecase.DeliverWebhooksRequest) (*usecase.DeliverWebhooksResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reqs = append(f.reqs, req)
+	return &usecase.DeliverWebhooksResponse{Succeeded: 1}, nil
+}
+
+func (f *fakeDeliverWebhooksUseCase) calls() []usecase.DeliverWebhooksRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]usecase.DeliverWebhooksRequest(nil), f.reqs...)
+}
+
+func TestWebhookDeliveryJob_Run(t *testing.T) {
+	useCase := &fakeDeliverWebhooksUseCase{}
+	job := NewWebhookDeliveryJob(useCase, 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(useCase.calls()) >= 2
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job did not stop after context was canceled")
+	}
+
+	calls := useCase.calls()
+	require.Equal(t, int32(webhookDeliveryBatchSize), calls[0].Limit)
+}
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"readly/pb"
+	"readly/service/auth"
+	"strings"
+)
+
+type claimsKey struct{}
+
+// UnaryAuthInterceptor allow_unauthenticatedが指定されたメソッド以外は認証し、auth.Claimsをコンテキストに設定する
+func UnaryAuthInterceptor(maker auth.TokenMaker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if allowUnauthenticated(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		claims, err := Authenticate(ctx, maker)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		return handler(ContextWithClaims(ctx, claims), req)
+	}
+}
+
+// StreamAuthInterceptor UnaryAuthInterceptorのストリーミング版
+func StreamAuthInterceptor(maker auth.TokenMaker) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if allowUnauthenticated(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		claims, err := Authenticate(ss.Context(), maker)
+		if err != nil {
+			return status.Errorf(codes.Unauthenticated, err.Error())
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ContextWithClaims(ss.Context(), claims)})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func ContextWithClaims(ctx context.Context, claims *auth.Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext 認証インターセプターを通っていない場合はfalseを返す
+func ClaimsFromContext(ctx context.Context) (*auth.Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*auth.Claims)
+	return claims, ok && claims != nil
+}
+
+// allowUnauthenticated "/pb.UserService/SignIn"の形式のメソッド名からprotoのメソッドオプションを参照する
+// 見つからないメソッドは認証が必要なものとして扱う
+func allowUnauthenticated(fullMethod string) bool {
+	// grpcurlなどの開発用ツールがサービスの定義を取得できるようにする
+	if strings.HasPrefix(fullMethod, "/grpc.reflection.") {
+		return true
+	}
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return false
+	}
+	method, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+	opts, ok := method.Options().(*descriptorpb.MethodOptions)
+	if !ok || opts == nil {
+		return false
+	}
+	allow, _ := proto.GetExtension(opts, pb.E_AllowUnauthenticated).(bool)
+	return allow
+}
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"readly/entity"
+	"readly/service/auth"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestAllowUnauthenticated(t *testing.T) {
+	testCases := []struct {
+		fullMethod string
+		want       bool
+	}{
+		{fullMethod: "/pb.UserService/SignIn", want: true},
+		{fullMethod: "/pb.UserService/SignUp", want: true},
+		{fullMethod: "/pb.UserService/RefreshToken", want: true},
+		{fullMethod: "/pb.SocialService/GetPublicProfile", want: true},
+		{fullMethod: "/pb.UserService/UpdateTimezone", want: false},
+		{fullMethod: "/pb.BookService/WatchLibrary", want: false},
+		{fullMethod: "/pb.UnknownService/Unknown", want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.fullMethod, func(t *testing.T) {
+			require.Equal(t, tc.want, allowUnauthenticated(tc.fullMethod))
+		})
+	}
+}
+
+func newAuthorizedContext(t *testing.T, maker auth.TokenMaker, userID int64) context.Context {
+	payload, err := maker.Generate(userID, entity.RoleUser, time.Minute)
+	require.NoError(t, err)
+	md := metadata.Pairs(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, payload.Token))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	maker, err := auth.NewPasetoMaker(testdata.RandomString(32))
+	require.NoError(t, err)
+	interceptor := UnaryAuthInterceptor(maker)
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		fullMethod string
+		check      func(t *testing.T, res any, err error)
+	}{
+		{
+			name:       "claims are set when authenticated",
+			ctx:        newAuthorizedContext(t, maker, 1),
+			fullMethod: "/pb.BookService/RegisterBook",
+			check: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				claims, ok := res.(*auth.Claims)
+				require.True(t, ok)
+				require.Equal(t, int64(1), claims.UserID)
+			},
+		},
+		{
+			name:       "unauthenticated without authorization header",
+			ctx:        context.Background(),
+			fullMethod: "/pb.BookService/RegisterBook",
+			check: func(t *testing.T, res any, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name:       "public method is called without authorization header",
+			ctx:        context.Background(),
+			fullMethod: "/pb.UserService/SignIn",
+			check: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Nil(t, res)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info := &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}
+			res, err := interceptor(tc.ctx, nil, info, func(ctx context.Context, req any) (any, error) {
+				claims, ok := ClaimsFromContext(ctx)
+				if !ok {
+					return nil, nil
+				}
+				return claims, nil
+			})
+			tc.check(t, res, err)
+		})
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	maker, err := auth.NewPasetoMaker(testdata.RandomString(32))
+	require.NoError(t, err)
+	interceptor := StreamAuthInterceptor(maker)
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.BookService/WatchLibrary"}
+
+	var got *auth.Claims
+	handler := func(srv any, stream grpc.ServerStream) error {
+		got, _ = ClaimsFromContext(stream.Context())
+		return nil
+	}
+
+	err = interceptor(nil, &testServerStream{ctx: newAuthorizedContext(t, maker, 2)}, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), got.UserID)
+
+	err = interceptor(nil, &testServerStream{ctx: context.Background()}, info, handler)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
+	"readly/entity"
 	"readly/service/auth"
 	"readly/testdata"
 	"testing"
@@ -20,7 +21,7 @@ func setAuthorizationHeader(
 	userID int64,
 	duration time.Duration,
 ) {
-	payload, err := maker.Generate(userID, duration)
+	payload, err := maker.Generate(userID, entity.RoleUser, duration)
 	require.NoError(t, err)
 
 	authorizationHeader := fmt.Sprintf("%s %s", authorizationType, payload.Token)
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter キーごとに一定期間内のリクエスト数を制限する
+type RateLimiter struct {
+	mu          sync.Mutex
+	limit       int
+	window      time.Duration
+	now         func() time.Time
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+}
+
+type bucket struct {
+	start time.Time
+	count int
+}
+
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:   limit,
+		window:  window,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Allow 許可された場合はリクエスト数を加算する
+func (l *RateLimiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok || now.Sub(b.start) >= l.window {
+		if now.Sub(l.lastCleanup) >= l.window {
+			l.cleanup(now)
+			l.lastCleanup = now
+		}
+		b = &bucket{start: now}
+		l.buckets[key] = b
+	}
+	if b.count >= l.limit {
+		return false
+	}
+	b.count++
+	return true
+}
+
+// cleanup 期間の過ぎたキーが溜まり続けないように削除する
+func (l *RateLimiter) cleanup(now time.Time) {
+	for k, b := range l.buckets {
+		if now.Sub(b.start) >= l.window {
+			delete(l.buckets, k)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	require.True(t, limiter.Allow("a"))
+	require.True(t, limiter.Allow("a"))
+	require.False(t, limiter.Allow("a"))
+	require.True(t, limiter.Allow("b"))
+
+	now = now.Add(time.Minute)
+	require.True(t, limiter.Allow("a"))
+	require.Len(t, limiter.buckets, 1)
+}
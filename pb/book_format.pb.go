@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: book_format.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BookFormat int32
+
+const (
+	BookFormat_FORMAT_UNSPECIFIED BookFormat = 0
+	BookFormat_PAPERBACK          BookFormat = 1
+	BookFormat_HARDCOVER          BookFormat = 2
+	BookFormat_EBOOK              BookFormat = 3
+	BookFormat_AUDIOBOOK          BookFormat = 4
+)
+
+// Enum value maps for BookFormat.
+var (
+	BookFormat_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "PAPERBACK",
+		2: "HARDCOVER",
+		3: "EBOOK",
+		4: "AUDIOBOOK",
+	}
+	BookFormat_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"PAPERBACK":          1,
+		"HARDCOVER":          2,
+		"EBOOK":              3,
+		"AUDIOBOOK":          4,
+	}
+)
+
+func (x BookFormat) Enum() *BookFormat {
+	p := new(BookFormat)
+	*p = x
+	return p
+}
+
+func (x BookFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_book_format_proto_enumTypes[0].Descriptor()
+}
+
+func (BookFormat) Type() protoreflect.EnumType {
+	return &file_book_format_proto_enumTypes[0]
+}
+
+func (x BookFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookFormat.Descriptor instead.
+func (BookFormat) EnumDescriptor() ([]byte, []int) {
+	return file_book_format_proto_rawDescGZIP(), []int{0}
+}
+
+var File_book_format_proto protoreflect.FileDescriptor
+
+var file_book_format_proto_rawDesc = string([]byte{
+	0x0a, 0x11, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x2a, 0x5c, 0x0a, 0x0a, 0x42, 0x6f, 0x6f, 0x6b, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x50, 0x41, 0x50, 0x45, 0x52, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x48, 0x41, 0x52, 0x44, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45,
+	0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x55, 0x44, 0x49, 0x4f, 0x42,
+	0x4f, 0x4f, 0x4b, 0x10, 0x04, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_book_format_proto_rawDescOnce sync.Once
+	file_book_format_proto_rawDescData []byte
+)
+
+func file_book_format_proto_rawDescGZIP() []byte {
+	file_book_format_proto_rawDescOnce.Do(func() {
+		file_book_format_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_book_format_proto_rawDesc), len(file_book_format_proto_rawDesc)))
+	})
+	return file_book_format_proto_rawDescData
+}
+
+var file_book_format_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_book_format_proto_goTypes = []any{
+	(BookFormat)(0), // 0: pb.BookFormat
+}
+var file_book_format_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_book_format_proto_init() }
+func file_book_format_proto_init() {
+	if File_book_format_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_book_format_proto_rawDesc), len(file_book_format_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_book_format_proto_goTypes,
+		DependencyIndexes: file_book_format_proto_depIdxs,
+		EnumInfos:         file_book_format_proto_enumTypes,
+	}.Build()
+	File_book_format_proto = out.File
+	file_book_format_proto_goTypes = nil
+	file_book_format_proto_depIdxs = nil
+}
@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: library_event.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LibraryEventType int32
+
+const (
+	LibraryEventType_LIBRARY_SYNCED         LibraryEventType = 0
+	LibraryEventType_LIBRARY_BOOK_ADDED     LibraryEventType = 1
+	LibraryEventType_LIBRARY_BOOK_UPDATED   LibraryEventType = 2
+	LibraryEventType_LIBRARY_BOOK_REMOVED   LibraryEventType = 3
+	LibraryEventType_LIBRARY_STATUS_CHANGED LibraryEventType = 4
+)
+
+// Enum value maps for LibraryEventType.
+var (
+	LibraryEventType_name = map[int32]string{
+		0: "LIBRARY_SYNCED",
+		1: "LIBRARY_BOOK_ADDED",
+		2: "LIBRARY_BOOK_UPDATED",
+		3: "LIBRARY_BOOK_REMOVED",
+		4: "LIBRARY_STATUS_CHANGED",
+	}
+	LibraryEventType_value = map[string]int32{
+		"LIBRARY_SYNCED":         0,
+		"LIBRARY_BOOK_ADDED":     1,
+		"LIBRARY_BOOK_UPDATED":   2,
+		"LIBRARY_BOOK_REMOVED":   3,
+		"LIBRARY_STATUS_CHANGED": 4,
+	}
+)
+
+func (x LibraryEventType) Enum() *LibraryEventType {
+	p := new(LibraryEventType)
+	*p = x
+	return p
+}
+
+func (x LibraryEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LibraryEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_library_event_proto_enumTypes[0].Descriptor()
+}
+
+func (LibraryEventType) Type() protoreflect.EnumType {
+	return &file_library_event_proto_enumTypes[0]
+}
+
+func (x LibraryEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LibraryEventType.Descriptor instead.
+func (LibraryEventType) EnumDescriptor() ([]byte, []int) {
+	return file_library_event_proto_rawDescGZIP(), []int{0}
+}
+
+type LibraryEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           LibraryEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=pb.LibraryEventType" json:"type,omitempty"`
+	BookId         int64                  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status         ReadingStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=pb.ReadingStatus" json:"status,omitempty"`
+	PreviousStatus ReadingStatus          `protobuf:"varint,5,opt,name=previous_status,json=previousStatus,proto3,enum=pb.ReadingStatus" json:"previous_status,omitempty"`
+	Priority       int32                  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	Owned          bool                   `protobuf:"varint,7,opt,name=owned,proto3" json:"owned,omitempty"`
+	ResumeToken    string                 `protobuf:"bytes,8,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LibraryEvent) Reset() {
+	*x = LibraryEvent{}
+	mi := &file_library_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LibraryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LibraryEvent) ProtoMessage() {}
+
+func (x *LibraryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_library_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LibraryEvent.ProtoReflect.Descriptor instead.
+func (*LibraryEvent) Descriptor() ([]byte, []int) {
+	return file_library_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LibraryEvent) GetType() LibraryEventType {
+	if x != nil {
+		return x.Type
+	}
+	return LibraryEventType_LIBRARY_SYNCED
+}
+
+func (x *LibraryEvent) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *LibraryEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LibraryEvent) GetStatus() ReadingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReadingStatus_UNREAD
+}
+
+func (x *LibraryEvent) GetPreviousStatus() ReadingStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ReadingStatus_UNREAD
+}
+
+func (x *LibraryEvent) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *LibraryEvent) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+func (x *LibraryEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *LibraryEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_library_event_proto protoreflect.FileDescriptor
+
+var file_library_event_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xe0, 0x02, 0x0a, 0x0c, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6f,
+	0x6f, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x2a, 0x8e, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x52, 0x59, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x41, 0x44, 0x44,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f,
+	0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x4c, 0x49, 0x42, 0x52, 0x41, 0x52, 0x59, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x04, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_library_event_proto_rawDescOnce sync.Once
+	file_library_event_proto_rawDescData []byte
+)
+
+func file_library_event_proto_rawDescGZIP() []byte {
+	file_library_event_proto_rawDescOnce.Do(func() {
+		file_library_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_library_event_proto_rawDesc), len(file_library_event_proto_rawDesc)))
+	})
+	return file_library_event_proto_rawDescData
+}
+
+var file_library_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_library_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_library_event_proto_goTypes = []any{
+	(LibraryEventType)(0),         // 0: pb.LibraryEventType
+	(*LibraryEvent)(nil),          // 1: pb.LibraryEvent
+	(ReadingStatus)(0),            // 2: pb.ReadingStatus
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_library_event_proto_depIdxs = []int32{
+	0, // 0: pb.LibraryEvent.type:type_name -> pb.LibraryEventType
+	2, // 1: pb.LibraryEvent.status:type_name -> pb.ReadingStatus
+	2, // 2: pb.LibraryEvent.previous_status:type_name -> pb.ReadingStatus
+	3, // 3: pb.LibraryEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_library_event_proto_init() }
+func file_library_event_proto_init() {
+	if File_library_event_proto != nil {
+		return
+	}
+	file_reading_status_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_library_event_proto_rawDesc), len(file_library_event_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_library_event_proto_goTypes,
+		DependencyIndexes: file_library_event_proto_depIdxs,
+		EnumInfos:         file_library_event_proto_enumTypes,
+		MessageInfos:      file_library_event_proto_msgTypes,
+	}.Build()
+	File_library_event_proto = out.File
+	file_library_event_proto_goTypes = nil
+	file_library_event_proto_depIdxs = nil
+}
@@ -24,10 +24,12 @@ const (
 type ReadingStatus int32
 
 const (
-	ReadingStatus_UNREAD  ReadingStatus = 0
-	ReadingStatus_READING ReadingStatus = 1
-	ReadingStatus_DONE    ReadingStatus = 2
-	ReadingStatus_UNKNOWN ReadingStatus = 3
+	ReadingStatus_UNREAD    ReadingStatus = 0
+	ReadingStatus_READING   ReadingStatus = 1
+	ReadingStatus_DONE      ReadingStatus = 2
+	ReadingStatus_UNKNOWN   ReadingStatus = 3
+	ReadingStatus_ABANDONED ReadingStatus = 4
+	ReadingStatus_ON_HOLD   ReadingStatus = 5
 )
 
 // Enum value maps for ReadingStatus.
@@ -37,12 +39,16 @@ var (
 		1: "READING",
 		2: "DONE",
 		3: "UNKNOWN",
+		4: "ABANDONED",
+		5: "ON_HOLD",
 	}
 	ReadingStatus_value = map[string]int32{
-		"UNREAD":  0,
-		"READING": 1,
-		"DONE":    2,
-		"UNKNOWN": 3,
+		"UNREAD":    0,
+		"READING":   1,
+		"DONE":      2,
+		"UNKNOWN":   3,
+		"ABANDONED": 4,
+		"ON_HOLD":   5,
 	}
 )
 
@@ -77,12 +83,14 @@ var File_reading_status_proto protoreflect.FileDescriptor
 
 var file_reading_status_proto_rawDesc = string([]byte{
 	0x0a, 0x14, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x2a, 0x3f, 0x0a, 0x0d, 0x52, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x2a, 0x5b, 0x0a, 0x0d, 0x52, 0x65,
 	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0a, 0x0a, 0x06, 0x55,
 	0x4e, 0x52, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x41, 0x44, 0x49,
 	0x4e, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x42, 0x0b, 0x5a, 0x09, 0x72,
-	0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x41,
+	0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x4e,
+	0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x05, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c,
+	0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_batch_register_books.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchRegisterBooksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*RegisterBookRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// trueの場合は1件でも失敗すると全件を登録しない
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRegisterBooksRequest) Reset() {
+	*x = BatchRegisterBooksRequest{}
+	mi := &file_rpc_batch_register_books_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRegisterBooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRegisterBooksRequest) ProtoMessage() {}
+
+func (x *BatchRegisterBooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_batch_register_books_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRegisterBooksRequest.ProtoReflect.Descriptor instead.
+func (*BatchRegisterBooksRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_batch_register_books_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchRegisterBooksRequest) GetItems() []*RegisterBookRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchRegisterBooksRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchRegisterBookResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 登録に成功した場合のみ設定される
+	Book *Book `protobuf:"bytes,1,opt,name=book,proto3,oneof" json:"book,omitempty"`
+	// 失敗した場合のエラーコード。成功した場合は0
+	ErrorCode     int32  `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage  string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRegisterBookResult) Reset() {
+	*x = BatchRegisterBookResult{}
+	mi := &file_rpc_batch_register_books_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRegisterBookResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRegisterBookResult) ProtoMessage() {}
+
+func (x *BatchRegisterBookResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_batch_register_books_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRegisterBookResult.ProtoReflect.Descriptor instead.
+func (*BatchRegisterBookResult) Descriptor() ([]byte, []int) {
+	return file_rpc_batch_register_books_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchRegisterBookResult) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *BatchRegisterBookResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *BatchRegisterBookResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type BatchRegisterBooksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// リクエストのitemsと同じ順序で並ぶ
+	Results       []*BatchRegisterBookResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchRegisterBooksResponse) Reset() {
+	*x = BatchRegisterBooksResponse{}
+	mi := &file_rpc_batch_register_books_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRegisterBooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRegisterBooksResponse) ProtoMessage() {}
+
+func (x *BatchRegisterBooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_batch_register_books_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRegisterBooksResponse.ProtoReflect.Descriptor instead.
+func (*BatchRegisterBooksResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_batch_register_books_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchRegisterBooksResponse) GetResults() []*BatchRegisterBookResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_rpc_batch_register_books_proto protoreflect.FileDescriptor
+
+var file_rpc_batch_register_books_proto_rawDesc = string([]byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x19, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06,
+	0x82, 0xb5, 0x18, 0x02, 0x18, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x74, 0x6f, 0x6d, 0x69, 0x63, 0x22, 0x89, 0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x21, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f,
+	0x6b, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x22, 0x53, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_batch_register_books_proto_rawDescOnce sync.Once
+	file_rpc_batch_register_books_proto_rawDescData []byte
+)
+
+func file_rpc_batch_register_books_proto_rawDescGZIP() []byte {
+	file_rpc_batch_register_books_proto_rawDescOnce.Do(func() {
+		file_rpc_batch_register_books_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_batch_register_books_proto_rawDesc), len(file_rpc_batch_register_books_proto_rawDesc)))
+	})
+	return file_rpc_batch_register_books_proto_rawDescData
+}
+
+var file_rpc_batch_register_books_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_batch_register_books_proto_goTypes = []any{
+	(*BatchRegisterBooksRequest)(nil),  // 0: pb.BatchRegisterBooksRequest
+	(*BatchRegisterBookResult)(nil),    // 1: pb.BatchRegisterBookResult
+	(*BatchRegisterBooksResponse)(nil), // 2: pb.BatchRegisterBooksResponse
+	(*RegisterBookRequest)(nil),        // 3: pb.RegisterBookRequest
+	(*Book)(nil),                       // 4: pb.Book
+}
+var file_rpc_batch_register_books_proto_depIdxs = []int32{
+	3, // 0: pb.BatchRegisterBooksRequest.items:type_name -> pb.RegisterBookRequest
+	4, // 1: pb.BatchRegisterBookResult.book:type_name -> pb.Book
+	1, // 2: pb.BatchRegisterBooksResponse.results:type_name -> pb.BatchRegisterBookResult
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_batch_register_books_proto_init() }
+func file_rpc_batch_register_books_proto_init() {
+	if File_rpc_batch_register_books_proto != nil {
+		return
+	}
+	file_book_proto_init()
+	file_rpc_register_book_proto_init()
+	file_validate_proto_init()
+	file_rpc_batch_register_books_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_batch_register_books_proto_rawDesc), len(file_rpc_batch_register_books_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_batch_register_books_proto_goTypes,
+		DependencyIndexes: file_rpc_batch_register_books_proto_depIdxs,
+		MessageInfos:      file_rpc_batch_register_books_proto_msgTypes,
+	}.Build()
+	File_rpc_batch_register_books_proto = out.File
+	file_rpc_batch_register_books_proto_goTypes = nil
+	file_rpc_batch_register_books_proto_depIdxs = nil
+}
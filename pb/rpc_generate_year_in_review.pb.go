@@ -0,0 +1,391 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_generate_year_in_review.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GenerateYearInReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateYearInReviewRequest) Reset() {
+	*x = GenerateYearInReviewRequest{}
+	mi := &file_rpc_generate_year_in_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateYearInReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateYearInReviewRequest) ProtoMessage() {}
+
+func (x *GenerateYearInReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_generate_year_in_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateYearInReviewRequest.ProtoReflect.Descriptor instead.
+func (*GenerateYearInReviewRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_generate_year_in_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenerateYearInReviewRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type ReviewedBook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AuthorName    *string                `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3,oneof" json:"author_name,omitempty"`
+	CoverImageUrl *string                `protobuf:"bytes,4,opt,name=cover_image_url,json=coverImageUrl,proto3,oneof" json:"cover_image_url,omitempty"`
+	PageCount     *int32                 `protobuf:"varint,5,opt,name=page_count,json=pageCount,proto3,oneof" json:"page_count,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewedBook) Reset() {
+	*x = ReviewedBook{}
+	mi := &file_rpc_generate_year_in_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewedBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewedBook) ProtoMessage() {}
+
+func (x *ReviewedBook) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_generate_year_in_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewedBook.ProtoReflect.Descriptor instead.
+func (*ReviewedBook) Descriptor() ([]byte, []int) {
+	return file_rpc_generate_year_in_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewedBook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewedBook) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReviewedBook) GetAuthorName() string {
+	if x != nil && x.AuthorName != nil {
+		return *x.AuthorName
+	}
+	return ""
+}
+
+func (x *ReviewedBook) GetCoverImageUrl() string {
+	if x != nil && x.CoverImageUrl != nil {
+		return *x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *ReviewedBook) GetPageCount() int32 {
+	if x != nil && x.PageCount != nil {
+		return *x.PageCount
+	}
+	return 0
+}
+
+func (x *ReviewedBook) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ReviewedBook) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GenerateYearInReviewResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Year           int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	FinishedBooks  int64                  `protobuf:"varint,2,opt,name=finished_books,json=finishedBooks,proto3" json:"finished_books,omitempty"`
+	TotalPages     int64                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	LongestBook    *ReviewedBook          `protobuf:"bytes,4,opt,name=longest_book,json=longestBook,proto3" json:"longest_book,omitempty"`
+	ShortestBook   *ReviewedBook          `protobuf:"bytes,5,opt,name=shortest_book,json=shortestBook,proto3" json:"shortest_book,omitempty"`
+	FavoriteGenres []*NameCount           `protobuf:"bytes,6,rep,name=favorite_genres,json=favoriteGenres,proto3" json:"favorite_genres,omitempty"`
+	TopMonth       *MonthlyCount          `protobuf:"bytes,7,opt,name=top_month,json=topMonth,proto3" json:"top_month,omitempty"`
+	Books          []*ReviewedBook        `protobuf:"bytes,8,rep,name=books,proto3" json:"books,omitempty"`
+	Html           string                 `protobuf:"bytes,9,opt,name=html,proto3" json:"html,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateYearInReviewResponse) Reset() {
+	*x = GenerateYearInReviewResponse{}
+	mi := &file_rpc_generate_year_in_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateYearInReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateYearInReviewResponse) ProtoMessage() {}
+
+func (x *GenerateYearInReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_generate_year_in_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateYearInReviewResponse.ProtoReflect.Descriptor instead.
+func (*GenerateYearInReviewResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_generate_year_in_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateYearInReviewResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GenerateYearInReviewResponse) GetFinishedBooks() int64 {
+	if x != nil {
+		return x.FinishedBooks
+	}
+	return 0
+}
+
+func (x *GenerateYearInReviewResponse) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *GenerateYearInReviewResponse) GetLongestBook() *ReviewedBook {
+	if x != nil {
+		return x.LongestBook
+	}
+	return nil
+}
+
+func (x *GenerateYearInReviewResponse) GetShortestBook() *ReviewedBook {
+	if x != nil {
+		return x.ShortestBook
+	}
+	return nil
+}
+
+func (x *GenerateYearInReviewResponse) GetFavoriteGenres() []*NameCount {
+	if x != nil {
+		return x.FavoriteGenres
+	}
+	return nil
+}
+
+func (x *GenerateYearInReviewResponse) GetTopMonth() *MonthlyCount {
+	if x != nil {
+		return x.TopMonth
+	}
+	return nil
+}
+
+func (x *GenerateYearInReviewResponse) GetBooks() []*ReviewedBook {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *GenerateYearInReviewResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+var File_rpc_generate_year_in_review_proto protoreflect.FileDescriptor
+
+var file_rpc_generate_year_in_review_proto_rawDesc = string([]byte{
+	0x0a, 0x21, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x79,
+	0x65, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x59, 0x65, 0x61, 0x72, 0x49, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0xd0, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x24, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0d, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x89, 0x03, 0x0a, 0x1c,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x59, 0x65, 0x61, 0x72, 0x49, 0x6e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x6c, 0x6f, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b,
+	0x52, 0x0b, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x35, 0x0a,
+	0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x36, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0e, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x09,
+	0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c,
+	0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_generate_year_in_review_proto_rawDescOnce sync.Once
+	file_rpc_generate_year_in_review_proto_rawDescData []byte
+)
+
+func file_rpc_generate_year_in_review_proto_rawDescGZIP() []byte {
+	file_rpc_generate_year_in_review_proto_rawDescOnce.Do(func() {
+		file_rpc_generate_year_in_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_generate_year_in_review_proto_rawDesc), len(file_rpc_generate_year_in_review_proto_rawDesc)))
+	})
+	return file_rpc_generate_year_in_review_proto_rawDescData
+}
+
+var file_rpc_generate_year_in_review_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_generate_year_in_review_proto_goTypes = []any{
+	(*GenerateYearInReviewRequest)(nil),  // 0: pb.GenerateYearInReviewRequest
+	(*ReviewedBook)(nil),                 // 1: pb.ReviewedBook
+	(*GenerateYearInReviewResponse)(nil), // 2: pb.GenerateYearInReviewResponse
+	(*timestamppb.Timestamp)(nil),        // 3: google.protobuf.Timestamp
+	(*NameCount)(nil),                    // 4: pb.NameCount
+	(*MonthlyCount)(nil),                 // 5: pb.MonthlyCount
+}
+var file_rpc_generate_year_in_review_proto_depIdxs = []int32{
+	3, // 0: pb.ReviewedBook.start_date:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.ReviewedBook.end_date:type_name -> google.protobuf.Timestamp
+	1, // 2: pb.GenerateYearInReviewResponse.longest_book:type_name -> pb.ReviewedBook
+	1, // 3: pb.GenerateYearInReviewResponse.shortest_book:type_name -> pb.ReviewedBook
+	4, // 4: pb.GenerateYearInReviewResponse.favorite_genres:type_name -> pb.NameCount
+	5, // 5: pb.GenerateYearInReviewResponse.top_month:type_name -> pb.MonthlyCount
+	1, // 6: pb.GenerateYearInReviewResponse.books:type_name -> pb.ReviewedBook
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_rpc_generate_year_in_review_proto_init() }
+func file_rpc_generate_year_in_review_proto_init() {
+	if File_rpc_generate_year_in_review_proto != nil {
+		return
+	}
+	file_rpc_get_reading_stats_proto_init()
+	file_rpc_generate_year_in_review_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_generate_year_in_review_proto_rawDesc), len(file_rpc_generate_year_in_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_generate_year_in_review_proto_goTypes,
+		DependencyIndexes: file_rpc_generate_year_in_review_proto_depIdxs,
+		MessageInfos:      file_rpc_generate_year_in_review_proto_msgTypes,
+	}.Build()
+	File_rpc_generate_year_in_review_proto = out.File
+	file_rpc_generate_year_in_review_proto_goTypes = nil
+	file_rpc_generate_year_in_review_proto_depIdxs = nil
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_get_reading_queue.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetReadingQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingQueueRequest) Reset() {
+	*x = GetReadingQueueRequest{}
+	mi := &file_rpc_get_reading_queue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingQueueRequest) ProtoMessage() {}
+
+func (x *GetReadingQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_queue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingQueueRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_queue_proto_rawDescGZIP(), []int{0}
+}
+
+type GetReadingQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Books         []*Book                `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingQueueResponse) Reset() {
+	*x = GetReadingQueueResponse{}
+	mi := &file_rpc_get_reading_queue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingQueueResponse) ProtoMessage() {}
+
+func (x *GetReadingQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_queue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingQueueResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_queue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetReadingQueueResponse) GetBooks() []*Book {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+var File_rpc_get_reading_queue_proto protoreflect.FileDescriptor
+
+var file_rpc_get_reading_queue_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x18, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_get_reading_queue_proto_rawDescOnce sync.Once
+	file_rpc_get_reading_queue_proto_rawDescData []byte
+)
+
+func file_rpc_get_reading_queue_proto_rawDescGZIP() []byte {
+	file_rpc_get_reading_queue_proto_rawDescOnce.Do(func() {
+		file_rpc_get_reading_queue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_reading_queue_proto_rawDesc), len(file_rpc_get_reading_queue_proto_rawDesc)))
+	})
+	return file_rpc_get_reading_queue_proto_rawDescData
+}
+
+var file_rpc_get_reading_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_reading_queue_proto_goTypes = []any{
+	(*GetReadingQueueRequest)(nil),  // 0: pb.GetReadingQueueRequest
+	(*GetReadingQueueResponse)(nil), // 1: pb.GetReadingQueueResponse
+	(*Book)(nil),                    // 2: pb.Book
+}
+var file_rpc_get_reading_queue_proto_depIdxs = []int32{
+	2, // 0: pb.GetReadingQueueResponse.books:type_name -> pb.Book
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_reading_queue_proto_init() }
+func file_rpc_get_reading_queue_proto_init() {
+	if File_rpc_get_reading_queue_proto != nil {
+		return
+	}
+	file_book_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_reading_queue_proto_rawDesc), len(file_rpc_get_reading_queue_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_reading_queue_proto_goTypes,
+		DependencyIndexes: file_rpc_get_reading_queue_proto_depIdxs,
+		MessageInfos:      file_rpc_get_reading_queue_proto_msgTypes,
+	}.Build()
+	File_rpc_get_reading_queue_proto = out.File
+	file_rpc_get_reading_queue_proto_goTypes = nil
+	file_rpc_get_reading_queue_proto_depIdxs = nil
+}
@@ -0,0 +1,396 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_get_reading_stats.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetReadingStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingStatsRequest) Reset() {
+	*x = GetReadingStatsRequest{}
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStatsRequest) ProtoMessage() {}
+
+func (x *GetReadingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetReadingStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetReadingStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type MonthlyCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyCount) Reset() {
+	*x = MonthlyCount{}
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyCount) ProtoMessage() {}
+
+func (x *MonthlyCount) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyCount.ProtoReflect.Descriptor instead.
+func (*MonthlyCount) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MonthlyCount) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+func (x *MonthlyCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NameCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NameCount) Reset() {
+	*x = NameCount{}
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NameCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameCount) ProtoMessage() {}
+
+func (x *NameCount) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameCount.ProtoReflect.Descriptor instead.
+func (*NameCount) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NameCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NameCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetReadingStatsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	From                *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	FinishedBooks       int64                  `protobuf:"varint,3,opt,name=finished_books,json=finishedBooks,proto3" json:"finished_books,omitempty"`
+	FinishedByMonth     []*MonthlyCount        `protobuf:"bytes,4,rep,name=finished_by_month,json=finishedByMonth,proto3" json:"finished_by_month,omitempty"`
+	Genres              []*NameCount           `protobuf:"bytes,5,rep,name=genres,proto3" json:"genres,omitempty"`
+	TopAuthors          []*NameCount           `protobuf:"bytes,6,rep,name=top_authors,json=topAuthors,proto3" json:"top_authors,omitempty"`
+	TopPublishers       []*NameCount           `protobuf:"bytes,7,rep,name=top_publishers,json=topPublishers,proto3" json:"top_publishers,omitempty"`
+	AverageDaysToFinish float64                `protobuf:"fixed64,8,opt,name=average_days_to_finish,json=averageDaysToFinish,proto3" json:"average_days_to_finish,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetReadingStatsResponse) Reset() {
+	*x = GetReadingStatsResponse{}
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStatsResponse) ProtoMessage() {}
+
+func (x *GetReadingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_stats_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetReadingStatsResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetFinishedBooks() int64 {
+	if x != nil {
+		return x.FinishedBooks
+	}
+	return 0
+}
+
+func (x *GetReadingStatsResponse) GetFinishedByMonth() []*MonthlyCount {
+	if x != nil {
+		return x.FinishedByMonth
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetGenres() []*NameCount {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetTopAuthors() []*NameCount {
+	if x != nil {
+		return x.TopAuthors
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetTopPublishers() []*NameCount {
+	if x != nil {
+		return x.TopPublishers
+	}
+	return nil
+}
+
+func (x *GetReadingStatsResponse) GetAverageDaysToFinish() float64 {
+	if x != nil {
+		return x.AverageDaysToFinish
+	}
+	return 0
+}
+
+var File_rpc_get_reading_stats_proto protoreflect.FileDescriptor
+
+var file_rpc_get_reading_stats_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x36, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0a, 0x82, 0xb5, 0x18, 0x06, 0x5a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a,
+	0x09, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9c, 0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x25, 0x0a, 0x0e,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x11, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x0f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x12, 0x25, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f,
+	0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0d, 0x74, 0x6f, 0x70, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x12, 0x33,
+	0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_get_reading_stats_proto_rawDescOnce sync.Once
+	file_rpc_get_reading_stats_proto_rawDescData []byte
+)
+
+func file_rpc_get_reading_stats_proto_rawDescGZIP() []byte {
+	file_rpc_get_reading_stats_proto_rawDescOnce.Do(func() {
+		file_rpc_get_reading_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_reading_stats_proto_rawDesc), len(file_rpc_get_reading_stats_proto_rawDesc)))
+	})
+	return file_rpc_get_reading_stats_proto_rawDescData
+}
+
+var file_rpc_get_reading_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_get_reading_stats_proto_goTypes = []any{
+	(*GetReadingStatsRequest)(nil),  // 0: pb.GetReadingStatsRequest
+	(*MonthlyCount)(nil),            // 1: pb.MonthlyCount
+	(*NameCount)(nil),               // 2: pb.NameCount
+	(*GetReadingStatsResponse)(nil), // 3: pb.GetReadingStatsResponse
+	(*timestamppb.Timestamp)(nil),   // 4: google.protobuf.Timestamp
+}
+var file_rpc_get_reading_stats_proto_depIdxs = []int32{
+	4, // 0: pb.GetReadingStatsRequest.from:type_name -> google.protobuf.Timestamp
+	4, // 1: pb.GetReadingStatsRequest.to:type_name -> google.protobuf.Timestamp
+	4, // 2: pb.MonthlyCount.month:type_name -> google.protobuf.Timestamp
+	4, // 3: pb.GetReadingStatsResponse.from:type_name -> google.protobuf.Timestamp
+	4, // 4: pb.GetReadingStatsResponse.to:type_name -> google.protobuf.Timestamp
+	1, // 5: pb.GetReadingStatsResponse.finished_by_month:type_name -> pb.MonthlyCount
+	2, // 6: pb.GetReadingStatsResponse.genres:type_name -> pb.NameCount
+	2, // 7: pb.GetReadingStatsResponse.top_authors:type_name -> pb.NameCount
+	2, // 8: pb.GetReadingStatsResponse.top_publishers:type_name -> pb.NameCount
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_reading_stats_proto_init() }
+func file_rpc_get_reading_stats_proto_init() {
+	if File_rpc_get_reading_stats_proto != nil {
+		return
+	}
+	file_validate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_reading_stats_proto_rawDesc), len(file_rpc_get_reading_stats_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_reading_stats_proto_goTypes,
+		DependencyIndexes: file_rpc_get_reading_stats_proto_depIdxs,
+		MessageInfos:      file_rpc_get_reading_stats_proto_msgTypes,
+	}.Build()
+	File_rpc_get_reading_stats_proto = out.File
+	file_rpc_get_reading_stats_proto_goTypes = nil
+	file_rpc_get_reading_stats_proto_depIdxs = nil
+}
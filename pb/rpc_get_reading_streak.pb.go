@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_get_reading_streak.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetReadingStreakRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReadingStreakRequest) Reset() {
+	*x = GetReadingStreakRequest{}
+	mi := &file_rpc_get_reading_streak_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStreakRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStreakRequest) ProtoMessage() {}
+
+func (x *GetReadingStreakRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_streak_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStreakRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingStreakRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_streak_proto_rawDescGZIP(), []int{0}
+}
+
+type GetReadingStreakResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CurrentStreak    int32                  `protobuf:"varint,1,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	LongestStreak    int32                  `protobuf:"varint,2,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	LastActivityDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_activity_date,json=lastActivityDate,proto3" json:"last_activity_date,omitempty"`
+	Timezone         string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetReadingStreakResponse) Reset() {
+	*x = GetReadingStreakResponse{}
+	mi := &file_rpc_get_reading_streak_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReadingStreakResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStreakResponse) ProtoMessage() {}
+
+func (x *GetReadingStreakResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_reading_streak_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStreakResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingStreakResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_reading_streak_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetReadingStreakResponse) GetCurrentStreak() int32 {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return 0
+}
+
+func (x *GetReadingStreakResponse) GetLongestStreak() int32 {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return 0
+}
+
+func (x *GetReadingStreakResponse) GetLastActivityDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityDate
+	}
+	return nil
+}
+
+func (x *GetReadingStreakResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+var File_rpc_get_reading_streak_proto protoreflect.FileDescriptor
+
+var file_rpc_get_reading_streak_proto_rawDesc = string([]byte{
+	0x0a, 0x1c, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x6f, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x48, 0x0a, 0x12, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x42,
+	0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_get_reading_streak_proto_rawDescOnce sync.Once
+	file_rpc_get_reading_streak_proto_rawDescData []byte
+)
+
+func file_rpc_get_reading_streak_proto_rawDescGZIP() []byte {
+	file_rpc_get_reading_streak_proto_rawDescOnce.Do(func() {
+		file_rpc_get_reading_streak_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_reading_streak_proto_rawDesc), len(file_rpc_get_reading_streak_proto_rawDesc)))
+	})
+	return file_rpc_get_reading_streak_proto_rawDescData
+}
+
+var file_rpc_get_reading_streak_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_reading_streak_proto_goTypes = []any{
+	(*GetReadingStreakRequest)(nil),  // 0: pb.GetReadingStreakRequest
+	(*GetReadingStreakResponse)(nil), // 1: pb.GetReadingStreakResponse
+	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+}
+var file_rpc_get_reading_streak_proto_depIdxs = []int32{
+	2, // 0: pb.GetReadingStreakResponse.last_activity_date:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_reading_streak_proto_init() }
+func file_rpc_get_reading_streak_proto_init() {
+	if File_rpc_get_reading_streak_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_reading_streak_proto_rawDesc), len(file_rpc_get_reading_streak_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_reading_streak_proto_goTypes,
+		DependencyIndexes: file_rpc_get_reading_streak_proto_depIdxs,
+		MessageInfos:      file_rpc_get_reading_streak_proto_msgTypes,
+	}.Build()
+	File_rpc_get_reading_streak_proto = out.File
+	file_rpc_get_reading_streak_proto_goTypes = nil
+	file_rpc_get_reading_streak_proto_depIdxs = nil
+}
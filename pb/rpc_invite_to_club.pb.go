@@ -0,0 +1,135 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_invite_to_club.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InviteToClubRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        int64                  `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteToClubRequest) Reset() {
+	*x = InviteToClubRequest{}
+	mi := &file_rpc_invite_to_club_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteToClubRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteToClubRequest) ProtoMessage() {}
+
+func (x *InviteToClubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_invite_to_club_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteToClubRequest.ProtoReflect.Descriptor instead.
+func (*InviteToClubRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_invite_to_club_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InviteToClubRequest) GetClubId() int64 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *InviteToClubRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+var File_rpc_invite_to_club_proto protoreflect.FileDescriptor
+
+var file_rpc_invite_to_club_proto_rawDesc = string([]byte{
+	0x0a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x63, 0x6c, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x47,
+	0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6c, 0x75, 0x62, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c,
+	0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_invite_to_club_proto_rawDescOnce sync.Once
+	file_rpc_invite_to_club_proto_rawDescData []byte
+)
+
+func file_rpc_invite_to_club_proto_rawDescGZIP() []byte {
+	file_rpc_invite_to_club_proto_rawDescOnce.Do(func() {
+		file_rpc_invite_to_club_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_invite_to_club_proto_rawDesc), len(file_rpc_invite_to_club_proto_rawDesc)))
+	})
+	return file_rpc_invite_to_club_proto_rawDescData
+}
+
+var file_rpc_invite_to_club_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rpc_invite_to_club_proto_goTypes = []any{
+	(*InviteToClubRequest)(nil), // 0: pb.InviteToClubRequest
+}
+var file_rpc_invite_to_club_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_invite_to_club_proto_init() }
+func file_rpc_invite_to_club_proto_init() {
+	if File_rpc_invite_to_club_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_invite_to_club_proto_rawDesc), len(file_rpc_invite_to_club_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_invite_to_club_proto_goTypes,
+		DependencyIndexes: file_rpc_invite_to_club_proto_depIdxs,
+		MessageInfos:      file_rpc_invite_to_club_proto_msgTypes,
+	}.Build()
+	File_rpc_invite_to_club_proto = out.File
+	file_rpc_invite_to_club_proto_goTypes = nil
+	file_rpc_invite_to_club_proto_depIdxs = nil
+}
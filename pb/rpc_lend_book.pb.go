@@ -0,0 +1,185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_lend_book.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LendBookRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookId         int64                  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	BorrowerName   *string                `protobuf:"bytes,2,opt,name=borrower_name,json=borrowerName,proto3,oneof" json:"borrower_name,omitempty"`
+	BorrowerUserId *int64                 `protobuf:"varint,3,opt,name=borrower_user_id,json=borrowerUserId,proto3,oneof" json:"borrower_user_id,omitempty"`
+	LoanDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=loan_date,json=loanDate,proto3,oneof" json:"loan_date,omitempty"`
+	DueDate        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LendBookRequest) Reset() {
+	*x = LendBookRequest{}
+	mi := &file_rpc_lend_book_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LendBookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LendBookRequest) ProtoMessage() {}
+
+func (x *LendBookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_lend_book_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LendBookRequest.ProtoReflect.Descriptor instead.
+func (*LendBookRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_lend_book_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LendBookRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *LendBookRequest) GetBorrowerName() string {
+	if x != nil && x.BorrowerName != nil {
+		return *x.BorrowerName
+	}
+	return ""
+}
+
+func (x *LendBookRequest) GetBorrowerUserId() int64 {
+	if x != nil && x.BorrowerUserId != nil {
+		return *x.BorrowerUserId
+	}
+	return 0
+}
+
+func (x *LendBookRequest) GetLoanDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoanDate
+	}
+	return nil
+}
+
+func (x *LendBookRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+var File_rpc_lend_book_proto protoreflect.FileDescriptor
+
+var file_rpc_lend_book_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x02, 0x0a, 0x0f, 0x4c,
+	0x65, 0x6e, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0x82, 0xb5, 0x18, 0x04, 0x08, 0x01, 0x10, 0x1e, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0e, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x08, 0x6c, 0x6f, 0x61,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0f, 0x82, 0xb5, 0x18, 0x0b, 0x5a, 0x09, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x48, 0x03, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64,
+	0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c,
+	0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_lend_book_proto_rawDescOnce sync.Once
+	file_rpc_lend_book_proto_rawDescData []byte
+)
+
+func file_rpc_lend_book_proto_rawDescGZIP() []byte {
+	file_rpc_lend_book_proto_rawDescOnce.Do(func() {
+		file_rpc_lend_book_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_lend_book_proto_rawDesc), len(file_rpc_lend_book_proto_rawDesc)))
+	})
+	return file_rpc_lend_book_proto_rawDescData
+}
+
+var file_rpc_lend_book_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_rpc_lend_book_proto_goTypes = []any{
+	(*LendBookRequest)(nil),       // 0: pb.LendBookRequest
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_rpc_lend_book_proto_depIdxs = []int32{
+	1, // 0: pb.LendBookRequest.loan_date:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.LendBookRequest.due_date:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_lend_book_proto_init() }
+func file_rpc_lend_book_proto_init() {
+	if File_rpc_lend_book_proto != nil {
+		return
+	}
+	file_validate_proto_init()
+	file_rpc_lend_book_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_lend_book_proto_rawDesc), len(file_rpc_lend_book_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_lend_book_proto_goTypes,
+		DependencyIndexes: file_rpc_lend_book_proto_depIdxs,
+		MessageInfos:      file_rpc_lend_book_proto_msgTypes,
+	}.Build()
+	File_rpc_lend_book_proto = out.File
+	file_rpc_lend_book_proto_goTypes = nil
+	file_rpc_lend_book_proto_depIdxs = nil
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_list_active_loans.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListActiveLoansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActiveLoansRequest) Reset() {
+	*x = ListActiveLoansRequest{}
+	mi := &file_rpc_list_active_loans_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActiveLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveLoansRequest) ProtoMessage() {}
+
+func (x *ListActiveLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_active_loans_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListActiveLoansRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_active_loans_proto_rawDescGZIP(), []int{0}
+}
+
+type ListActiveLoansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Loans         []*Loan                `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActiveLoansResponse) Reset() {
+	*x = ListActiveLoansResponse{}
+	mi := &file_rpc_list_active_loans_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActiveLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActiveLoansResponse) ProtoMessage() {}
+
+func (x *ListActiveLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_active_loans_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActiveLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListActiveLoansResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_active_loans_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListActiveLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+var File_rpc_list_active_loans_proto protoreflect.FileDescriptor
+
+var file_rpc_list_active_loans_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x18, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x61,
+	0x6e, 0x73, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_list_active_loans_proto_rawDescOnce sync.Once
+	file_rpc_list_active_loans_proto_rawDescData []byte
+)
+
+func file_rpc_list_active_loans_proto_rawDescGZIP() []byte {
+	file_rpc_list_active_loans_proto_rawDescOnce.Do(func() {
+		file_rpc_list_active_loans_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_active_loans_proto_rawDesc), len(file_rpc_list_active_loans_proto_rawDesc)))
+	})
+	return file_rpc_list_active_loans_proto_rawDescData
+}
+
+var file_rpc_list_active_loans_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_active_loans_proto_goTypes = []any{
+	(*ListActiveLoansRequest)(nil),  // 0: pb.ListActiveLoansRequest
+	(*ListActiveLoansResponse)(nil), // 1: pb.ListActiveLoansResponse
+	(*Loan)(nil),                    // 2: pb.Loan
+}
+var file_rpc_list_active_loans_proto_depIdxs = []int32{
+	2, // 0: pb.ListActiveLoansResponse.loans:type_name -> pb.Loan
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_active_loans_proto_init() }
+func file_rpc_list_active_loans_proto_init() {
+	if File_rpc_list_active_loans_proto != nil {
+		return
+	}
+	file_loan_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_active_loans_proto_rawDesc), len(file_rpc_list_active_loans_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_active_loans_proto_goTypes,
+		DependencyIndexes: file_rpc_list_active_loans_proto_depIdxs,
+		MessageInfos:      file_rpc_list_active_loans_proto_msgTypes,
+	}.Build()
+	File_rpc_list_active_loans_proto = out.File
+	file_rpc_list_active_loans_proto_goTypes = nil
+	file_rpc_list_active_loans_proto_depIdxs = nil
+}
@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_list_club_discussion.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListClubDiscussionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        int64                  `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	SectionId     int64                  `protobuf:"varint,2,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClubDiscussionRequest) Reset() {
+	*x = ListClubDiscussionRequest{}
+	mi := &file_rpc_list_club_discussion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClubDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClubDiscussionRequest) ProtoMessage() {}
+
+func (x *ListClubDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_club_discussion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClubDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*ListClubDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_club_discussion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListClubDiscussionRequest) GetClubId() int64 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *ListClubDiscussionRequest) GetSectionId() int64 {
+	if x != nil {
+		return x.SectionId
+	}
+	return 0
+}
+
+func (x *ListClubDiscussionRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListClubDiscussionRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListClubDiscussionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*ClubPost            `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClubDiscussionResponse) Reset() {
+	*x = ListClubDiscussionResponse{}
+	mi := &file_rpc_list_club_discussion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClubDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClubDiscussionResponse) ProtoMessage() {}
+
+func (x *ListClubDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_club_discussion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClubDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*ListClubDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_club_discussion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListClubDiscussionResponse) GetPosts() []*ClubPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+var File_rpc_list_club_discussion_proto protoreflect.FileDescriptor
+
+var file_rpc_list_club_discussion_proto_rawDesc = string([]byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x62, 0x5f,
+	0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x63, 0x6c, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x81, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x44, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x63, 0x6c, 0x75, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x40, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_list_club_discussion_proto_rawDescOnce sync.Once
+	file_rpc_list_club_discussion_proto_rawDescData []byte
+)
+
+func file_rpc_list_club_discussion_proto_rawDescGZIP() []byte {
+	file_rpc_list_club_discussion_proto_rawDescOnce.Do(func() {
+		file_rpc_list_club_discussion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_club_discussion_proto_rawDesc), len(file_rpc_list_club_discussion_proto_rawDesc)))
+	})
+	return file_rpc_list_club_discussion_proto_rawDescData
+}
+
+var file_rpc_list_club_discussion_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_club_discussion_proto_goTypes = []any{
+	(*ListClubDiscussionRequest)(nil),  // 0: pb.ListClubDiscussionRequest
+	(*ListClubDiscussionResponse)(nil), // 1: pb.ListClubDiscussionResponse
+	(*ClubPost)(nil),                   // 2: pb.ClubPost
+}
+var file_rpc_list_club_discussion_proto_depIdxs = []int32{
+	2, // 0: pb.ListClubDiscussionResponse.posts:type_name -> pb.ClubPost
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_club_discussion_proto_init() }
+func file_rpc_list_club_discussion_proto_init() {
+	if File_rpc_list_club_discussion_proto != nil {
+		return
+	}
+	file_club_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_club_discussion_proto_rawDesc), len(file_rpc_list_club_discussion_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_club_discussion_proto_goTypes,
+		DependencyIndexes: file_rpc_list_club_discussion_proto_depIdxs,
+		MessageInfos:      file_rpc_list_club_discussion_proto_msgTypes,
+	}.Build()
+	File_rpc_list_club_discussion_proto = out.File
+	file_rpc_list_club_discussion_proto_goTypes = nil
+	file_rpc_list_club_discussion_proto_depIdxs = nil
+}
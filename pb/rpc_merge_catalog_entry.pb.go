@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_merge_catalog_entry.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MergeCatalogEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  CatalogKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=pb.CatalogKind" json:"kind,omitempty"`
+	// 統合後は別名として残り、以降の登録は統合先に置き換えられる
+	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Canonical     string `protobuf:"bytes,3,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCatalogEntryRequest) Reset() {
+	*x = MergeCatalogEntryRequest{}
+	mi := &file_rpc_merge_catalog_entry_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCatalogEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCatalogEntryRequest) ProtoMessage() {}
+
+func (x *MergeCatalogEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_merge_catalog_entry_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCatalogEntryRequest.ProtoReflect.Descriptor instead.
+func (*MergeCatalogEntryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_merge_catalog_entry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MergeCatalogEntryRequest) GetKind() CatalogKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CatalogKind_CATALOG_KIND_UNSPECIFIED
+}
+
+func (x *MergeCatalogEntryRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MergeCatalogEntryRequest) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+type MergeCatalogEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canonical     string                 `protobuf:"bytes,1,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	MovedBooks    int64                  `protobuf:"varint,2,opt,name=moved_books,json=movedBooks,proto3" json:"moved_books,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCatalogEntryResponse) Reset() {
+	*x = MergeCatalogEntryResponse{}
+	mi := &file_rpc_merge_catalog_entry_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCatalogEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCatalogEntryResponse) ProtoMessage() {}
+
+func (x *MergeCatalogEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_merge_catalog_entry_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCatalogEntryResponse.ProtoReflect.Descriptor instead.
+func (*MergeCatalogEntryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_merge_catalog_entry_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MergeCatalogEntryResponse) GetCanonical() string {
+	if x != nil {
+		return x.Canonical
+	}
+	return ""
+}
+
+func (x *MergeCatalogEntryResponse) GetMovedBooks() int64 {
+	if x != nil {
+		return x.MovedBooks
+	}
+	return 0
+}
+
+var File_rpc_merge_catalog_entry_proto protoreflect.FileDescriptor
+
+var file_rpc_merge_catalog_entry_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x72, 0x70, 0x63, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x63, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x0d, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x8b, 0x01, 0x0a, 0x18, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x70, 0x62, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0x82, 0xb5, 0x18, 0x05,
+	0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c,
+	0x22, 0x5a, 0x0a, 0x19, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x61, 0x6e, 0x6f, 0x6e, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x42, 0x0b, 0x5a, 0x09,
+	0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+})
+
+var (
+	file_rpc_merge_catalog_entry_proto_rawDescOnce sync.Once
+	file_rpc_merge_catalog_entry_proto_rawDescData []byte
+)
+
+func file_rpc_merge_catalog_entry_proto_rawDescGZIP() []byte {
+	file_rpc_merge_catalog_entry_proto_rawDescOnce.Do(func() {
+		file_rpc_merge_catalog_entry_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_merge_catalog_entry_proto_rawDesc), len(file_rpc_merge_catalog_entry_proto_rawDesc)))
+	})
+	return file_rpc_merge_catalog_entry_proto_rawDescData
+}
+
+var file_rpc_merge_catalog_entry_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_merge_catalog_entry_proto_goTypes = []any{
+	(*MergeCatalogEntryRequest)(nil),  // 0: pb.MergeCatalogEntryRequest
+	(*MergeCatalogEntryResponse)(nil), // 1: pb.MergeCatalogEntryResponse
+	(CatalogKind)(0),                  // 2: pb.CatalogKind
+}
+var file_rpc_merge_catalog_entry_proto_depIdxs = []int32{
+	2, // 0: pb.MergeCatalogEntryRequest.kind:type_name -> pb.CatalogKind
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_merge_catalog_entry_proto_init() }
+func file_rpc_merge_catalog_entry_proto_init() {
+	if File_rpc_merge_catalog_entry_proto != nil {
+		return
+	}
+	file_catalog_proto_init()
+	file_validate_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_merge_catalog_entry_proto_rawDesc), len(file_rpc_merge_catalog_entry_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_merge_catalog_entry_proto_goTypes,
+		DependencyIndexes: file_rpc_merge_catalog_entry_proto_depIdxs,
+		MessageInfos:      file_rpc_merge_catalog_entry_proto_msgTypes,
+	}.Build()
+	File_rpc_merge_catalog_entry_proto = out.File
+	file_rpc_merge_catalog_entry_proto_goTypes = nil
+	file_rpc_merge_catalog_entry_proto_depIdxs = nil
+}
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_reorder_reading_queue.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReorderReadingQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookIds       []int64                `protobuf:"varint,1,rep,packed,name=book_ids,json=bookIds,proto3" json:"book_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderReadingQueueRequest) Reset() {
+	*x = ReorderReadingQueueRequest{}
+	mi := &file_rpc_reorder_reading_queue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderReadingQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderReadingQueueRequest) ProtoMessage() {}
+
+func (x *ReorderReadingQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reorder_reading_queue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderReadingQueueRequest.ProtoReflect.Descriptor instead.
+func (*ReorderReadingQueueRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_reorder_reading_queue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReorderReadingQueueRequest) GetBookIds() []int64 {
+	if x != nil {
+		return x.BookIds
+	}
+	return nil
+}
+
+type ReorderReadingQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Books         []*Book                `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderReadingQueueResponse) Reset() {
+	*x = ReorderReadingQueueResponse{}
+	mi := &file_rpc_reorder_reading_queue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderReadingQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderReadingQueueResponse) ProtoMessage() {}
+
+func (x *ReorderReadingQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reorder_reading_queue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderReadingQueueResponse.ProtoReflect.Descriptor instead.
+func (*ReorderReadingQueueResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_reorder_reading_queue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReorderReadingQueueResponse) GetBooks() []*Book {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+var File_rpc_reorder_reading_queue_proto protoreflect.FileDescriptor
+
+var file_rpc_reorder_reading_queue_proto_rawDesc = string([]byte{
+	0x0a, 0x1f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x37, 0x0a, 0x1a, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x3d, 0x0a, 0x1b, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61,
+	0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_reorder_reading_queue_proto_rawDescOnce sync.Once
+	file_rpc_reorder_reading_queue_proto_rawDescData []byte
+)
+
+func file_rpc_reorder_reading_queue_proto_rawDescGZIP() []byte {
+	file_rpc_reorder_reading_queue_proto_rawDescOnce.Do(func() {
+		file_rpc_reorder_reading_queue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_reorder_reading_queue_proto_rawDesc), len(file_rpc_reorder_reading_queue_proto_rawDesc)))
+	})
+	return file_rpc_reorder_reading_queue_proto_rawDescData
+}
+
+var file_rpc_reorder_reading_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_reorder_reading_queue_proto_goTypes = []any{
+	(*ReorderReadingQueueRequest)(nil),  // 0: pb.ReorderReadingQueueRequest
+	(*ReorderReadingQueueResponse)(nil), // 1: pb.ReorderReadingQueueResponse
+	(*Book)(nil),                        // 2: pb.Book
+}
+var file_rpc_reorder_reading_queue_proto_depIdxs = []int32{
+	2, // 0: pb.ReorderReadingQueueResponse.books:type_name -> pb.Book
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_reorder_reading_queue_proto_init() }
+func file_rpc_reorder_reading_queue_proto_init() {
+	if File_rpc_reorder_reading_queue_proto != nil {
+		return
+	}
+	file_book_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_reorder_reading_queue_proto_rawDesc), len(file_rpc_reorder_reading_queue_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_reorder_reading_queue_proto_goTypes,
+		DependencyIndexes: file_rpc_reorder_reading_queue_proto_depIdxs,
+		MessageInfos:      file_rpc_reorder_reading_queue_proto_msgTypes,
+	}.Build()
+	File_rpc_reorder_reading_queue_proto = out.File
+	file_rpc_reorder_reading_queue_proto_goTypes = nil
+	file_rpc_reorder_reading_queue_proto_depIdxs = nil
+}
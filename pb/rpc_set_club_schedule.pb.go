@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_set_club_schedule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetClubScheduleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ClubId int64                  `protobuf:"varint,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	BookId int64                  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	// 読む順に並べる
+	Sections      []*ScheduleSection `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClubScheduleRequest) Reset() {
+	*x = SetClubScheduleRequest{}
+	mi := &file_rpc_set_club_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClubScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClubScheduleRequest) ProtoMessage() {}
+
+func (x *SetClubScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_club_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClubScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetClubScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_club_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetClubScheduleRequest) GetClubId() int64 {
+	if x != nil {
+		return x.ClubId
+	}
+	return 0
+}
+
+func (x *SetClubScheduleRequest) GetBookId() int64 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *SetClubScheduleRequest) GetSections() []*ScheduleSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type ScheduleSection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// 章とページの少なくともどちらかを指定する
+	Chapter       *string                `protobuf:"bytes,2,opt,name=chapter,proto3,oneof" json:"chapter,omitempty"`
+	TargetPage    *int32                 `protobuf:"varint,3,opt,name=target_page,json=targetPage,proto3,oneof" json:"target_page,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleSection) Reset() {
+	*x = ScheduleSection{}
+	mi := &file_rpc_set_club_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSection) ProtoMessage() {}
+
+func (x *ScheduleSection) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_club_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSection.ProtoReflect.Descriptor instead.
+func (*ScheduleSection) Descriptor() ([]byte, []int) {
+	return file_rpc_set_club_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleSection) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ScheduleSection) GetChapter() string {
+	if x != nil && x.Chapter != nil {
+		return *x.Chapter
+	}
+	return ""
+}
+
+func (x *ScheduleSection) GetTargetPage() int32 {
+	if x != nil && x.TargetPage != nil {
+		return *x.TargetPage
+	}
+	return 0
+}
+
+func (x *ScheduleSection) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type SetClubScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sections      []*ClubSection         `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClubScheduleResponse) Reset() {
+	*x = SetClubScheduleResponse{}
+	mi := &file_rpc_set_club_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClubScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClubScheduleResponse) ProtoMessage() {}
+
+func (x *SetClubScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_club_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClubScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetClubScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_club_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetClubScheduleResponse) GetSections() []*ClubSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+var File_rpc_set_club_schedule_proto protoreflect.FileDescriptor
+
+var file_rpc_set_club_schedule_proto_rawDesc = string([]byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x0a, 0x63, 0x6c, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7b,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6c, 0x75, 0x62, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x0f,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0x82, 0xb5, 0x18, 0x05, 0x08, 0x01, 0x10, 0xff, 0x01, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x26, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0x82, 0xb5, 0x18, 0x03, 0x10, 0xff, 0x01, 0x48, 0x00, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0x82,
+	0xb5, 0x18, 0x02, 0x20, 0x01, 0x48, 0x01, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x63, 0x68, 0x61, 0x70, 0x74, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x17, 0x53, 0x65, 0x74,
+	0x43, 0x6c, 0x75, 0x62, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x75, 0x62,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_rpc_set_club_schedule_proto_rawDescOnce sync.Once
+	file_rpc_set_club_schedule_proto_rawDescData []byte
+)
+
+func file_rpc_set_club_schedule_proto_rawDescGZIP() []byte {
+	file_rpc_set_club_schedule_proto_rawDescOnce.Do(func() {
+		file_rpc_set_club_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_club_schedule_proto_rawDesc), len(file_rpc_set_club_schedule_proto_rawDesc)))
+	})
+	return file_rpc_set_club_schedule_proto_rawDescData
+}
+
+var file_rpc_set_club_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_rpc_set_club_schedule_proto_goTypes = []any{
+	(*SetClubScheduleRequest)(nil),  // 0: pb.SetClubScheduleRequest
+	(*ScheduleSection)(nil),         // 1: pb.ScheduleSection
+	(*SetClubScheduleResponse)(nil), // 2: pb.SetClubScheduleResponse
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+	(*ClubSection)(nil),             // 4: pb.ClubSection
+}
+var file_rpc_set_club_schedule_proto_depIdxs = []int32{
+	1, // 0: pb.SetClubScheduleRequest.sections:type_name -> pb.ScheduleSection
+	3, // 1: pb.ScheduleSection.due_date:type_name -> google.protobuf.Timestamp
+	4, // 2: pb.SetClubScheduleResponse.sections:type_name -> pb.ClubSection
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_club_schedule_proto_init() }
+func file_rpc_set_club_schedule_proto_init() {
+	if File_rpc_set_club_schedule_proto != nil {
+		return
+	}
+	file_club_proto_init()
+	file_validate_proto_init()
+	file_rpc_set_club_schedule_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_club_schedule_proto_rawDesc), len(file_rpc_set_club_schedule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_club_schedule_proto_goTypes,
+		DependencyIndexes: file_rpc_set_club_schedule_proto_depIdxs,
+		MessageInfos:      file_rpc_set_club_schedule_proto_msgTypes,
+	}.Build()
+	File_rpc_set_club_schedule_proto = out.File
+	file_rpc_set_club_schedule_proto_goTypes = nil
+	file_rpc_set_club_schedule_proto_depIdxs = nil
+}
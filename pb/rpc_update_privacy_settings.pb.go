@@ -0,0 +1,270 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: rpc_update_privacy_settings.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdatePrivacySettingsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ActivityVisibility *Visibility            `protobuf:"varint,1,opt,name=activity_visibility,json=activityVisibility,proto3,enum=pb.Visibility,oneof" json:"activity_visibility,omitempty"`
+	ProfileVisibility  *Visibility            `protobuf:"varint,2,opt,name=profile_visibility,json=profileVisibility,proto3,enum=pb.Visibility,oneof" json:"profile_visibility,omitempty"`
+	LibraryVisibility  *Visibility            `protobuf:"varint,3,opt,name=library_visibility,json=libraryVisibility,proto3,enum=pb.Visibility,oneof" json:"library_visibility,omitempty"`
+	// 指定した本棚のみ変更する
+	Shelves       []*ShelfVisibility `protobuf:"bytes,4,rep,name=shelves,proto3" json:"shelves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsRequest) Reset() {
+	*x = UpdatePrivacySettingsRequest{}
+	mi := &file_rpc_update_privacy_settings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_privacy_settings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_privacy_settings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdatePrivacySettingsRequest) GetActivityVisibility() Visibility {
+	if x != nil && x.ActivityVisibility != nil {
+		return *x.ActivityVisibility
+	}
+	return Visibility_PRIVATE
+}
+
+func (x *UpdatePrivacySettingsRequest) GetProfileVisibility() Visibility {
+	if x != nil && x.ProfileVisibility != nil {
+		return *x.ProfileVisibility
+	}
+	return Visibility_PRIVATE
+}
+
+func (x *UpdatePrivacySettingsRequest) GetLibraryVisibility() Visibility {
+	if x != nil && x.LibraryVisibility != nil {
+		return *x.LibraryVisibility
+	}
+	return Visibility_PRIVATE
+}
+
+func (x *UpdatePrivacySettingsRequest) GetShelves() []*ShelfVisibility {
+	if x != nil {
+		return x.Shelves
+	}
+	return nil
+}
+
+type UpdatePrivacySettingsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ActivityVisibility Visibility             `protobuf:"varint,1,opt,name=activity_visibility,json=activityVisibility,proto3,enum=pb.Visibility" json:"activity_visibility,omitempty"`
+	ProfileVisibility  Visibility             `protobuf:"varint,2,opt,name=profile_visibility,json=profileVisibility,proto3,enum=pb.Visibility" json:"profile_visibility,omitempty"`
+	LibraryVisibility  Visibility             `protobuf:"varint,3,opt,name=library_visibility,json=libraryVisibility,proto3,enum=pb.Visibility" json:"library_visibility,omitempty"`
+	Shelves            []*ShelfVisibility     `protobuf:"bytes,4,rep,name=shelves,proto3" json:"shelves,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsResponse) Reset() {
+	*x = UpdatePrivacySettingsResponse{}
+	mi := &file_rpc_update_privacy_settings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsResponse) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_privacy_settings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_privacy_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdatePrivacySettingsResponse) GetActivityVisibility() Visibility {
+	if x != nil {
+		return x.ActivityVisibility
+	}
+	return Visibility_PRIVATE
+}
+
+func (x *UpdatePrivacySettingsResponse) GetProfileVisibility() Visibility {
+	if x != nil {
+		return x.ProfileVisibility
+	}
+	return Visibility_PRIVATE
+}
+
+func (x *UpdatePrivacySettingsResponse) GetLibraryVisibility() Visibility {
+	if x != nil {
+		return x.LibraryVisibility
+	}
+	return Visibility_PRIVATE
+}
+
+func (x *UpdatePrivacySettingsResponse) GetShelves() []*ShelfVisibility {
+	if x != nil {
+		return x.Shelves
+	}
+	return nil
+}
+
+var File_rpc_update_privacy_settings_proto protoreflect.FileDescriptor
+
+var file_rpc_update_privacy_settings_proto_rawDesc = string([]byte{
+	0x0a, 0x21, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x10, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe1, 0x02, 0x0a, 0x1c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x13, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x42, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x01, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x12, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x48, 0x02, 0x52, 0x11, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x56, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c,
+	0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x68, 0x65, 0x6c, 0x66, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x07,
+	0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x8d, 0x02,
+	0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x13, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x12, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x3d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70,
+	0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x3d, 0x0a, 0x12, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x11, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2d,
+	0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x42, 0x0b, 0x5a,
+	0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+})
+
+var (
+	file_rpc_update_privacy_settings_proto_rawDescOnce sync.Once
+	file_rpc_update_privacy_settings_proto_rawDescData []byte
+)
+
+func file_rpc_update_privacy_settings_proto_rawDescGZIP() []byte {
+	file_rpc_update_privacy_settings_proto_rawDescOnce.Do(func() {
+		file_rpc_update_privacy_settings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_privacy_settings_proto_rawDesc), len(file_rpc_update_privacy_settings_proto_rawDesc)))
+	})
+	return file_rpc_update_privacy_settings_proto_rawDescData
+}
+
+var file_rpc_update_privacy_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_privacy_settings_proto_goTypes = []any{
+	(*UpdatePrivacySettingsRequest)(nil),  // 0: pb.UpdatePrivacySettingsRequest
+	(*UpdatePrivacySettingsResponse)(nil), // 1: pb.UpdatePrivacySettingsResponse
+	(Visibility)(0),                       // 2: pb.Visibility
+	(*ShelfVisibility)(nil),               // 3: pb.ShelfVisibility
+}
+var file_rpc_update_privacy_settings_proto_depIdxs = []int32{
+	2, // 0: pb.UpdatePrivacySettingsRequest.activity_visibility:type_name -> pb.Visibility
+	2, // 1: pb.UpdatePrivacySettingsRequest.profile_visibility:type_name -> pb.Visibility
+	2, // 2: pb.UpdatePrivacySettingsRequest.library_visibility:type_name -> pb.Visibility
+	3, // 3: pb.UpdatePrivacySettingsRequest.shelves:type_name -> pb.ShelfVisibility
+	2, // 4: pb.UpdatePrivacySettingsResponse.activity_visibility:type_name -> pb.Visibility
+	2, // 5: pb.UpdatePrivacySettingsResponse.profile_visibility:type_name -> pb.Visibility
+	2, // 6: pb.UpdatePrivacySettingsResponse.library_visibility:type_name -> pb.Visibility
+	3, // 7: pb.UpdatePrivacySettingsResponse.shelves:type_name -> pb.ShelfVisibility
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_privacy_settings_proto_init() }
+func file_rpc_update_privacy_settings_proto_init() {
+	if File_rpc_update_privacy_settings_proto != nil {
+		return
+	}
+	file_visibility_proto_init()
+	file_rpc_update_privacy_settings_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_privacy_settings_proto_rawDesc), len(file_rpc_update_privacy_settings_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_privacy_settings_proto_goTypes,
+		DependencyIndexes: file_rpc_update_privacy_settings_proto_depIdxs,
+		MessageInfos:      file_rpc_update_privacy_settings_proto_msgTypes,
+	}.Build()
+	File_rpc_update_privacy_settings_proto = out.File
+	file_rpc_update_privacy_settings_proto_goTypes = nil
+	file_rpc_update_privacy_settings_proto_depIdxs = nil
+}
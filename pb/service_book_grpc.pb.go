@@ -20,8 +20,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookService_RegisterBook_FullMethodName = "/pb.BookService/RegisterBook"
-	BookService_DeleteBook_FullMethodName   = "/pb.BookService/DeleteBook"
+	BookService_RegisterBook_FullMethodName         = "/pb.BookService/RegisterBook"
+	BookService_BatchRegisterBooks_FullMethodName   = "/pb.BookService/BatchRegisterBooks"
+	BookService_GetLibrary_FullMethodName           = "/pb.BookService/GetLibrary"
+	BookService_UpdateBook_FullMethodName           = "/pb.BookService/UpdateBook"
+	BookService_DeleteBook_FullMethodName           = "/pb.BookService/DeleteBook"
+	BookService_GetReadingStats_FullMethodName      = "/pb.BookService/GetReadingStats"
+	BookService_GenerateYearInReview_FullMethodName = "/pb.BookService/GenerateYearInReview"
+	BookService_GetSpendingSummary_FullMethodName   = "/pb.BookService/GetSpendingSummary"
+	BookService_GetReadingStreak_FullMethodName     = "/pb.BookService/GetReadingStreak"
+	BookService_GetActivityCalendar_FullMethodName  = "/pb.BookService/GetActivityCalendar"
+	BookService_GetReadingQueue_FullMethodName      = "/pb.BookService/GetReadingQueue"
+	BookService_ReorderReadingQueue_FullMethodName  = "/pb.BookService/ReorderReadingQueue"
+	BookService_PopNextBook_FullMethodName          = "/pb.BookService/PopNextBook"
+	BookService_UpdateWishlistEntry_FullMethodName  = "/pb.BookService/UpdateWishlistEntry"
+	BookService_RecommendBooks_FullMethodName       = "/pb.BookService/RecommendBooks"
+	BookService_Sync_FullMethodName                 = "/pb.BookService/Sync"
+	BookService_WatchLibrary_FullMethodName         = "/pb.BookService/WatchLibrary"
 )
 
 // BookServiceClient is the client API for BookService service.
@@ -29,7 +44,23 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BookServiceClient interface {
 	RegisterBook(ctx context.Context, in *RegisterBookRequest, opts ...grpc.CallOption) (*Book, error)
+	BatchRegisterBooks(ctx context.Context, in *BatchRegisterBooksRequest, opts ...grpc.CallOption) (*BatchRegisterBooksResponse, error)
+	GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error)
+	UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error)
 	DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetReadingStats(ctx context.Context, in *GetReadingStatsRequest, opts ...grpc.CallOption) (*GetReadingStatsResponse, error)
+	GenerateYearInReview(ctx context.Context, in *GenerateYearInReviewRequest, opts ...grpc.CallOption) (*GenerateYearInReviewResponse, error)
+	GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error)
+	GetReadingStreak(ctx context.Context, in *GetReadingStreakRequest, opts ...grpc.CallOption) (*GetReadingStreakResponse, error)
+	GetActivityCalendar(ctx context.Context, in *GetActivityCalendarRequest, opts ...grpc.CallOption) (*GetActivityCalendarResponse, error)
+	GetReadingQueue(ctx context.Context, in *GetReadingQueueRequest, opts ...grpc.CallOption) (*GetReadingQueueResponse, error)
+	ReorderReadingQueue(ctx context.Context, in *ReorderReadingQueueRequest, opts ...grpc.CallOption) (*ReorderReadingQueueResponse, error)
+	PopNextBook(ctx context.Context, in *PopNextBookRequest, opts ...grpc.CallOption) (*Book, error)
+	UpdateWishlistEntry(ctx context.Context, in *UpdateWishlistEntryRequest, opts ...grpc.CallOption) (*UpdateWishlistEntryResponse, error)
+	RecommendBooks(ctx context.Context, in *RecommendBooksRequest, opts ...grpc.CallOption) (*RecommendBooksResponse, error)
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	// ストリーミングのためgRPCのみで提供する
+	WatchLibrary(ctx context.Context, in *WatchLibraryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LibraryEvent], error)
 }
 
 type bookServiceClient struct {
@@ -50,6 +81,36 @@ func (c *bookServiceClient) RegisterBook(ctx context.Context, in *RegisterBookRe
 	return out, nil
 }
 
+func (c *bookServiceClient) BatchRegisterBooks(ctx context.Context, in *BatchRegisterBooksRequest, opts ...grpc.CallOption) (*BatchRegisterBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchRegisterBooksResponse)
+	err := c.cc.Invoke(ctx, BookService_BatchRegisterBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetLibrary(ctx context.Context, in *GetLibraryRequest, opts ...grpc.CallOption) (*GetLibraryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLibraryResponse)
+	err := c.cc.Invoke(ctx, BookService_GetLibrary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateBook(ctx context.Context, in *UpdateBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_UpdateBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookServiceClient) DeleteBook(ctx context.Context, in *DeleteBookRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -60,12 +121,157 @@ func (c *bookServiceClient) DeleteBook(ctx context.Context, in *DeleteBookReques
 	return out, nil
 }
 
+func (c *bookServiceClient) GetReadingStats(ctx context.Context, in *GetReadingStatsRequest, opts ...grpc.CallOption) (*GetReadingStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReadingStatsResponse)
+	err := c.cc.Invoke(ctx, BookService_GetReadingStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GenerateYearInReview(ctx context.Context, in *GenerateYearInReviewRequest, opts ...grpc.CallOption) (*GenerateYearInReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateYearInReviewResponse)
+	err := c.cc.Invoke(ctx, BookService_GenerateYearInReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingSummaryResponse)
+	err := c.cc.Invoke(ctx, BookService_GetSpendingSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetReadingStreak(ctx context.Context, in *GetReadingStreakRequest, opts ...grpc.CallOption) (*GetReadingStreakResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReadingStreakResponse)
+	err := c.cc.Invoke(ctx, BookService_GetReadingStreak_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetActivityCalendar(ctx context.Context, in *GetActivityCalendarRequest, opts ...grpc.CallOption) (*GetActivityCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetActivityCalendarResponse)
+	err := c.cc.Invoke(ctx, BookService_GetActivityCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) GetReadingQueue(ctx context.Context, in *GetReadingQueueRequest, opts ...grpc.CallOption) (*GetReadingQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReadingQueueResponse)
+	err := c.cc.Invoke(ctx, BookService_GetReadingQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) ReorderReadingQueue(ctx context.Context, in *ReorderReadingQueueRequest, opts ...grpc.CallOption) (*ReorderReadingQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderReadingQueueResponse)
+	err := c.cc.Invoke(ctx, BookService_ReorderReadingQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) PopNextBook(ctx context.Context, in *PopNextBookRequest, opts ...grpc.CallOption) (*Book, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Book)
+	err := c.cc.Invoke(ctx, BookService_PopNextBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) UpdateWishlistEntry(ctx context.Context, in *UpdateWishlistEntryRequest, opts ...grpc.CallOption) (*UpdateWishlistEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWishlistEntryResponse)
+	err := c.cc.Invoke(ctx, BookService_UpdateWishlistEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) RecommendBooks(ctx context.Context, in *RecommendBooksRequest, opts ...grpc.CallOption) (*RecommendBooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendBooksResponse)
+	err := c.cc.Invoke(ctx, BookService_RecommendBooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, BookService_Sync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookServiceClient) WatchLibrary(ctx context.Context, in *WatchLibraryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LibraryEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookService_ServiceDesc.Streams[0], BookService_WatchLibrary_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchLibraryRequest, LibraryEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookService_WatchLibraryClient = grpc.ServerStreamingClient[LibraryEvent]
+
 // BookServiceServer is the server API for BookService service.
 // All implementations must embed UnimplementedBookServiceServer
 // for forward compatibility.
 type BookServiceServer interface {
 	RegisterBook(context.Context, *RegisterBookRequest) (*Book, error)
+	BatchRegisterBooks(context.Context, *BatchRegisterBooksRequest) (*BatchRegisterBooksResponse, error)
+	GetLibrary(context.Context, *GetLibraryRequest) (*GetLibraryResponse, error)
+	UpdateBook(context.Context, *UpdateBookRequest) (*Book, error)
 	DeleteBook(context.Context, *DeleteBookRequest) (*emptypb.Empty, error)
+	GetReadingStats(context.Context, *GetReadingStatsRequest) (*GetReadingStatsResponse, error)
+	GenerateYearInReview(context.Context, *GenerateYearInReviewRequest) (*GenerateYearInReviewResponse, error)
+	GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error)
+	GetReadingStreak(context.Context, *GetReadingStreakRequest) (*GetReadingStreakResponse, error)
+	GetActivityCalendar(context.Context, *GetActivityCalendarRequest) (*GetActivityCalendarResponse, error)
+	GetReadingQueue(context.Context, *GetReadingQueueRequest) (*GetReadingQueueResponse, error)
+	ReorderReadingQueue(context.Context, *ReorderReadingQueueRequest) (*ReorderReadingQueueResponse, error)
+	PopNextBook(context.Context, *PopNextBookRequest) (*Book, error)
+	UpdateWishlistEntry(context.Context, *UpdateWishlistEntryRequest) (*UpdateWishlistEntryResponse, error)
+	RecommendBooks(context.Context, *RecommendBooksRequest) (*RecommendBooksResponse, error)
+	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
+	// ストリーミングのためgRPCのみで提供する
+	WatchLibrary(*WatchLibraryRequest, grpc.ServerStreamingServer[LibraryEvent]) error
 	mustEmbedUnimplementedBookServiceServer()
 }
 
@@ -79,9 +285,54 @@ type UnimplementedBookServiceServer struct{}
 func (UnimplementedBookServiceServer) RegisterBook(context.Context, *RegisterBookRequest) (*Book, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterBook not implemented")
 }
+func (UnimplementedBookServiceServer) BatchRegisterBooks(context.Context, *BatchRegisterBooksRequest) (*BatchRegisterBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRegisterBooks not implemented")
+}
+func (UnimplementedBookServiceServer) GetLibrary(context.Context, *GetLibraryRequest) (*GetLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLibrary not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateBook(context.Context, *UpdateBookRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBook not implemented")
+}
 func (UnimplementedBookServiceServer) DeleteBook(context.Context, *DeleteBookRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBook not implemented")
 }
+func (UnimplementedBookServiceServer) GetReadingStats(context.Context, *GetReadingStatsRequest) (*GetReadingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadingStats not implemented")
+}
+func (UnimplementedBookServiceServer) GenerateYearInReview(context.Context, *GenerateYearInReviewRequest) (*GenerateYearInReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateYearInReview not implemented")
+}
+func (UnimplementedBookServiceServer) GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingSummary not implemented")
+}
+func (UnimplementedBookServiceServer) GetReadingStreak(context.Context, *GetReadingStreakRequest) (*GetReadingStreakResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadingStreak not implemented")
+}
+func (UnimplementedBookServiceServer) GetActivityCalendar(context.Context, *GetActivityCalendarRequest) (*GetActivityCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityCalendar not implemented")
+}
+func (UnimplementedBookServiceServer) GetReadingQueue(context.Context, *GetReadingQueueRequest) (*GetReadingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadingQueue not implemented")
+}
+func (UnimplementedBookServiceServer) ReorderReadingQueue(context.Context, *ReorderReadingQueueRequest) (*ReorderReadingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderReadingQueue not implemented")
+}
+func (UnimplementedBookServiceServer) PopNextBook(context.Context, *PopNextBookRequest) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PopNextBook not implemented")
+}
+func (UnimplementedBookServiceServer) UpdateWishlistEntry(context.Context, *UpdateWishlistEntryRequest) (*UpdateWishlistEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWishlistEntry not implemented")
+}
+func (UnimplementedBookServiceServer) RecommendBooks(context.Context, *RecommendBooksRequest) (*RecommendBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendBooks not implemented")
+}
+func (UnimplementedBookServiceServer) Sync(context.Context, *SyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (UnimplementedBookServiceServer) WatchLibrary(*WatchLibraryRequest, grpc.ServerStreamingServer[LibraryEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLibrary not implemented")
+}
 func (UnimplementedBookServiceServer) mustEmbedUnimplementedBookServiceServer() {}
 func (UnimplementedBookServiceServer) testEmbeddedByValue()                     {}
 
@@ -121,6 +372,60 @@ func _BookService_RegisterBook_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookService_BatchRegisterBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRegisterBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).BatchRegisterBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_BatchRegisterBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).BatchRegisterBooks(ctx, req.(*BatchRegisterBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetLibrary(ctx, req.(*GetLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateBook(ctx, req.(*UpdateBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteBookRequest)
 	if err := dec(in); err != nil {
@@ -139,6 +444,215 @@ func _BookService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookService_GetReadingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetReadingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetReadingStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetReadingStats(ctx, req.(*GetReadingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GenerateYearInReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateYearInReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GenerateYearInReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GenerateYearInReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GenerateYearInReview(ctx, req.(*GenerateYearInReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetSpendingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetSpendingSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetSpendingSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetSpendingSummary(ctx, req.(*GetSpendingSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetReadingStreak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadingStreakRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetReadingStreak(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetReadingStreak_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetReadingStreak(ctx, req.(*GetReadingStreakRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetActivityCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetActivityCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetActivityCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetActivityCalendar(ctx, req.(*GetActivityCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_GetReadingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).GetReadingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_GetReadingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).GetReadingQueue(ctx, req.(*GetReadingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_ReorderReadingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderReadingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).ReorderReadingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_ReorderReadingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).ReorderReadingQueue(ctx, req.(*ReorderReadingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_PopNextBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopNextBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).PopNextBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_PopNextBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).PopNextBook(ctx, req.(*PopNextBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_UpdateWishlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWishlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).UpdateWishlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_UpdateWishlistEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).UpdateWishlistEntry(ctx, req.(*UpdateWishlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_RecommendBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).RecommendBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_RecommendBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).RecommendBooks(ctx, req.(*RecommendBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookService_Sync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookServiceServer).Sync(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookService_WatchLibrary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLibraryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookServiceServer).WatchLibrary(m, &grpc.GenericServerStream[WatchLibraryRequest, LibraryEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookService_WatchLibraryServer = grpc.ServerStreamingServer[LibraryEvent]
+
 // BookService_ServiceDesc is the grpc.ServiceDesc for BookService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,11 +664,73 @@ var BookService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterBook",
 			Handler:    _BookService_RegisterBook_Handler,
 		},
+		{
+			MethodName: "BatchRegisterBooks",
+			Handler:    _BookService_BatchRegisterBooks_Handler,
+		},
+		{
+			MethodName: "GetLibrary",
+			Handler:    _BookService_GetLibrary_Handler,
+		},
+		{
+			MethodName: "UpdateBook",
+			Handler:    _BookService_UpdateBook_Handler,
+		},
 		{
 			MethodName: "DeleteBook",
 			Handler:    _BookService_DeleteBook_Handler,
 		},
+		{
+			MethodName: "GetReadingStats",
+			Handler:    _BookService_GetReadingStats_Handler,
+		},
+		{
+			MethodName: "GenerateYearInReview",
+			Handler:    _BookService_GenerateYearInReview_Handler,
+		},
+		{
+			MethodName: "GetSpendingSummary",
+			Handler:    _BookService_GetSpendingSummary_Handler,
+		},
+		{
+			MethodName: "GetReadingStreak",
+			Handler:    _BookService_GetReadingStreak_Handler,
+		},
+		{
+			MethodName: "GetActivityCalendar",
+			Handler:    _BookService_GetActivityCalendar_Handler,
+		},
+		{
+			MethodName: "GetReadingQueue",
+			Handler:    _BookService_GetReadingQueue_Handler,
+		},
+		{
+			MethodName: "ReorderReadingQueue",
+			Handler:    _BookService_ReorderReadingQueue_Handler,
+		},
+		{
+			MethodName: "PopNextBook",
+			Handler:    _BookService_PopNextBook_Handler,
+		},
+		{
+			MethodName: "UpdateWishlistEntry",
+			Handler:    _BookService_UpdateWishlistEntry_Handler,
+		},
+		{
+			MethodName: "RecommendBooks",
+			Handler:    _BookService_RecommendBooks_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _BookService_Sync_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLibrary",
+			Handler:       _BookService_WatchLibrary_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_book.proto",
 }
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: service_club.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ClubService_CreateClub_FullMethodName           = "/pb.ClubService/CreateClub"
+	ClubService_GetClub_FullMethodName              = "/pb.ClubService/GetClub"
+	ClubService_InviteToClub_FullMethodName         = "/pb.ClubService/InviteToClub"
+	ClubService_JoinClub_FullMethodName             = "/pb.ClubService/JoinClub"
+	ClubService_LeaveClub_FullMethodName            = "/pb.ClubService/LeaveClub"
+	ClubService_UpdateClubMemberRole_FullMethodName = "/pb.ClubService/UpdateClubMemberRole"
+	ClubService_SetClubSchedule_FullMethodName      = "/pb.ClubService/SetClubSchedule"
+	ClubService_UpdateClubProgress_FullMethodName   = "/pb.ClubService/UpdateClubProgress"
+	ClubService_PostClubDiscussion_FullMethodName   = "/pb.ClubService/PostClubDiscussion"
+	ClubService_ListClubDiscussion_FullMethodName   = "/pb.ClubService/ListClubDiscussion"
+)
+
+// ClubServiceClient is the client API for ClubService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClubServiceClient interface {
+	CreateClub(ctx context.Context, in *CreateClubRequest, opts ...grpc.CallOption) (*BookClub, error)
+	GetClub(ctx context.Context, in *GetClubRequest, opts ...grpc.CallOption) (*BookClub, error)
+	InviteToClub(ctx context.Context, in *InviteToClubRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	JoinClub(ctx context.Context, in *JoinClubRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LeaveClub(ctx context.Context, in *LeaveClubRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateClubMemberRole(ctx context.Context, in *UpdateClubMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetClubSchedule(ctx context.Context, in *SetClubScheduleRequest, opts ...grpc.CallOption) (*SetClubScheduleResponse, error)
+	UpdateClubProgress(ctx context.Context, in *UpdateClubProgressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PostClubDiscussion(ctx context.Context, in *PostClubDiscussionRequest, opts ...grpc.CallOption) (*ClubPost, error)
+	ListClubDiscussion(ctx context.Context, in *ListClubDiscussionRequest, opts ...grpc.CallOption) (*ListClubDiscussionResponse, error)
+}
+
+type clubServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClubServiceClient(cc grpc.ClientConnInterface) ClubServiceClient {
+	return &clubServiceClient{cc}
+}
+
+func (c *clubServiceClient) CreateClub(ctx context.Context, in *CreateClubRequest, opts ...grpc.CallOption) (*BookClub, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookClub)
+	err := c.cc.Invoke(ctx, ClubService_CreateClub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) GetClub(ctx context.Context, in *GetClubRequest, opts ...grpc.CallOption) (*BookClub, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookClub)
+	err := c.cc.Invoke(ctx, ClubService_GetClub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) InviteToClub(ctx context.Context, in *InviteToClubRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClubService_InviteToClub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) JoinClub(ctx context.Context, in *JoinClubRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClubService_JoinClub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) LeaveClub(ctx context.Context, in *LeaveClubRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClubService_LeaveClub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) UpdateClubMemberRole(ctx context.Context, in *UpdateClubMemberRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClubService_UpdateClubMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) SetClubSchedule(ctx context.Context, in *SetClubScheduleRequest, opts ...grpc.CallOption) (*SetClubScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetClubScheduleResponse)
+	err := c.cc.Invoke(ctx, ClubService_SetClubSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) UpdateClubProgress(ctx context.Context, in *UpdateClubProgressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ClubService_UpdateClubProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) PostClubDiscussion(ctx context.Context, in *PostClubDiscussionRequest, opts ...grpc.CallOption) (*ClubPost, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClubPost)
+	err := c.cc.Invoke(ctx, ClubService_PostClubDiscussion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clubServiceClient) ListClubDiscussion(ctx context.Context, in *ListClubDiscussionRequest, opts ...grpc.CallOption) (*ListClubDiscussionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClubDiscussionResponse)
+	err := c.cc.Invoke(ctx, ClubService_ListClubDiscussion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClubServiceServer is the server API for ClubService service.
+// All implementations must embed UnimplementedClubServiceServer
+// for forward compatibility.
+type ClubServiceServer interface {
+	CreateClub(context.Context, *CreateClubRequest) (*BookClub, error)
+	GetClub(context.Context, *GetClubRequest) (*BookClub, error)
+	InviteToClub(context.Context, *InviteToClubRequest) (*emptypb.Empty, error)
+	JoinClub(context.Context, *JoinClubRequest) (*emptypb.Empty, error)
+	LeaveClub(context.Context, *LeaveClubRequest) (*emptypb.Empty, error)
+	UpdateClubMemberRole(context.Context, *UpdateClubMemberRoleRequest) (*emptypb.Empty, error)
+	SetClubSchedule(context.Context, *SetClubScheduleRequest) (*SetClubScheduleResponse, error)
+	UpdateClubProgress(context.Context, *UpdateClubProgressRequest) (*emptypb.Empty, error)
+	PostClubDiscussion(context.Context, *PostClubDiscussionRequest) (*ClubPost, error)
+	ListClubDiscussion(context.Context, *ListClubDiscussionRequest) (*ListClubDiscussionResponse, error)
+	mustEmbedUnimplementedClubServiceServer()
+}
+
+// UnimplementedClubServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedClubServiceServer struct{}
+
+func (UnimplementedClubServiceServer) CreateClub(context.Context, *CreateClubRequest) (*BookClub, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClub not implemented")
+}
+func (UnimplementedClubServiceServer) GetClub(context.Context, *GetClubRequest) (*BookClub, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClub not implemented")
+}
+func (UnimplementedClubServiceServer) InviteToClub(context.Context, *InviteToClubRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToClub not implemented")
+}
+func (UnimplementedClubServiceServer) JoinClub(context.Context, *JoinClubRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinClub not implemented")
+}
+func (UnimplementedClubServiceServer) LeaveClub(context.Context, *LeaveClubRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveClub not implemented")
+}
+func (UnimplementedClubServiceServer) UpdateClubMemberRole(context.Context, *UpdateClubMemberRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClubMemberRole not implemented")
+}
+func (UnimplementedClubServiceServer) SetClubSchedule(context.Context, *SetClubScheduleRequest) (*SetClubScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClubSchedule not implemented")
+}
+func (UnimplementedClubServiceServer) UpdateClubProgress(context.Context, *UpdateClubProgressRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClubProgress not implemented")
+}
+func (UnimplementedClubServiceServer) PostClubDiscussion(context.Context, *PostClubDiscussionRequest) (*ClubPost, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostClubDiscussion not implemented")
+}
+func (UnimplementedClubServiceServer) ListClubDiscussion(context.Context, *ListClubDiscussionRequest) (*ListClubDiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClubDiscussion not implemented")
+}
+func (UnimplementedClubServiceServer) mustEmbedUnimplementedClubServiceServer() {}
+func (UnimplementedClubServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeClubServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClubServiceServer will
+// result in compilation errors.
+type UnsafeClubServiceServer interface {
+	mustEmbedUnimplementedClubServiceServer()
+}
+
+func RegisterClubServiceServer(s grpc.ServiceRegistrar, srv ClubServiceServer) {
+	// If the following call pancis, it indicates UnimplementedClubServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ClubService_ServiceDesc, srv)
+}
+
+func _ClubService_CreateClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).CreateClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_CreateClub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).CreateClub(ctx, req.(*CreateClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_GetClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).GetClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_GetClub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).GetClub(ctx, req.(*GetClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_InviteToClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteToClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).InviteToClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_InviteToClub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).InviteToClub(ctx, req.(*InviteToClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_JoinClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).JoinClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_JoinClub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).JoinClub(ctx, req.(*JoinClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_LeaveClub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveClubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).LeaveClub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_LeaveClub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).LeaveClub(ctx, req.(*LeaveClubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_UpdateClubMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClubMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).UpdateClubMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_UpdateClubMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).UpdateClubMemberRole(ctx, req.(*UpdateClubMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_SetClubSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClubScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).SetClubSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_SetClubSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).SetClubSchedule(ctx, req.(*SetClubScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_UpdateClubProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClubProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).UpdateClubProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_UpdateClubProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).UpdateClubProgress(ctx, req.(*UpdateClubProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_PostClubDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostClubDiscussionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).PostClubDiscussion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_PostClubDiscussion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).PostClubDiscussion(ctx, req.(*PostClubDiscussionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClubService_ListClubDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClubDiscussionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClubServiceServer).ListClubDiscussion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClubService_ListClubDiscussion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClubServiceServer).ListClubDiscussion(ctx, req.(*ListClubDiscussionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClubService_ServiceDesc is the grpc.ServiceDesc for ClubService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClubService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ClubService",
+	HandlerType: (*ClubServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateClub",
+			Handler:    _ClubService_CreateClub_Handler,
+		},
+		{
+			MethodName: "GetClub",
+			Handler:    _ClubService_GetClub_Handler,
+		},
+		{
+			MethodName: "InviteToClub",
+			Handler:    _ClubService_InviteToClub_Handler,
+		},
+		{
+			MethodName: "JoinClub",
+			Handler:    _ClubService_JoinClub_Handler,
+		},
+		{
+			MethodName: "LeaveClub",
+			Handler:    _ClubService_LeaveClub_Handler,
+		},
+		{
+			MethodName: "UpdateClubMemberRole",
+			Handler:    _ClubService_UpdateClubMemberRole_Handler,
+		},
+		{
+			MethodName: "SetClubSchedule",
+			Handler:    _ClubService_SetClubSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateClubProgress",
+			Handler:    _ClubService_UpdateClubProgress_Handler,
+		},
+		{
+			MethodName: "PostClubDiscussion",
+			Handler:    _ClubService_PostClubDiscussion_Handler,
+		},
+		{
+			MethodName: "ListClubDiscussion",
+			Handler:    _ClubService_ListClubDiscussion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_club.proto",
+}
@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: visibility.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Visibility int32
+
+const (
+	Visibility_PRIVATE        Visibility = 0
+	Visibility_FOLLOWERS_ONLY Visibility = 1
+	Visibility_PUBLIC         Visibility = 2
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "PRIVATE",
+		1: "FOLLOWERS_ONLY",
+		2: "PUBLIC",
+	}
+	Visibility_value = map[string]int32{
+		"PRIVATE":        0,
+		"FOLLOWERS_ONLY": 1,
+		"PUBLIC":         2,
+	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
+}
+
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_visibility_proto_enumTypes[0].Descriptor()
+}
+
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_visibility_proto_enumTypes[0]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_visibility_proto_rawDescGZIP(), []int{0}
+}
+
+// 同じ読書ステータスの本をまとめた本棚ごとの公開範囲
+type ShelfVisibility struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadingStatus ReadingStatus          `protobuf:"varint,1,opt,name=reading_status,json=readingStatus,proto3,enum=pb.ReadingStatus" json:"reading_status,omitempty"`
+	Visibility    Visibility             `protobuf:"varint,2,opt,name=visibility,proto3,enum=pb.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShelfVisibility) Reset() {
+	*x = ShelfVisibility{}
+	mi := &file_visibility_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShelfVisibility) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShelfVisibility) ProtoMessage() {}
+
+func (x *ShelfVisibility) ProtoReflect() protoreflect.Message {
+	mi := &file_visibility_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShelfVisibility.ProtoReflect.Descriptor instead.
+func (*ShelfVisibility) Descriptor() ([]byte, []int) {
+	return file_visibility_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShelfVisibility) GetReadingStatus() ReadingStatus {
+	if x != nil {
+		return x.ReadingStatus
+	}
+	return ReadingStatus_UNREAD
+}
+
+func (x *ShelfVisibility) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_PRIVATE
+}
+
+var File_visibility_proto protoreflect.FileDescriptor
+
+var file_visibility_proto_rawDesc = string([]byte{
+	0x0a, 0x10, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x14, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7b, 0x0a, 0x0f,
+	0x53, 0x68, 0x65, 0x6c, 0x66, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x38, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2a, 0x39, 0x0a, 0x0a, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41,
+	0x54, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52,
+	0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x55, 0x42, 0x4c,
+	0x49, 0x43, 0x10, 0x02, 0x42, 0x0b, 0x5a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x6c, 0x79, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_visibility_proto_rawDescOnce sync.Once
+	file_visibility_proto_rawDescData []byte
+)
+
+func file_visibility_proto_rawDescGZIP() []byte {
+	file_visibility_proto_rawDescOnce.Do(func() {
+		file_visibility_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_visibility_proto_rawDesc), len(file_visibility_proto_rawDesc)))
+	})
+	return file_visibility_proto_rawDescData
+}
+
+var file_visibility_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_visibility_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_visibility_proto_goTypes = []any{
+	(Visibility)(0),         // 0: pb.Visibility
+	(*ShelfVisibility)(nil), // 1: pb.ShelfVisibility
+	(ReadingStatus)(0),      // 2: pb.ReadingStatus
+}
+var file_visibility_proto_depIdxs = []int32{
+	2, // 0: pb.ShelfVisibility.reading_status:type_name -> pb.ReadingStatus
+	0, // 1: pb.ShelfVisibility.visibility:type_name -> pb.Visibility
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_visibility_proto_init() }
+func file_visibility_proto_init() {
+	if File_visibility_proto != nil {
+		return
+	}
+	file_reading_status_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_visibility_proto_rawDesc), len(file_visibility_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_visibility_proto_goTypes,
+		DependencyIndexes: file_visibility_proto_depIdxs,
+		EnumInfos:         file_visibility_proto_enumTypes,
+		MessageInfos:      file_visibility_proto_msgTypes,
+	}.Build()
+	File_visibility_proto = out.File
+	file_visibility_proto_goTypes = nil
+	file_visibility_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+	"time"
+)
+
+type AuditLogRepository interface {
+	Create(ctx context.Context, req CreateAuditLogRequest) error
+	Get(ctx context.Context, req GetAuditLogsRequest) ([]AuditLogResponse, error)
+}
+
+type AuditLogRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewAuditLogRepository(q sqlc.Querier) AuditLogRepository {
+	return &AuditLogRepositoryImpl{
+		querier: q,
+	}
+}
+
+type CreateAuditLogRequest struct {
+	ActorID    int64
+	Action     entity.AuditAction
+	TargetType entity.AuditTargetType
+	TargetID   *int64
+	// JSONに変換して保存する。nilの場合は空のオブジェクトにする
+	Detail any
+}
+
+// Create 操作と同じトランザクションで呼び出し、記録できない場合は操作も取り消す
+func (r *AuditLogRepositoryImpl) Create(ctx context.Context, req CreateAuditLogRequest) error {
+	detail := json.RawMessage("{}")
+	if req.Detail != nil {
+		b, err := json.Marshal(req.Detail)
+		if err != nil {
+			return err
+		}
+		detail = b
+	}
+	targetID := sql.NullInt64{}
+	if req.TargetID != nil {
+		targetID = sql.NullInt64{Int64: *req.TargetID, Valid: true}
+	}
+	_, err := r.querier.CreateAuditLog(ctx, sqlc.CreateAuditLogParams{
+		ActorID:    req.ActorID,
+		Action:     string(req.Action),
+		TargetType: string(req.TargetType),
+		TargetID:   targetID,
+		Detail:     detail,
+	})
+	return err
+}
+
+type GetAuditLogsRequest struct {
+	TargetType *entity.AuditTargetType
+	TargetID   *int64
+	Limit      int32
+	Offset     int32
+}
+
+type AuditLogResponse struct {
+	ID         int64
+	ActorID    int64
+	Action     entity.AuditAction
+	TargetType entity.AuditTargetType
+	TargetID   *int64
+	Detail     json.RawMessage
+	CreatedAt  time.Time
+}
+
+// Get 新しい順に返す
+func (r *AuditLogRepositoryImpl) Get(ctx context.Context, req GetAuditLogsRequest) ([]AuditLogResponse, error) {
+	targetType := sql.NullString{}
+	if req.TargetType != nil {
+		targetType = sql.NullString{String: string(*req.TargetType), Valid: true}
+	}
+	targetID := sql.NullInt64{}
+	if req.TargetID != nil {
+		targetID = sql.NullInt64{Int64: *req.TargetID, Valid: true}
+	}
+	logs, err := r.querier.GetAuditLogs(ctx, sqlc.GetAuditLogsParams{
+		TargetType: targetType,
+		TargetID:   targetID,
+		PageSize:   req.Limit,
+		PageOffset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]AuditLogResponse, len(logs))
+	for i, l := range logs {
+		res[i] = AuditLogResponse{
+			ID:         l.ID,
+			ActorID:    l.ActorID,
+			Action:     entity.AuditAction(l.Action),
+			TargetType: entity.AuditTargetType(l.TargetType),
+			TargetID:   nilInt64(l.TargetID),
+			Detail:     l.Detail,
+			CreatedAt:  l.CreatedAt,
+		}
+	}
+	return res, nil
+}
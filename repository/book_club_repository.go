@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type BookClubRepository interface {
+	Create(ctx context.Context, req CreateBookClubRequest) (*BookClubResponse, error)
+	DeleteSectionsAfter(ctx context.Context, req DeleteClubSectionsAfterRequest) error
+	GetByID(ctx context.Context, id int64) (*BookClubResponse, error)
+	GetSectionByID(ctx context.Context, id int64) (*ClubSectionResponse, error)
+	GetSections(ctx context.Context, req GetClubSectionsRequest) ([]ClubSectionResponse, error)
+	UpdateCurrentBook(ctx context.Context, req UpdateClubCurrentBookRequest) (*BookClubResponse, error)
+	UpsertSection(ctx context.Context, req UpsertClubSectionRequest) (*ClubSectionResponse, error)
+}
+
+type BookClubRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewBookClubRepository(q sqlc.Querier) BookClubRepository {
+	return &BookClubRepositoryImpl{
+		querier: q,
+	}
+}
+
+type BookClubResponse struct {
+	ID            int64
+	Name          string
+	Description   *string
+	CurrentBookID *int64
+	CreatedAt     time.Time
+}
+
+func newBookClubResponse(c sqlc.BookClub) *BookClubResponse {
+	return &BookClubResponse{
+		ID:            c.ID,
+		Name:          c.Name,
+		Description:   nilString(c.Description),
+		CurrentBookID: nilInt64(c.CurrentBookID),
+		CreatedAt:     c.CreatedAt,
+	}
+}
+
+type CreateBookClubRequest struct {
+	Name        string
+	Description *string
+}
+
+func (r *BookClubRepositoryImpl) Create(ctx context.Context, req CreateBookClubRequest) (*BookClubResponse, error) {
+	desc := sql.NullString{String: "", Valid: false}
+	if req.Description != nil {
+		desc = sql.NullString{String: *req.Description, Valid: true}
+	}
+	res, err := r.querier.CreateBookClub(ctx, sqlc.CreateBookClubParams{
+		Name:        req.Name,
+		Description: desc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBookClubResponse(res), nil
+}
+
+func (r *BookClubRepositoryImpl) GetByID(ctx context.Context, id int64) (*BookClubResponse, error) {
+	res, err := r.querier.GetBookClubByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return newBookClubResponse(res), nil
+}
+
+type UpdateClubCurrentBookRequest struct {
+	ID     int64
+	BookID int64
+}
+
+func (r *BookClubRepositoryImpl) UpdateCurrentBook(ctx context.Context, req UpdateClubCurrentBookRequest) (*BookClubResponse, error) {
+	res, err := r.querier.UpdateBookClubCurrentBook(ctx, sqlc.UpdateBookClubCurrentBookParams{
+		ID:            req.ID,
+		CurrentBookID: sql.NullInt64{Int64: req.BookID, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newBookClubResponse(res), nil
+}
+
+type ClubSectionResponse struct {
+	ID         int64
+	ClubID     int64
+	BookID     int64
+	Position   int32
+	Title      string
+	Chapter    *string
+	TargetPage *int32
+	DueDate    time.Time
+}
+
+func newClubSectionResponse(s sqlc.ClubSection) ClubSectionResponse {
+	return ClubSectionResponse{
+		ID:         s.ID,
+		ClubID:     s.ClubID,
+		BookID:     s.BookID,
+		Position:   s.Position,
+		Title:      s.Title,
+		Chapter:    nilString(s.Chapter),
+		TargetPage: nilInt32(s.TargetPage),
+		DueDate:    s.DueDate,
+	}
+}
+
+type DeleteClubSectionsAfterRequest struct {
+	ClubID   int64
+	BookID   int64
+	Position int32
+}
+
+// DeleteSectionsAfter 指定した位置より後ろの区切りを削除する
+func (r *BookClubRepositoryImpl) DeleteSectionsAfter(ctx context.Context, req DeleteClubSectionsAfterRequest) error {
+	return r.querier.DeleteClubSectionsAfter(ctx, sqlc.DeleteClubSectionsAfterParams{
+		ClubID:   req.ClubID,
+		BookID:   req.BookID,
+		Position: req.Position,
+	})
+}
+
+func (r *BookClubRepositoryImpl) GetSectionByID(ctx context.Context, id int64) (*ClubSectionResponse, error) {
+	res, err := r.querier.GetClubSectionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	section := newClubSectionResponse(res)
+	return &section, nil
+}
+
+type GetClubSectionsRequest struct {
+	ClubID int64
+	BookID int64
+}
+
+func (r *BookClubRepositoryImpl) GetSections(ctx context.Context, req GetClubSectionsRequest) ([]ClubSectionResponse, error) {
+	rows, err := r.querier.GetClubSections(ctx, sqlc.GetClubSectionsParams{
+		ClubID: req.ClubID,
+		BookID: req.BookID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ClubSectionResponse, len(rows))
+	for i, row := range rows {
+		res[i] = newClubSectionResponse(row)
+	}
+	return res, nil
+}
+
+type UpsertClubSectionRequest struct {
+	ClubID     int64
+	BookID     int64
+	Position   int32
+	Title      string
+	Chapter    *string
+	TargetPage *int32
+	DueDate    time.Time
+}
+
+func (r UpsertClubSectionRequest) toParams() sqlc.UpsertClubSectionParams {
+	chapter := sql.NullString{String: "", Valid: false}
+	targetPage := sql.NullInt32{Int32: 0, Valid: false}
+	if r.Chapter != nil {
+		chapter = sql.NullString{String: *r.Chapter, Valid: true}
+	}
+	if r.TargetPage != nil {
+		targetPage = sql.NullInt32{Int32: *r.TargetPage, Valid: true}
+	}
+	return sqlc.UpsertClubSectionParams{
+		ClubID:     r.ClubID,
+		BookID:     r.BookID,
+		Position:   r.Position,
+		Title:      r.Title,
+		Chapter:    chapter,
+		TargetPage: targetPage,
+		DueDate:    r.DueDate,
+	}
+}
+
+// UpsertSection 同じ位置の区切りがある場合は上書きし、ディスカッションは引き継ぐ
+func (r *BookClubRepositoryImpl) UpsertSection(ctx context.Context, req UpsertClubSectionRequest) (*ClubSectionResponse, error) {
+	res, err := r.querier.UpsertClubSection(ctx, req.toParams())
+	if err != nil {
+		return nil, err
+	}
+	section := newClubSectionResponse(res)
+	return &section, nil
+}
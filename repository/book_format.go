@@ -0,0 +1,94 @@
+package repository
+
+import (
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+)
+
+type BookFormat int
+
+const (
+	FormatUnspecified BookFormat = iota
+	Paperback
+	Hardcover
+	Ebook
+	Audiobook
+)
+
+type BookFormatConvertible interface {
+	entity.BookFormat | sqlc.NullBookFormat
+}
+
+func (f BookFormat) toSqlc() sqlc.NullBookFormat {
+	switch f {
+	case Paperback:
+		return sqlc.NullBookFormat{BookFormat: sqlc.BookFormatPaperback, Valid: true}
+	case Hardcover:
+		return sqlc.NullBookFormat{BookFormat: sqlc.BookFormatHardcover, Valid: true}
+	case Ebook:
+		return sqlc.NullBookFormat{BookFormat: sqlc.BookFormatEbook, Valid: true}
+	case Audiobook:
+		return sqlc.NullBookFormat{BookFormat: sqlc.BookFormatAudiobook, Valid: true}
+	default:
+		return sqlc.NullBookFormat{Valid: false}
+	}
+}
+
+func (f BookFormat) ToEntity() entity.BookFormat {
+	switch f {
+	case Paperback:
+		return entity.Paperback
+	case Hardcover:
+		return entity.Hardcover
+	case Ebook:
+		return entity.Ebook
+	case Audiobook:
+		return entity.Audiobook
+	default:
+		return entity.FormatUnspecified
+	}
+}
+
+func newBookFormatFromSqlc(f sqlc.NullBookFormat) BookFormat {
+	if !f.Valid {
+		return FormatUnspecified
+	}
+	switch f.BookFormat {
+	case sqlc.BookFormatPaperback:
+		return Paperback
+	case sqlc.BookFormatHardcover:
+		return Hardcover
+	case sqlc.BookFormatEbook:
+		return Ebook
+	case sqlc.BookFormatAudiobook:
+		return Audiobook
+	default:
+		return FormatUnspecified
+	}
+}
+
+func newBookFormatFromEntity(e entity.BookFormat) BookFormat {
+	switch e {
+	case entity.Paperback:
+		return Paperback
+	case entity.Hardcover:
+		return Hardcover
+	case entity.Ebook:
+		return Ebook
+	case entity.Audiobook:
+		return Audiobook
+	default:
+		return FormatUnspecified
+	}
+}
+
+func NewBookFormat[T BookFormatConvertible](src T) BookFormat {
+	switch v := any(src).(type) {
+	case entity.BookFormat:
+		return newBookFormatFromEntity(v)
+	case sqlc.NullBookFormat:
+		return newBookFormatFromSqlc(v)
+	default:
+		return FormatUnspecified
+	}
+}
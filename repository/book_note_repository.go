@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"github.com/google/uuid"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type BookNoteRepository interface {
+	Delete(ctx context.Context, userID int64, id uuid.UUID) error
+	Get(ctx context.Context, userID int64, id uuid.UUID) (*BookNoteResponse, error)
+	GetUpdatedSince(ctx context.Context, userID int64, since time.Time) ([]BookNoteResponse, error)
+	Upsert(ctx context.Context, req UpsertBookNoteRequest) (*BookNoteResponse, error)
+}
+
+type BookNoteRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewBookNoteRepository(q sqlc.Querier) BookNoteRepository {
+	return &BookNoteRepositoryImpl{
+		querier: q,
+	}
+}
+
+type UpsertBookNoteRequest struct {
+	ID     uuid.UUID
+	UserID int64
+	BookID int64
+	Body   string
+}
+
+type BookNoteResponse struct {
+	ID        uuid.UUID
+	BookID    int64
+	Body      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func newBookNoteResponse(n sqlc.BookNote) BookNoteResponse {
+	return BookNoteResponse{
+		ID:        n.ID,
+		BookID:    n.BookID,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+}
+
+func (r *BookNoteRepositoryImpl) Delete(ctx context.Context, userID int64, id uuid.UUID) error {
+	rowsAffected, err := r.querier.DeleteBookNote(ctx, sqlc.DeleteBookNoteParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNoRowsDeleted
+	}
+	return nil
+}
+
+func (r *BookNoteRepositoryImpl) Get(ctx context.Context, userID int64, id uuid.UUID) (*BookNoteResponse, error) {
+	n, err := r.querier.GetBookNote(ctx, sqlc.GetBookNoteParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newBookNoteResponse(n)
+	return &res, nil
+}
+
+func (r *BookNoteRepositoryImpl) GetUpdatedSince(ctx context.Context, userID int64, since time.Time) ([]BookNoteResponse, error) {
+	notes, err := r.querier.GetBookNotesUpdatedSince(ctx, sqlc.GetBookNotesUpdatedSinceParams{
+		UserID:    userID,
+		UpdatedAt: since,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]BookNoteResponse, len(notes))
+	for i, n := range notes {
+		res[i] = newBookNoteResponse(n)
+	}
+	return res, nil
+}
+
+// Upsert 他のユーザーや別の本のメモと同じIDの場合はsql.ErrNoRowsを返す
+func (r *BookNoteRepositoryImpl) Upsert(ctx context.Context, req UpsertBookNoteRequest) (*BookNoteResponse, error) {
+	n, err := r.querier.UpsertBookNote(ctx, sqlc.UpsertBookNoteParams{
+		ID:     req.ID,
+		UserID: req.UserID,
+		BookID: req.BookID,
+		Body:   req.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newBookNoteResponse(n)
+	return &res, nil
+}
@@ -21,6 +21,7 @@ type BookRepository interface {
 	DeletePublisher(ctx context.Context, name string) error
 	GetBookByID(ctx context.Context, id int64) (*GetBookResponse, error)
 	GetGenresByBookID(ctx context.Context, id int64) ([]string, error)
+	UpdateBook(ctx context.Context, req UpdateBookRequest) (*UpdateBookResponse, error)
 }
 
 type BookRepositoryImpl struct {
@@ -50,6 +51,7 @@ type CreateBookRequest struct {
 	Publisher     *string
 	PublishDate   *time.Time
 	ISBN          *string
+	PageCount     *int32
 }
 
 func (r CreateBookRequest) toParams() sqlc.CreateBookParams {
@@ -60,6 +62,7 @@ func (r CreateBookRequest) toParams() sqlc.CreateBookParams {
 	p := sql.NullString{String: "", Valid: false}
 	pd := sql.NullTime{Time: time.Time{}, Valid: false}
 	ISBN := sql.NullString{String: "", Valid: false}
+	pc := sql.NullInt32{Int32: 0, Valid: false}
 	if r.Description != nil {
 		desc = sql.NullString{String: *r.Description, Valid: true}
 	}
@@ -81,6 +84,9 @@ func (r CreateBookRequest) toParams() sqlc.CreateBookParams {
 	if r.ISBN != nil {
 		ISBN = sql.NullString{String: *r.ISBN, Valid: true}
 	}
+	if r.PageCount != nil {
+		pc = sql.NullInt32{Int32: *r.PageCount, Valid: true}
+	}
 	return sqlc.CreateBookParams{
 		Title:         r.Title,
 		Description:   desc,
@@ -90,6 +96,7 @@ func (r CreateBookRequest) toParams() sqlc.CreateBookParams {
 		PublisherName: p,
 		PublishedDate: pd,
 		Isbn:          ISBN,
+		PageCount:     pc,
 	}
 }
 
@@ -103,6 +110,8 @@ type CreateBookResponse struct {
 	Publisher     *string
 	PublishDate   *time.Time
 	ISBN          *string
+	PageCount     *int32
+	Version       int64
 }
 
 func newCreateResponse(b sqlc.Book) *CreateBookResponse {
@@ -116,6 +125,8 @@ func newCreateResponse(b sqlc.Book) *CreateBookResponse {
 		Publisher:     nilString(b.PublisherName),
 		PublishDate:   nilTime(b.PublishedDate),
 		ISBN:          nilString(b.Isbn),
+		PageCount:     nilInt32(b.PageCount),
+		Version:       b.Version,
 	}
 }
 
@@ -231,6 +242,8 @@ type GetBookResponse struct {
 	PublisherName *string
 	PublishDate   *time.Time
 	ISBN          *string
+	PageCount     *int32
+	Version       int64
 }
 
 func newGetBookResponse(b sqlc.GetBooksByIDRow) *GetBookResponse {
@@ -245,6 +258,8 @@ func newGetBookResponse(b sqlc.GetBooksByIDRow) *GetBookResponse {
 		PublisherName: nilString(b.PublisherName),
 		PublishDate:   nilTime(b.PublishedDate),
 		ISBN:          nilString(b.Isbn),
+		PageCount:     nilInt32(b.PageCount),
+		Version:       b.Version,
 	}
 }
 
@@ -263,3 +278,61 @@ func (r *BookRepositoryImpl) GetGenresByBookID(ctx context.Context, id int64) ([
 	}
 	return g, err
 }
+
+type UpdateBookRequest struct {
+	ID            int64
+	Title         string
+	Description   *string
+	CoverImageURL *string
+	URL           *string
+	Author        *string
+	Publisher     *string
+	PublishDate   *time.Time
+	ISBN          *string
+	PageCount     *int32
+	// nilでない場合はバージョンが一致するときだけ更新する
+	ExpectedVersion *int64
+}
+
+func (r UpdateBookRequest) toParams() sqlc.UpdateBookParams {
+	p := CreateBookRequest{
+		Title:         r.Title,
+		Description:   r.Description,
+		CoverImageURL: r.CoverImageURL,
+		URL:           r.URL,
+		Author:        r.Author,
+		Publisher:     r.Publisher,
+		PublishDate:   r.PublishDate,
+		ISBN:          r.ISBN,
+		PageCount:     r.PageCount,
+	}.toParams()
+	expectedVersion := sql.NullInt64{}
+	if r.ExpectedVersion != nil {
+		expectedVersion = sql.NullInt64{Int64: *r.ExpectedVersion, Valid: true}
+	}
+	return sqlc.UpdateBookParams{
+		ID:              r.ID,
+		Title:           p.Title,
+		Description:     p.Description,
+		CoverImageUrl:   p.CoverImageUrl,
+		Url:             p.Url,
+		AuthorName:      p.AuthorName,
+		PublisherName:   p.PublisherName,
+		PublishedDate:   p.PublishedDate,
+		Isbn:            p.Isbn,
+		PageCount:       p.PageCount,
+		ExpectedVersion: expectedVersion,
+	}
+}
+
+type UpdateBookResponse CreateBookResponse
+
+// UpdateBook 共有している書籍を更新する。存在しないかバージョンが一致しない場合はsql.ErrNoRowsを返す
+func (r *BookRepositoryImpl) UpdateBook(ctx context.Context, req UpdateBookRequest) (*UpdateBookResponse, error) {
+	b, err := r.querier.UpdateBook(ctx, req.toParams())
+	if err != nil {
+		return nil, err
+	}
+	res := UpdateBookResponse(*newCreateResponse(b))
+	return &res, nil
+}
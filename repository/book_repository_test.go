@@ -158,6 +158,28 @@ func TestDeleteGenre(t *testing.T) {
 	require.ErrorIs(t, err, sql.ErrNoRows)
 }
 
+func TestUpdateBook(t *testing.T) {
+	b := createRandomBook(t)
+	a := createRandomAuthor(t)
+	title := testdata.RandomString(8)
+
+	req := UpdateBookRequest{
+		ID:     b.ID,
+		Title:  title,
+		Author: a,
+	}
+	ub, err := bookRepo.UpdateBook(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, b.ID, ub.ID)
+	require.Equal(t, title, ub.Title)
+	require.Equal(t, *a, *ub.Author)
+	require.Nil(t, ub.Description)
+
+	req.ID = 0
+	_, err = bookRepo.UpdateBook(context.Background(), req)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 //func TestRegister(t *testing.T) {
 //	user, err := repository.createRandomUser()
 //	require.NoError(t, err)
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type BookTagRepository interface {
+	Delete(ctx context.Context, req BookTagRequest) error
+	Get(ctx context.Context, req BookTagRequest) (*BookTagResponse, error)
+	GetUpdatedSince(ctx context.Context, userID int64, since time.Time) ([]BookTagResponse, error)
+	Upsert(ctx context.Context, req BookTagRequest) (*BookTagResponse, error)
+}
+
+type BookTagRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewBookTagRepository(q sqlc.Querier) BookTagRepository {
+	return &BookTagRepositoryImpl{
+		querier: q,
+	}
+}
+
+type BookTagRequest struct {
+	UserID int64
+	BookID int64
+	Name   string
+}
+
+type BookTagResponse struct {
+	BookID    int64
+	Name      string
+	UpdatedAt time.Time
+}
+
+func newBookTagResponse(t sqlc.BookTag) BookTagResponse {
+	return BookTagResponse{
+		BookID:    t.BookID,
+		Name:      t.Name,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func (r *BookTagRepositoryImpl) Delete(ctx context.Context, req BookTagRequest) error {
+	rowsAffected, err := r.querier.DeleteBookTag(ctx, sqlc.DeleteBookTagParams{
+		UserID: req.UserID,
+		BookID: req.BookID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNoRowsDeleted
+	}
+	return nil
+}
+
+func (r *BookTagRepositoryImpl) Get(ctx context.Context, req BookTagRequest) (*BookTagResponse, error) {
+	t, err := r.querier.GetBookTag(ctx, sqlc.GetBookTagParams{
+		UserID: req.UserID,
+		BookID: req.BookID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newBookTagResponse(t)
+	return &res, nil
+}
+
+func (r *BookTagRepositoryImpl) GetUpdatedSince(ctx context.Context, userID int64, since time.Time) ([]BookTagResponse, error) {
+	tags, err := r.querier.GetBookTagsUpdatedSince(ctx, sqlc.GetBookTagsUpdatedSinceParams{
+		UserID:    userID,
+		UpdatedAt: since,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]BookTagResponse, len(tags))
+	for i, t := range tags {
+		res[i] = newBookTagResponse(t)
+	}
+	return res, nil
+}
+
+// Upsert 既に付いているタグは更新日時だけを進める
+func (r *BookTagRepositoryImpl) Upsert(ctx context.Context, req BookTagRequest) (*BookTagResponse, error) {
+	t, err := r.querier.UpsertBookTag(ctx, sqlc.UpsertBookTagParams{
+		UserID: req.UserID,
+		BookID: req.BookID,
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newBookTagResponse(t)
+	return &res, nil
+}
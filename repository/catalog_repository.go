@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+)
+
+type CatalogRepository interface {
+	CreateAlias(ctx context.Context, req CreateAliasRequest) error
+	Delete(ctx context.Context, kind entity.CatalogKind, name string) error
+	Exists(ctx context.Context, kind entity.CatalogKind, name string) (bool, error)
+	GetCanonicalName(ctx context.Context, kind entity.CatalogKind, alias string) (string, error)
+	GetDuplicates(ctx context.Context, kind entity.CatalogKind) ([]DuplicateResponse, error)
+	MoveAliases(ctx context.Context, req MoveCatalogEntryRequest) error
+	MoveBooks(ctx context.Context, req MoveCatalogEntryRequest) (int64, error)
+}
+
+type CatalogRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewCatalogRepository(q sqlc.Querier) CatalogRepository {
+	return &CatalogRepositoryImpl{
+		querier: q,
+	}
+}
+
+func unknownCatalogKindError(kind entity.CatalogKind) error {
+	return fmt.Errorf("unknown catalog kind: %s", kind)
+}
+
+type CreateAliasRequest struct {
+	Kind          entity.CatalogKind
+	Alias         string
+	CanonicalName string
+}
+
+// CreateAlias 既に別名として登録されている場合は参照先を置き換える
+func (r *CatalogRepositoryImpl) CreateAlias(ctx context.Context, req CreateAliasRequest) error {
+	var err error
+	switch req.Kind {
+	case entity.CatalogAuthor:
+		_, err = r.querier.CreateAuthorAlias(ctx, sqlc.CreateAuthorAliasParams{Alias: req.Alias, CanonicalName: req.CanonicalName})
+	case entity.CatalogPublisher:
+		_, err = r.querier.CreatePublisherAlias(ctx, sqlc.CreatePublisherAliasParams{Alias: req.Alias, CanonicalName: req.CanonicalName})
+	case entity.CatalogGenre:
+		_, err = r.querier.CreateGenreAlias(ctx, sqlc.CreateGenreAliasParams{Alias: req.Alias, CanonicalName: req.CanonicalName})
+	default:
+		err = unknownCatalogKindError(req.Kind)
+	}
+	return err
+}
+
+func (r *CatalogRepositoryImpl) Delete(ctx context.Context, kind entity.CatalogKind, name string) error {
+	switch kind {
+	case entity.CatalogAuthor:
+		return r.querier.DeleteAuthor(ctx, name)
+	case entity.CatalogPublisher:
+		return r.querier.DeletePublisher(ctx, name)
+	case entity.CatalogGenre:
+		return r.querier.DeleteGenre(ctx, name)
+	default:
+		return unknownCatalogKindError(kind)
+	}
+}
+
+func (r *CatalogRepositoryImpl) Exists(ctx context.Context, kind entity.CatalogKind, name string) (bool, error) {
+	var err error
+	switch kind {
+	case entity.CatalogAuthor:
+		_, err = r.querier.GetAuthorByName(ctx, name)
+	case entity.CatalogPublisher:
+		_, err = r.querier.GetPublisherByName(ctx, name)
+	case entity.CatalogGenre:
+		_, err = r.querier.GetGenreByName(ctx, name)
+	default:
+		err = unknownCatalogKindError(kind)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetCanonicalName 別名でない場合はsql.ErrNoRowsを返す
+func (r *CatalogRepositoryImpl) GetCanonicalName(ctx context.Context, kind entity.CatalogKind, alias string) (string, error) {
+	switch kind {
+	case entity.CatalogAuthor:
+		return r.querier.GetCanonicalAuthorName(ctx, alias)
+	case entity.CatalogPublisher:
+		return r.querier.GetCanonicalPublisherName(ctx, alias)
+	case entity.CatalogGenre:
+		return r.querier.GetCanonicalGenreName(ctx, alias)
+	default:
+		return "", unknownCatalogKindError(kind)
+	}
+}
+
+type DuplicateResponse struct {
+	NormalizedName string
+	Names          []string
+}
+
+func (r *CatalogRepositoryImpl) GetDuplicates(ctx context.Context, kind entity.CatalogKind) ([]DuplicateResponse, error) {
+	var res []DuplicateResponse
+	switch kind {
+	case entity.CatalogAuthor:
+		rows, err := r.querier.GetDuplicateAuthors(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			res = append(res, DuplicateResponse{NormalizedName: row.NormalizedName, Names: row.Names})
+		}
+	case entity.CatalogPublisher:
+		rows, err := r.querier.GetDuplicatePublishers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			res = append(res, DuplicateResponse{NormalizedName: row.NormalizedName, Names: row.Names})
+		}
+	case entity.CatalogGenre:
+		rows, err := r.querier.GetDuplicateGenres(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			res = append(res, DuplicateResponse{NormalizedName: row.NormalizedName, Names: row.Names})
+		}
+	default:
+		return nil, unknownCatalogKindError(kind)
+	}
+	return res, nil
+}
+
+type MoveCatalogEntryRequest struct {
+	Kind entity.CatalogKind
+	From string
+	To   string
+}
+
+// MoveAliases Fromを参照している別名の参照先をToに置き換える
+func (r *CatalogRepositoryImpl) MoveAliases(ctx context.Context, req MoveCatalogEntryRequest) error {
+	var err error
+	switch req.Kind {
+	case entity.CatalogAuthor:
+		_, err = r.querier.UpdateAuthorAliasesCanonicalName(ctx, sqlc.UpdateAuthorAliasesCanonicalNameParams{NewName: req.To, OldName: req.From})
+	case entity.CatalogPublisher:
+		_, err = r.querier.UpdatePublisherAliasesCanonicalName(ctx, sqlc.UpdatePublisherAliasesCanonicalNameParams{NewName: req.To, OldName: req.From})
+	case entity.CatalogGenre:
+		_, err = r.querier.UpdateGenreAliasesCanonicalName(ctx, sqlc.UpdateGenreAliasesCanonicalNameParams{NewName: req.To, OldName: req.From})
+	default:
+		err = unknownCatalogKindError(req.Kind)
+	}
+	return err
+}
+
+// MoveBooks Fromを参照している書籍をToの参照に置き換え、置き換えた書籍の数を返す
+func (r *CatalogRepositoryImpl) MoveBooks(ctx context.Context, req MoveCatalogEntryRequest) (int64, error) {
+	switch req.Kind {
+	case entity.CatalogAuthor:
+		return r.querier.UpdateBooksAuthorName(ctx, sqlc.UpdateBooksAuthorNameParams{
+			NewName: sql.NullString{String: req.To, Valid: true},
+			OldName: sql.NullString{String: req.From, Valid: true},
+		})
+	case entity.CatalogPublisher:
+		return r.querier.UpdateBooksPublisherName(ctx, sqlc.UpdateBooksPublisherNameParams{
+			NewName: sql.NullString{String: req.To, Valid: true},
+			OldName: sql.NullString{String: req.From, Valid: true},
+		})
+	case entity.CatalogGenre:
+		// 両方のジャンルを持つ書籍があるため、コピーしてから元のジャンルを外す
+		_, err := r.querier.CopyBookGenres(ctx, sqlc.CopyBookGenresParams{NewName: req.To, OldName: req.From})
+		if err != nil {
+			return 0, err
+		}
+		return r.querier.DeleteBookGenresByGenre(ctx, req.From)
+	default:
+		return 0, unknownCatalogKindError(req.Kind)
+	}
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+)
+
+func TestMoveAuthor(t *testing.T) {
+	from := createRandomAuthor(t)
+	to := createRandomAuthor(t)
+	b, err := bookRepo.CreateBook(context.Background(), CreateBookRequest{
+		Title:  testdata.RandomString(8),
+		Author: from,
+	})
+	require.NoError(t, err)
+	alias := testdata.RandomString(8)
+	err = catalogRepo.CreateAlias(context.Background(), CreateAliasRequest{
+		Kind:          entity.CatalogAuthor,
+		Alias:         alias,
+		CanonicalName: *from,
+	})
+	require.NoError(t, err)
+
+	req := MoveCatalogEntryRequest{
+		Kind: entity.CatalogAuthor,
+		From: *from,
+		To:   *to,
+	}
+	moved, err := catalogRepo.MoveBooks(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), moved)
+	err = catalogRepo.MoveAliases(context.Background(), req)
+	require.NoError(t, err)
+	err = catalogRepo.Delete(context.Background(), entity.CatalogAuthor, *from)
+	require.NoError(t, err)
+
+	gb, err := bookRepo.GetBookByID(context.Background(), b.ID)
+	require.NoError(t, err)
+	require.Equal(t, *to, *gb.AuthorName)
+	canonical, err := catalogRepo.GetCanonicalName(context.Background(), entity.CatalogAuthor, alias)
+	require.NoError(t, err)
+	require.Equal(t, *to, canonical)
+	exists, err := catalogRepo.Exists(context.Background(), entity.CatalogAuthor, *from)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestGetCanonicalNameNotAlias(t *testing.T) {
+	_, err := catalogRepo.GetCanonicalName(context.Background(), entity.CatalogGenre, testdata.RandomString(8))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
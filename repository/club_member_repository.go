@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type ClubMemberRepository interface {
+	Create(ctx context.Context, req CreateClubMemberRequest) (*ClubMemberResponse, error)
+	CreateInvitation(ctx context.Context, req ClubInvitationRequest) error
+	Delete(ctx context.Context, req GetClubMemberRequest) error
+	DeleteInvitation(ctx context.Context, req ClubInvitationRequest) error
+	Get(ctx context.Context, req GetClubMemberRequest) (*ClubMemberResponse, error)
+	GetByClub(ctx context.Context, clubID int64) ([]ClubMemberDetailResponse, error)
+	ResetProgress(ctx context.Context, clubID int64) error
+	UpdateProgress(ctx context.Context, req UpdateClubProgressRequest) (*ClubMemberResponse, error)
+	UpdateRole(ctx context.Context, req UpdateClubRoleRequest) (*ClubMemberResponse, error)
+}
+
+type ClubMemberRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewClubMemberRepository(q sqlc.Querier) ClubMemberRepository {
+	return &ClubMemberRepositoryImpl{
+		querier: q,
+	}
+}
+
+type ClubMemberResponse struct {
+	ClubID            int64
+	UserID            int64
+	Role              ClubRole
+	CurrentPage       int32
+	ProgressUpdatedAt *time.Time
+	JoinedAt          time.Time
+}
+
+func newClubMemberResponse(m sqlc.ClubMember) *ClubMemberResponse {
+	return &ClubMemberResponse{
+		ClubID:            m.ClubID,
+		UserID:            m.UserID,
+		Role:              NewClubRole[sqlc.ClubRole](m.Role),
+		CurrentPage:       m.CurrentPage,
+		ProgressUpdatedAt: nilTime(m.ProgressUpdatedAt),
+		JoinedAt:          m.JoinedAt,
+	}
+}
+
+type CreateClubMemberRequest struct {
+	ClubID int64
+	UserID int64
+	Role   ClubRole
+}
+
+func (r *ClubMemberRepositoryImpl) Create(ctx context.Context, req CreateClubMemberRequest) (*ClubMemberResponse, error) {
+	res, err := r.querier.CreateClubMember(ctx, sqlc.CreateClubMemberParams{
+		ClubID: req.ClubID,
+		UserID: req.UserID,
+		Role:   req.Role.toSqlc(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClubMemberResponse(res), nil
+}
+
+type ClubInvitationRequest struct {
+	ClubID    int64
+	InviteeID int64
+	// 削除時は不要
+	InviterID int64
+}
+
+func (r *ClubMemberRepositoryImpl) CreateInvitation(ctx context.Context, req ClubInvitationRequest) error {
+	_, err := r.querier.CreateClubInvitation(ctx, sqlc.CreateClubInvitationParams{
+		ClubID:    req.ClubID,
+		InviteeID: req.InviteeID,
+		InviterID: req.InviterID,
+	})
+	return err
+}
+
+func (r *ClubMemberRepositoryImpl) DeleteInvitation(ctx context.Context, req ClubInvitationRequest) error {
+	rows, err := r.querier.DeleteClubInvitation(ctx, sqlc.DeleteClubInvitationParams{
+		ClubID:    req.ClubID,
+		InviteeID: req.InviteeID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNoRowsDeleted
+	}
+	return nil
+}
+
+type GetClubMemberRequest struct {
+	ClubID int64
+	UserID int64
+}
+
+func (r *ClubMemberRepositoryImpl) Delete(ctx context.Context, req GetClubMemberRequest) error {
+	rows, err := r.querier.DeleteClubMember(ctx, sqlc.DeleteClubMemberParams{
+		ClubID: req.ClubID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNoRowsDeleted
+	}
+	return nil
+}
+
+func (r *ClubMemberRepositoryImpl) Get(ctx context.Context, req GetClubMemberRequest) (*ClubMemberResponse, error) {
+	res, err := r.querier.GetClubMember(ctx, sqlc.GetClubMemberParams{
+		ClubID: req.ClubID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClubMemberResponse(res), nil
+}
+
+type ClubMemberDetailResponse struct {
+	UserID            int64
+	Name              string
+	Role              ClubRole
+	CurrentPage       int32
+	ProgressUpdatedAt *time.Time
+	JoinedAt          time.Time
+}
+
+func (r *ClubMemberRepositoryImpl) GetByClub(ctx context.Context, clubID int64) ([]ClubMemberDetailResponse, error) {
+	rows, err := r.querier.GetClubMembers(ctx, clubID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ClubMemberDetailResponse, len(rows))
+	for i, row := range rows {
+		res[i] = ClubMemberDetailResponse{
+			UserID:            row.UserID,
+			Name:              row.Name,
+			Role:              NewClubRole[sqlc.ClubRole](row.Role),
+			CurrentPage:       row.CurrentPage,
+			ProgressUpdatedAt: nilTime(row.ProgressUpdatedAt),
+			JoinedAt:          row.JoinedAt,
+		}
+	}
+	return res, nil
+}
+
+func (r *ClubMemberRepositoryImpl) ResetProgress(ctx context.Context, clubID int64) error {
+	return r.querier.ResetClubProgress(ctx, clubID)
+}
+
+type UpdateClubProgressRequest struct {
+	ClubID      int64
+	UserID      int64
+	CurrentPage int32
+}
+
+func (r *ClubMemberRepositoryImpl) UpdateProgress(ctx context.Context, req UpdateClubProgressRequest) (*ClubMemberResponse, error) {
+	res, err := r.querier.UpdateClubMemberProgress(ctx, sqlc.UpdateClubMemberProgressParams{
+		ClubID:      req.ClubID,
+		UserID:      req.UserID,
+		CurrentPage: req.CurrentPage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClubMemberResponse(res), nil
+}
+
+type UpdateClubRoleRequest struct {
+	ClubID int64
+	UserID int64
+	Role   ClubRole
+}
+
+func (r *ClubMemberRepositoryImpl) UpdateRole(ctx context.Context, req UpdateClubRoleRequest) (*ClubMemberResponse, error) {
+	res, err := r.querier.UpdateClubMemberRole(ctx, sqlc.UpdateClubMemberRoleParams{
+		ClubID: req.ClubID,
+		UserID: req.UserID,
+		Role:   req.Role.toSqlc(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClubMemberResponse(res), nil
+}
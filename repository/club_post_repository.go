@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type ClubPostRepository interface {
+	Create(ctx context.Context, req CreateClubPostRequest) (*ClubPostResponse, error)
+	GetBySection(ctx context.Context, req GetClubPostsRequest) ([]ClubPostResponse, error)
+}
+
+type ClubPostRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewClubPostRepository(q sqlc.Querier) ClubPostRepository {
+	return &ClubPostRepositoryImpl{
+		querier: q,
+	}
+}
+
+type ClubPostResponse struct {
+	ID        int64
+	UserID    int64
+	UserName  string
+	Body      string
+	CreatedAt time.Time
+}
+
+type CreateClubPostRequest struct {
+	SectionID int64
+	UserID    int64
+	Body      string
+}
+
+func (r *ClubPostRepositoryImpl) Create(ctx context.Context, req CreateClubPostRequest) (*ClubPostResponse, error) {
+	res, err := r.querier.CreateClubPost(ctx, sqlc.CreateClubPostParams{
+		SectionID: req.SectionID,
+		UserID:    req.UserID,
+		Body:      req.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ClubPostResponse{
+		ID:        res.ID,
+		UserID:    res.UserID,
+		Body:      res.Body,
+		CreatedAt: res.CreatedAt,
+	}, nil
+}
+
+type GetClubPostsRequest struct {
+	SectionID int64
+	Limit     int32
+	Offset    int32
+}
+
+func (r *ClubPostRepositoryImpl) GetBySection(ctx context.Context, req GetClubPostsRequest) ([]ClubPostResponse, error) {
+	rows, err := r.querier.GetClubPosts(ctx, sqlc.GetClubPostsParams{
+		SectionID: req.SectionID,
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ClubPostResponse, len(rows))
+	for i, row := range rows {
+		res[i] = ClubPostResponse(row)
+	}
+	return res, nil
+}
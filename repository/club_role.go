@@ -0,0 +1,73 @@
+package repository
+
+import (
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+)
+
+type ClubRole int
+
+const (
+	ClubOwner ClubRole = iota
+	ClubModerator
+	ClubMember
+)
+
+type ClubRoleConvertible interface {
+	entity.ClubRole | sqlc.ClubRole
+}
+
+func (r ClubRole) toSqlc() sqlc.ClubRole {
+	switch r {
+	case ClubOwner:
+		return sqlc.ClubRoleOwner
+	case ClubModerator:
+		return sqlc.ClubRoleModerator
+	default:
+		return sqlc.ClubRoleMember
+	}
+}
+
+func (r ClubRole) ToEntity() entity.ClubRole {
+	switch r {
+	case ClubOwner:
+		return entity.ClubOwner
+	case ClubModerator:
+		return entity.ClubModerator
+	default:
+		return entity.ClubMember
+	}
+}
+
+func newClubRoleFromSqlc(r sqlc.ClubRole) ClubRole {
+	switch r {
+	case sqlc.ClubRoleOwner:
+		return ClubOwner
+	case sqlc.ClubRoleModerator:
+		return ClubModerator
+	default:
+		return ClubMember
+	}
+}
+
+func newClubRoleFromEntity(e entity.ClubRole) ClubRole {
+	switch e {
+	case entity.ClubOwner:
+		return ClubOwner
+	case entity.ClubModerator:
+		return ClubModerator
+	default:
+		return ClubMember
+	}
+}
+
+func NewClubRole[T ClubRoleConvertible](src T) ClubRole {
+	switch v := any(src).(type) {
+	case entity.ClubRole:
+		return newClubRoleFromEntity(v)
+	case sqlc.ClubRole:
+		return newClubRoleFromSqlc(v)
+	default:
+		return ClubMember
+	}
+}
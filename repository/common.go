@@ -13,6 +13,13 @@ func nilInt64(ni sql.NullInt64) *int64 {
 	return &ni.Int64
 }
 
+func nilInt32(ni sql.NullInt32) *int32 {
+	if !ni.Valid {
+		return nil
+	}
+	return &ni.Int32
+}
+
 func nilString(ns sql.NullString) *string {
 	if !ns.Valid {
 		return nil
@@ -5,3 +5,4 @@ import (
 )
 
 var ErrNoRowsDeleted = errors.New("no rows were deleted")
+var ErrNoRowsUpdated = errors.New("no rows were updated")
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+	"time"
+)
+
+type FeedEventType int
+
+const (
+	AddedBookEvent FeedEventType = iota
+	StartedReadingEvent
+	FinishedReadingEvent
+)
+
+func (t FeedEventType) toSqlc() sqlc.FeedEventType {
+	switch t {
+	case StartedReadingEvent:
+		return sqlc.FeedEventTypeStartedReading
+	case FinishedReadingEvent:
+		return sqlc.FeedEventTypeFinishedReading
+	default:
+		return sqlc.FeedEventTypeAddedBook
+	}
+}
+
+func (t FeedEventType) ToEntity() entity.FeedEventType {
+	switch t {
+	case StartedReadingEvent:
+		return entity.StartedReading
+	case FinishedReadingEvent:
+		return entity.FinishedReading
+	default:
+		return entity.AddedBook
+	}
+}
+
+func newFeedEventType(t sqlc.FeedEventType) FeedEventType {
+	switch t {
+	case sqlc.FeedEventTypeStartedReading:
+		return StartedReadingEvent
+	case sqlc.FeedEventTypeFinishedReading:
+		return FinishedReadingEvent
+	default:
+		return AddedBookEvent
+	}
+}
+
+type FeedRepository interface {
+	CreateEvent(ctx context.Context, req CreateFeedEventRequest) error
+	GetFeed(ctx context.Context, req GetFeedRequest) ([]FeedEventResponse, error)
+}
+
+type FeedRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewFeedRepository(q sqlc.Querier) FeedRepository {
+	return &FeedRepositoryImpl{
+		querier: q,
+	}
+}
+
+type CreateFeedEventRequest struct {
+	UserID int64
+	BookID int64
+	Type   FeedEventType
+}
+
+func (r *FeedRepositoryImpl) CreateEvent(ctx context.Context, req CreateFeedEventRequest) error {
+	_, err := r.querier.CreateFeedEvent(ctx, sqlc.CreateFeedEventParams{
+		UserID:    req.UserID,
+		BookID:    req.BookID,
+		EventType: req.Type.toSqlc(),
+	})
+	return err
+}
+
+type GetFeedRequest struct {
+	UserID int64
+	// 指定した場合はこのIDより古いイベントのみ返す
+	BeforeID *int64
+	Limit    int32
+}
+
+type FeedEventResponse struct {
+	ID            int64
+	Type          FeedEventType
+	CreatedAt     time.Time
+	UserID        int64
+	UserName      string
+	BookID        int64
+	Title         string
+	AuthorName    *string
+	CoverImageURL *string
+}
+
+// GetFeed フォローしているユーザーのうち、活動を非公開にしていないユーザーのイベントを新しい順に返す
+func (r *FeedRepositoryImpl) GetFeed(ctx context.Context, req GetFeedRequest) ([]FeedEventResponse, error) {
+	beforeID := sql.NullInt64{Int64: 0, Valid: false}
+	if req.BeforeID != nil {
+		beforeID = sql.NullInt64{Int64: *req.BeforeID, Valid: true}
+	}
+	rows, err := r.querier.GetFeed(ctx, sqlc.GetFeedParams{
+		UserID:   req.UserID,
+		BeforeID: beforeID,
+		PageSize: req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]FeedEventResponse, len(rows))
+	for i, row := range rows {
+		res[i] = FeedEventResponse{
+			ID:            row.ID,
+			Type:          newFeedEventType(row.EventType),
+			CreatedAt:     row.CreatedAt,
+			UserID:        row.UserID,
+			UserName:      row.UserName,
+			BookID:        row.BookID,
+			Title:         row.Title,
+			AuthorName:    nilString(row.AuthorName),
+			CoverImageURL: nilString(row.CoverImageUrl),
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type FollowRepository interface {
+	Create(ctx context.Context, req FollowRequest) error
+	Delete(ctx context.Context, req FollowRequest) error
+	GetFollowers(ctx context.Context, req GetFollowsRequest) ([]FollowUserResponse, error)
+	GetFollowing(ctx context.Context, req GetFollowsRequest) ([]FollowUserResponse, error)
+}
+
+type FollowRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewFollowRepository(q sqlc.Querier) FollowRepository {
+	return &FollowRepositoryImpl{
+		querier: q,
+	}
+}
+
+type FollowRequest struct {
+	FollowerID int64
+	FolloweeID int64
+}
+
+func (r *FollowRepositoryImpl) Create(ctx context.Context, req FollowRequest) error {
+	_, err := r.querier.CreateFollow(ctx, sqlc.CreateFollowParams{
+		FollowerID: req.FollowerID,
+		FolloweeID: req.FolloweeID,
+	})
+	return err
+}
+
+func (r *FollowRepositoryImpl) Delete(ctx context.Context, req FollowRequest) error {
+	rows, err := r.querier.DeleteFollow(ctx, sqlc.DeleteFollowParams{
+		FollowerID: req.FollowerID,
+		FolloweeID: req.FolloweeID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNoRowsDeleted
+	}
+	return nil
+}
+
+type GetFollowsRequest struct {
+	UserID int64
+	Limit  int32
+	Offset int32
+}
+
+type FollowUserResponse struct {
+	ID         int64
+	Name       string
+	FollowedAt time.Time
+}
+
+// GetFollowers フォローされた日時の新しい順に返す
+func (r *FollowRepositoryImpl) GetFollowers(ctx context.Context, req GetFollowsRequest) ([]FollowUserResponse, error) {
+	rows, err := r.querier.GetFollowers(ctx, sqlc.GetFollowersParams{
+		FolloweeID: req.UserID,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]FollowUserResponse, len(rows))
+	for i, row := range rows {
+		res[i] = FollowUserResponse(row)
+	}
+	return res, nil
+}
+
+// GetFollowing フォローした日時の新しい順に返す
+func (r *FollowRepositoryImpl) GetFollowing(ctx context.Context, req GetFollowsRequest) ([]FollowUserResponse, error) {
+	rows, err := r.querier.GetFollowing(ctx, sqlc.GetFollowingParams{
+		FollowerID: req.UserID,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]FollowUserResponse, len(rows))
+	for i, row := range rows {
+		res[i] = FollowUserResponse(row)
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type IdempotencyKeyRepository interface {
+	Create(ctx context.Context, req CreateIdempotencyKeyRequest) error
+	Delete(ctx context.Context, userID int64, key string) error
+	DeleteExpired(ctx context.Context, userID int64) error
+	Get(ctx context.Context, userID int64, key string) (*IdempotencyKeyResponse, error)
+	SaveResponse(ctx context.Context, req SaveIdempotencyKeyResponseRequest) error
+}
+
+type IdempotencyKeyRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewIdempotencyKeyRepository(q sqlc.Querier) IdempotencyKeyRepository {
+	return &IdempotencyKeyRepositoryImpl{
+		querier: q,
+	}
+}
+
+type CreateIdempotencyKeyRequest struct {
+	UserID      int64
+	Key         string
+	Method      string
+	RequestHash string
+	ExpiresAt   time.Time
+}
+
+type SaveIdempotencyKeyResponseRequest struct {
+	UserID   int64
+	Key      string
+	Response []byte
+}
+
+type IdempotencyKeyResponse struct {
+	Method      string
+	RequestHash string
+	// 最初のリクエストが処理中の場合はnil
+	Response  []byte
+	ExpiresAt time.Time
+}
+
+// Create 既に同じキーが存在する場合はsql.ErrNoRowsを返す
+func (r *IdempotencyKeyRepositoryImpl) Create(ctx context.Context, req CreateIdempotencyKeyRequest) error {
+	_, err := r.querier.CreateIdempotencyKey(ctx, sqlc.CreateIdempotencyKeyParams{
+		UserID:      req.UserID,
+		Key:         req.Key,
+		Method:      req.Method,
+		RequestHash: req.RequestHash,
+		ExpiresAt:   req.ExpiresAt,
+	})
+	return err
+}
+
+func (r *IdempotencyKeyRepositoryImpl) Delete(ctx context.Context, userID int64, key string) error {
+	return r.querier.DeleteIdempotencyKey(ctx, sqlc.DeleteIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+}
+
+func (r *IdempotencyKeyRepositoryImpl) DeleteExpired(ctx context.Context, userID int64) error {
+	return r.querier.DeleteExpiredIdempotencyKeys(ctx, userID)
+}
+
+func (r *IdempotencyKeyRepositoryImpl) Get(ctx context.Context, userID int64, key string) (*IdempotencyKeyResponse, error) {
+	k, err := r.querier.GetIdempotencyKey(ctx, sqlc.GetIdempotencyKeyParams{
+		UserID: userID,
+		Key:    key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &IdempotencyKeyResponse{
+		Method:      k.Method,
+		RequestHash: k.RequestHash,
+		Response:    k.Response,
+		ExpiresAt:   k.ExpiresAt,
+	}, nil
+}
+
+func (r *IdempotencyKeyRepositoryImpl) SaveResponse(ctx context.Context, req SaveIdempotencyKeyResponseRequest) error {
+	return r.querier.UpdateIdempotencyKeyResponse(ctx, sqlc.UpdateIdempotencyKeyResponseParams{
+		UserID:   req.UserID,
+		Key:      req.Key,
+		Response: req.Response,
+	})
+}
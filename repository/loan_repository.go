@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type LoanRepository interface {
+	Create(ctx context.Context, req CreateLoanRequest) (*CreateLoanResponse, error)
+	GetActive(ctx context.Context, userID int64) ([]LoanResponse, error)
+	GetActiveByBook(ctx context.Context, req GetActiveLoanByBookRequest) (*CreateLoanResponse, error)
+	GetByID(ctx context.Context, req GetLoanByIDRequest) (*LoanResponse, error)
+	GetOverdue(ctx context.Context, userID int64) ([]LoanResponse, error)
+	Return(ctx context.Context, req ReturnLoanRequest) (*CreateLoanResponse, error)
+}
+
+type LoanRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewLoanRepository(q sqlc.Querier) LoanRepository {
+	return &LoanRepositoryImpl{
+		querier: q,
+	}
+}
+
+type CreateLoanRequest struct {
+	UserID         int64
+	BookID         int64
+	BorrowerName   string
+	BorrowerUserID *int64
+	LoanDate       time.Time
+	DueDate        *time.Time
+}
+
+func (r CreateLoanRequest) toParams() sqlc.CreateLoanParams {
+	bu := sql.NullInt64{Int64: 0, Valid: false}
+	dd := sql.NullTime{Time: time.Time{}, Valid: false}
+	if r.BorrowerUserID != nil {
+		bu = sql.NullInt64{Int64: *r.BorrowerUserID, Valid: true}
+	}
+	if r.DueDate != nil {
+		dd = sql.NullTime{Time: *r.DueDate, Valid: true}
+	}
+	return sqlc.CreateLoanParams{
+		UserID:         r.UserID,
+		BookID:         r.BookID,
+		BorrowerName:   r.BorrowerName,
+		BorrowerUserID: bu,
+		LoanDate:       r.LoanDate,
+		DueDate:        dd,
+	}
+}
+
+type CreateLoanResponse struct {
+	ID             int64
+	BookID         int64
+	BorrowerName   string
+	BorrowerUserID *int64
+	LoanDate       time.Time
+	DueDate        *time.Time
+	ReturnDate     *time.Time
+}
+
+func newCreateLoanResponse(l sqlc.Loan) *CreateLoanResponse {
+	return &CreateLoanResponse{
+		ID:             l.ID,
+		BookID:         l.BookID,
+		BorrowerName:   l.BorrowerName,
+		BorrowerUserID: nilInt64(l.BorrowerUserID),
+		LoanDate:       l.LoanDate,
+		DueDate:        nilTime(l.DueDate),
+		ReturnDate:     nilTime(l.ReturnDate),
+	}
+}
+
+func (r *LoanRepositoryImpl) Create(ctx context.Context, req CreateLoanRequest) (*CreateLoanResponse, error) {
+	l, err := r.querier.CreateLoan(ctx, req.toParams())
+	if err != nil {
+		return nil, err
+	}
+	return newCreateLoanResponse(l), nil
+}
+
+type LoanResponse struct {
+	ID             int64
+	BorrowerName   string
+	BorrowerUserID *int64
+	LoanDate       time.Time
+	DueDate        *time.Time
+	ReturnDate     *time.Time
+	Overdue        bool
+	BookID         int64
+	Title          string
+	Genres         []string
+	Description    *string
+	CoverImageURL  *string
+	URL            *string
+	AuthorName     *string
+	PublisherName  *string
+	PublishDate    *time.Time
+	ISBN           *string
+	Status         ReadingStatus
+	StartDate      *time.Time
+	EndDate        *time.Time
+	Priority       int16
+	QueuePosition  *int32
+	Owned          bool
+}
+
+// newLoanResponse GetActiveLoansRow/GetOverdueLoansRowは同じ列を持つため変換して利用する
+func newLoanResponse(r sqlc.GetLoanByIDRow) LoanResponse {
+	return LoanResponse{
+		ID:             r.ID,
+		BorrowerName:   r.BorrowerName,
+		BorrowerUserID: nilInt64(r.BorrowerUserID),
+		LoanDate:       r.LoanDate,
+		DueDate:        nilTime(r.DueDate),
+		ReturnDate:     nilTime(r.ReturnDate),
+		Overdue:        r.Overdue,
+		BookID:         r.BookID,
+		Title:          r.Title,
+		Genres:         newGenres(r.Genres),
+		Description:    nilString(r.Description),
+		CoverImageURL:  nilString(r.CoverImageUrl),
+		URL:            nilString(r.Url),
+		AuthorName:     nilString(r.AuthorName),
+		PublisherName:  nilString(r.PublisherName),
+		PublishDate:    nilTime(r.PublishedDate),
+		ISBN:           nilString(r.Isbn),
+		Status:         NewReadingStatus[sqlc.ReadingStatus](r.Status),
+		StartDate:      nilTime(r.StartDate),
+		EndDate:        nilTime(r.EndDate),
+		Priority:       r.Priority,
+		QueuePosition:  nilInt32(r.QueuePosition),
+		Owned:          r.Owned,
+	}
+}
+
+func (r *LoanRepositoryImpl) GetActive(ctx context.Context, userID int64) ([]LoanResponse, error) {
+	rows, err := r.querier.GetActiveLoans(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]LoanResponse, len(rows))
+	for i, row := range rows {
+		res[i] = newLoanResponse(sqlc.GetLoanByIDRow(row))
+	}
+	return res, nil
+}
+
+type GetActiveLoanByBookRequest struct {
+	UserID int64
+	BookID int64
+}
+
+func (r *LoanRepositoryImpl) GetActiveByBook(ctx context.Context, req GetActiveLoanByBookRequest) (*CreateLoanResponse, error) {
+	l, err := r.querier.GetActiveLoanByBook(ctx, sqlc.GetActiveLoanByBookParams{
+		UserID: req.UserID,
+		BookID: req.BookID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newCreateLoanResponse(l), nil
+}
+
+type GetLoanByIDRequest struct {
+	ID     int64
+	UserID int64
+}
+
+func (r *LoanRepositoryImpl) GetByID(ctx context.Context, req GetLoanByIDRequest) (*LoanResponse, error) {
+	row, err := r.querier.GetLoanByID(ctx, sqlc.GetLoanByIDParams{
+		ID:     req.ID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newLoanResponse(row)
+	return &res, nil
+}
+
+// GetOverdue 貸出中かつ返却期限を過ぎたものを期限の古い順に返す
+func (r *LoanRepositoryImpl) GetOverdue(ctx context.Context, userID int64) ([]LoanResponse, error) {
+	rows, err := r.querier.GetOverdueLoans(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]LoanResponse, len(rows))
+	for i, row := range rows {
+		res[i] = newLoanResponse(sqlc.GetLoanByIDRow(row))
+	}
+	return res, nil
+}
+
+type ReturnLoanRequest struct {
+	ID         int64
+	UserID     int64
+	ReturnDate time.Time
+}
+
+func (r *LoanRepositoryImpl) Return(ctx context.Context, req ReturnLoanRequest) (*CreateLoanResponse, error) {
+	l, err := r.querier.ReturnLoan(ctx, sqlc.ReturnLoanParams{
+		ID:         req.ID,
+		UserID:     req.UserID,
+		ReturnDate: sql.NullTime{Time: req.ReturnDate, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newCreateLoanResponse(l), nil
+}
@@ -11,10 +11,12 @@ import (
 	"time"
 )
 
+var dbtx sqlc.DBTX
 var querier sqlc.Querier
 var bookRepo BookRepository
 var userRepo UserRepository
 var readingHistoryRepo ReadingHistoryRepository
+var catalogRepo CatalogRepository
 
 func init() {
 	rand.New(rand.NewSource(time.Now().UnixNano()))
@@ -26,10 +28,12 @@ func TestMain(m *testing.M) {
 		log.Fatal("cannot load config:", err)
 	}
 	a := &sqlc.Adapter{}
-	_, q := a.Connect(config.DBDriver, config.DBSource)
+	d, q := a.Connect(config.DBDriver, config.DBSource)
+	dbtx = d
 	querier = q
 	bookRepo = NewBookRepository(q)
 	userRepo = NewUserRepository(q)
 	readingHistoryRepo = NewReadingHistoryRepository(q)
+	catalogRepo = NewCatalogRepository(q)
 	os.Exit(m.Run())
 }
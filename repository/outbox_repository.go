@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+	"time"
+)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, req CreateOutboxEventRequest) error
+	GetUnpublished(ctx context.Context, limit int32) ([]OutboxEventResponse, error)
+	GetPublishedByUser(ctx context.Context, req GetPublishedOutboxEventsByUserRequest) ([]OutboxEventResponse, error)
+	GetLatestPublishedSeq(ctx context.Context) (int64, error)
+	NextPublishedSeq(ctx context.Context) (int64, error)
+	MarkPublished(ctx context.Context, req MarkOutboxEventPublishedRequest) error
+}
+
+type OutboxRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewOutboxRepository(q sqlc.Querier) OutboxRepository {
+	return &OutboxRepositoryImpl{
+		querier: q,
+	}
+}
+
+type CreateOutboxEventRequest struct {
+	Type entity.DomainEventType
+	// JSONに変換して保存する
+	Payload any
+}
+
+type GetPublishedOutboxEventsByUserRequest struct {
+	UserID   int64
+	AfterSeq int64
+	Types    []entity.DomainEventType
+	Limit    int32
+}
+
+type MarkOutboxEventPublishedRequest struct {
+	ID           int64
+	PublishedSeq int64
+}
+
+type OutboxEventResponse struct {
+	ID        int64
+	Type      entity.DomainEventType
+	Payload   json.RawMessage
+	CreatedAt time.Time
+	// 未配信の場合は0
+	PublishedSeq int64
+}
+
+// Create 変更と同じトランザクションで呼び出す
+func (r *OutboxRepositoryImpl) Create(ctx context.Context, req CreateOutboxEventRequest) error {
+	payload, err := json.Marshal(req.Payload)
+	if err != nil {
+		return err
+	}
+	_, err = r.querier.CreateOutboxEvent(ctx, sqlc.CreateOutboxEventParams{
+		EventType: string(req.Type),
+		Payload:   payload,
+	})
+	return err
+}
+
+// GetUnpublished 取得した行はトランザクションが終わるまでロックされる
+func (r *OutboxRepositoryImpl) GetUnpublished(ctx context.Context, limit int32) ([]OutboxEventResponse, error) {
+	events, err := r.querier.GetUnpublishedOutboxEvents(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return newOutboxEventResponses(events), nil
+}
+
+// GetPublishedByUser 配信済みのイベントを配信した順に返す
+func (r *OutboxRepositoryImpl) GetPublishedByUser(ctx context.Context, req GetPublishedOutboxEventsByUserRequest) ([]OutboxEventResponse, error) {
+	types := make([]string, len(req.Types))
+	for i, t := range req.Types {
+		types[i] = string(t)
+	}
+	events, err := r.querier.GetPublishedOutboxEventsByUser(ctx, sqlc.GetPublishedOutboxEventsByUserParams{
+		UserID:     req.UserID,
+		AfterSeq:   req.AfterSeq,
+		EventTypes: types,
+		PageSize:   req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newOutboxEventResponses(events), nil
+}
+
+// GetLatestPublishedSeq 配信済みのイベントがなければ0を返す
+func (r *OutboxRepositoryImpl) GetLatestPublishedSeq(ctx context.Context) (int64, error) {
+	return r.querier.GetLatestOutboxPublishedSeq(ctx)
+}
+
+// NextPublishedSeq 配信する直前に呼び出し、配信した順に増える番号を払い出す。
+// 配信に失敗して使われなかった番号は欠番になる
+func (r *OutboxRepositoryImpl) NextPublishedSeq(ctx context.Context) (int64, error) {
+	return r.querier.GetNextOutboxPublishedSeq(ctx)
+}
+
+func (r *OutboxRepositoryImpl) MarkPublished(ctx context.Context, req MarkOutboxEventPublishedRequest) error {
+	return r.querier.MarkOutboxEventPublished(ctx, sqlc.MarkOutboxEventPublishedParams{
+		PublishedSeq: sql.NullInt64{Int64: req.PublishedSeq, Valid: true},
+		ID:           req.ID,
+	})
+}
+
+func newOutboxEventResponses(events []sqlc.OutboxEvent) []OutboxEventResponse {
+	res := make([]OutboxEventResponse, len(events))
+	for i, e := range events {
+		res[i] = OutboxEventResponse{
+			ID:           e.ID,
+			Type:         entity.DomainEventType(e.EventType),
+			Payload:      e.Payload,
+			CreatedAt:    e.CreatedAt,
+			PublishedSeq: e.PublishedSeq.Int64,
+		}
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+	"testing"
+)
+
+func TestGetPublishedByUserInPublishedOrder(t *testing.T) {
+	// 他のテストのリレーに配信されないよう、コミットせずに検証する
+	tx, err := dbtx.(*sql.DB).BeginTx(context.Background(), nil)
+	require.NoError(t, err)
+	defer tx.Rollback()
+	q := sqlc.New(tx)
+	repo := NewOutboxRepository(q)
+
+	user := createRandomUser(t)
+	payload, err := json.Marshal(entity.BookDeletedPayload{UserID: user.ID, BookID: 1})
+	require.NoError(t, err)
+	ids := make([]int64, 2)
+	for i := range ids {
+		e, err := q.CreateOutboxEvent(context.Background(), sqlc.CreateOutboxEventParams{
+			EventType: string(entity.BookDeleted),
+			Payload:   payload,
+		})
+		require.NoError(t, err)
+		ids[i] = e.ID
+	}
+
+	// idの大きいイベントが先に配信された場合
+	seqs := make([]int64, 2)
+	for i, id := range []int64{ids[1], ids[0]} {
+		seqs[i], err = repo.NextPublishedSeq(context.Background())
+		require.NoError(t, err)
+		err = repo.MarkPublished(context.Background(), MarkOutboxEventPublishedRequest{ID: id, PublishedSeq: seqs[i]})
+		require.NoError(t, err)
+	}
+
+	req := GetPublishedOutboxEventsByUserRequest{
+		UserID: user.ID,
+		Types:  []entity.DomainEventType{entity.BookDeleted},
+		Limit:  10,
+	}
+	events, err := repo.GetPublishedByUser(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, ids[1], events[0].ID)
+	require.Equal(t, ids[0], events[1].ID)
+
+	// idが小さくても後から配信されたイベントは再開位置より後として返る
+	req.AfterSeq = seqs[0]
+	events, err = repo.GetPublishedByUser(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, ids[0], events[0].ID)
+	require.Equal(t, seqs[1], events[0].PublishedSeq)
+
+	latest, err := repo.GetLatestPublishedSeq(context.Background())
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, latest, seqs[1])
+}
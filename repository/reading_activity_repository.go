@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type ReadingActivityRepository interface {
+	Create(ctx context.Context, req CreateReadingActivityRequest) error
+	GetActivityDates(ctx context.Context, req GetActivityDatesRequest) ([]time.Time, error)
+	GetDailyActivityCounts(ctx context.Context, req GetDailyActivityCountsRequest) ([]DailyActivityCountResponse, error)
+}
+
+type ReadingActivityRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewReadingActivityRepository(q sqlc.Querier) ReadingActivityRepository {
+	return &ReadingActivityRepositoryImpl{
+		querier: q,
+	}
+}
+
+type ActivityType int
+
+const (
+	StatusChanged ActivityType = iota
+	ProgressUpdated
+)
+
+func (t ActivityType) toSqlc() sqlc.ActivityType {
+	switch t {
+	case ProgressUpdated:
+		return sqlc.ActivityTypeProgressUpdated
+	default:
+		return sqlc.ActivityTypeStatusChanged
+	}
+}
+
+type CreateReadingActivityRequest struct {
+	UserID     int64
+	BookID     int64
+	Type       ActivityType
+	OccurredAt time.Time
+}
+
+func (r *ReadingActivityRepositoryImpl) Create(ctx context.Context, req CreateReadingActivityRequest) error {
+	_, err := r.querier.CreateReadingActivity(ctx, sqlc.CreateReadingActivityParams{
+		UserID:       req.UserID,
+		BookID:       req.BookID,
+		ActivityType: req.Type.toSqlc(),
+		OccurredAt:   req.OccurredAt,
+	})
+	return err
+}
+
+type GetActivityDatesRequest struct {
+	UserID   int64
+	Timezone string
+}
+
+// GetActivityDates 指定したタイムゾーンでの活動日を新しい順に返す
+func (r *ReadingActivityRepositoryImpl) GetActivityDates(ctx context.Context, req GetActivityDatesRequest) ([]time.Time, error) {
+	return r.querier.GetActivityDates(ctx, sqlc.GetActivityDatesParams{
+		Timezone: req.Timezone,
+		UserID:   req.UserID,
+	})
+}
+
+type GetDailyActivityCountsRequest struct {
+	UserID   int64
+	Timezone string
+	From     time.Time
+	To       time.Time
+}
+
+type DailyActivityCountResponse struct {
+	Date  time.Time
+	Count int64
+}
+
+func (r *ReadingActivityRepositoryImpl) GetDailyActivityCounts(ctx context.Context, req GetDailyActivityCountsRequest) ([]DailyActivityCountResponse, error) {
+	rows, err := r.querier.GetDailyActivityCounts(ctx, sqlc.GetDailyActivityCountsParams{
+		Timezone: req.Timezone,
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]DailyActivityCountResponse, len(rows))
+	for i, row := range rows {
+		res[i] = DailyActivityCountResponse{
+			Date:  row.ActivityDate,
+			Count: row.ActivityCount,
+		}
+	}
+	return res, nil
+}
@@ -9,12 +9,21 @@ import (
 )
 
 type ReadingHistoryRepository interface {
+	CountByBook(ctx context.Context, bookID int64) (int64, error)
 	Create(ctx context.Context, req CreateReadingHistoryRequest) (*CreateReadingHistoryResponse, error)
 	Delete(ctx context.Context, req DeleteReadingHistoryRequest) error
+	DeleteByBook(ctx context.Context, bookID int64) ([]int64, error)
 	GetByUser(ctx context.Context, req GetReadingHistoryByUserRequest) ([]GetReadingHistoryByUserResponse, error)
 	GetByUserAndBook(ctx context.Context, req GetReadingHistoryByUserAndBookRequest) (*GetReadingHistoryByUserAndBookResponse, error)
 	GetByUserAndStatus(ctx context.Context, req GetReadingHistoryByUserAndStatusRequest) ([]GetReadingHistoryByUserAndStatusResponse, error)
+	GetForSync(ctx context.Context, req GetReadingHistoryForSyncRequest) (*SyncReadingHistoryResponse, error)
+	GetNextQueuePosition(ctx context.Context, userID int64) (int32, error)
+	GetPublicByUser(ctx context.Context, req GetPublicReadingHistoryByUserRequest) ([]GetPublicReadingHistoryByUserResponse, error)
+	GetQueue(ctx context.Context, userID int64) ([]GetReadingQueueResponse, error)
+	GetUpdatedSince(ctx context.Context, req GetReadingHistoriesUpdatedSinceRequest) ([]SyncReadingHistoryResponse, error)
 	Update(ctx context.Context, req UpdateReadingHistoryRequest) (*UpdateReadingHistoryResponse, error)
+	UpdateQueuePosition(ctx context.Context, req UpdateQueuePositionRequest) error
+	UpdateWishlistEntry(ctx context.Context, req UpdateWishlistEntryRequest) (*UpdateReadingHistoryResponse, error)
 }
 
 type ReadingHistoryRepositoryImpl struct {
@@ -34,6 +43,8 @@ const (
 	Reading
 	Done
 	Unknown
+	Abandoned
+	OnHold
 )
 
 type Convertible interface {
@@ -48,6 +59,10 @@ func (status ReadingStatus) toSqlc() sqlc.ReadingStatus {
 		return sqlc.ReadingStatusReading
 	case Done:
 		return sqlc.ReadingStatusDone
+	case Abandoned:
+		return sqlc.ReadingStatusAbandoned
+	case OnHold:
+		return sqlc.ReadingStatusOnHold
 	default:
 		return sqlc.ReadingStatusUnknown
 	}
@@ -61,6 +76,10 @@ func (status ReadingStatus) ToEntity() entity.ReadingStatus {
 		return entity.Reading
 	case Done:
 		return entity.Done
+	case Abandoned:
+		return entity.Abandoned
+	case OnHold:
+		return entity.OnHold
 	default:
 		return entity.Unknown
 	}
@@ -74,6 +93,10 @@ func newFromSqlc(rs sqlc.ReadingStatus) ReadingStatus {
 		return Reading
 	case sqlc.ReadingStatusDone:
 		return Done
+	case sqlc.ReadingStatusAbandoned:
+		return Abandoned
+	case sqlc.ReadingStatusOnHold:
+		return OnHold
 	default:
 		return Unknown
 	}
@@ -87,6 +110,10 @@ func newFromEntity(e entity.ReadingStatus) ReadingStatus {
 		return Reading
 	case entity.Done:
 		return Done
+	case entity.Abandoned:
+		return Abandoned
+	case entity.OnHold:
+		return OnHold
 	default:
 		return Unknown
 	}
@@ -105,11 +132,21 @@ func NewReadingStatus[T Convertible](src T) ReadingStatus {
 }
 
 type CreateReadingHistoryRequest struct {
-	UserID    int64
-	BookID    int64
-	Status    ReadingStatus
-	StartDate *time.Time
-	EndDate   *time.Time
+	UserID        int64
+	BookID        int64
+	Status        ReadingStatus
+	StartDate     *time.Time
+	EndDate       *time.Time
+	Priority      int16
+	QueuePosition *int32
+	Owned         bool
+	Format        BookFormat
+	// 通貨の最小単位(円、セントなど)での金額
+	PurchasePrice    *int64
+	PurchaseCurrency *string
+	PurchaseStore    *string
+	PurchaseDate     *time.Time
+	DurationMinutes  *int32
 }
 
 func (r CreateReadingHistoryRequest) toParams() sqlc.CreateReadingHistoryParams {
@@ -121,31 +158,89 @@ func (r CreateReadingHistoryRequest) toParams() sqlc.CreateReadingHistoryParams
 	if r.EndDate != nil {
 		ed = sql.NullTime{Time: *r.EndDate, Valid: true}
 	}
+	qp := sql.NullInt32{Int32: 0, Valid: false}
+	if r.QueuePosition != nil {
+		qp = sql.NullInt32{Int32: *r.QueuePosition, Valid: true}
+	}
+	pp := sql.NullInt64{Int64: 0, Valid: false}
+	pc := sql.NullString{String: "", Valid: false}
+	ps := sql.NullString{String: "", Valid: false}
+	pd := sql.NullTime{Time: time.Time{}, Valid: false}
+	dm := sql.NullInt32{Int32: 0, Valid: false}
+	if r.PurchasePrice != nil {
+		pp = sql.NullInt64{Int64: *r.PurchasePrice, Valid: true}
+	}
+	if r.PurchaseCurrency != nil {
+		pc = sql.NullString{String: *r.PurchaseCurrency, Valid: true}
+	}
+	if r.PurchaseStore != nil {
+		ps = sql.NullString{String: *r.PurchaseStore, Valid: true}
+	}
+	if r.PurchaseDate != nil {
+		pd = sql.NullTime{Time: *r.PurchaseDate, Valid: true}
+	}
+	if r.DurationMinutes != nil {
+		dm = sql.NullInt32{Int32: *r.DurationMinutes, Valid: true}
+	}
 	return sqlc.CreateReadingHistoryParams{
-		UserID:    r.UserID,
-		BookID:    r.BookID,
-		Status:    r.Status.toSqlc(),
-		StartDate: sd,
-		EndDate:   ed,
+		UserID:           r.UserID,
+		BookID:           r.BookID,
+		Status:           r.Status.toSqlc(),
+		StartDate:        sd,
+		EndDate:          ed,
+		Priority:         r.Priority,
+		QueuePosition:    qp,
+		Owned:            r.Owned,
+		Format:           r.Format.toSqlc(),
+		PurchasePrice:    pp,
+		PurchaseCurrency: pc,
+		PurchaseStore:    ps,
+		PurchaseDate:     pd,
+		DurationMinutes:  dm,
 	}
 }
 
 type CreateReadingHistoryResponse struct {
-	BookID    int64
-	Status    ReadingStatus
-	StartDate *time.Time
-	EndDate   *time.Time
+	BookID           int64
+	Status           ReadingStatus
+	StartDate        *time.Time
+	EndDate          *time.Time
+	Priority         int16
+	QueuePosition    *int32
+	Owned            bool
+	Format           BookFormat
+	PurchasePrice    *int64
+	PurchaseCurrency *string
+	PurchaseStore    *string
+	PurchaseDate     *time.Time
+	DurationMinutes  *int32
+	Version          int64
 }
 
 func newCreateReadingHistoryResponse(r sqlc.ReadingHistory) *CreateReadingHistoryResponse {
 	return &CreateReadingHistoryResponse{
-		BookID:    r.BookID,
-		Status:    NewReadingStatus(r.Status),
-		StartDate: nilTime(r.StartDate),
-		EndDate:   nilTime(r.EndDate),
+		BookID:           r.BookID,
+		Status:           NewReadingStatus(r.Status),
+		StartDate:        nilTime(r.StartDate),
+		EndDate:          nilTime(r.EndDate),
+		Priority:         r.Priority,
+		QueuePosition:    nilInt32(r.QueuePosition),
+		Owned:            r.Owned,
+		Format:           NewBookFormat(r.Format),
+		PurchasePrice:    nilInt64(r.PurchasePrice),
+		PurchaseCurrency: nilString(r.PurchaseCurrency),
+		PurchaseStore:    nilString(r.PurchaseStore),
+		PurchaseDate:     nilTime(r.PurchaseDate),
+		DurationMinutes:  nilInt32(r.DurationMinutes),
+		Version:          r.Version,
 	}
 }
 
+// CountByBook 書籍を本棚に登録しているユーザー数を返す
+func (r *ReadingHistoryRepositoryImpl) CountByBook(ctx context.Context, bookID int64) (int64, error) {
+	return r.querier.CountReadingHistoriesByBook(ctx, bookID)
+}
+
 func (r *ReadingHistoryRepositoryImpl) Create(ctx context.Context, req CreateReadingHistoryRequest) (*CreateReadingHistoryResponse, error) {
 	h, err := r.querier.CreateReadingHistory(ctx, req.toParams())
 	if err != nil {
@@ -173,6 +268,11 @@ func (r *ReadingHistoryRepositoryImpl) Delete(ctx context.Context, req DeleteRea
 	return nil
 }
 
+// DeleteByBook 書籍をすべてのユーザーの本棚から削除し、削除したユーザーのIDを返す
+func (r *ReadingHistoryRepositoryImpl) DeleteByBook(ctx context.Context, bookID int64) ([]int64, error) {
+	return r.querier.DeleteReadingHistoriesByBook(ctx, bookID)
+}
+
 type GetReadingHistoryByUserRequest struct {
 	UserID int64
 	Limit  int32
@@ -188,19 +288,32 @@ func (r GetReadingHistoryByUserRequest) toParams() sqlc.GetReadingHistoryByUserP
 }
 
 type GetReadingHistoryByUserResponse struct {
-	BookID        int64
-	Title         string
-	Genres        []string
-	Description   *string
-	CoverImageURL *string
-	URL           *string
-	AuthorName    *string
-	PublisherName *string
-	PublishDate   *time.Time
-	ISBN          *string
-	Status        ReadingStatus
-	StartDate     *time.Time
-	EndDate       *time.Time
+	BookID           int64
+	Title            string
+	Genres           []string
+	Description      *string
+	CoverImageURL    *string
+	URL              *string
+	AuthorName       *string
+	PublisherName    *string
+	PublishDate      *time.Time
+	ISBN             *string
+	Status           ReadingStatus
+	StartDate        *time.Time
+	EndDate          *time.Time
+	PageCount        *int32
+	Priority         int16
+	QueuePosition    *int32
+	Owned            bool
+	Format           BookFormat
+	PurchasePrice    *int64
+	PurchaseCurrency *string
+	PurchaseStore    *string
+	PurchaseDate     *time.Time
+	DurationMinutes  *int32
+	Version          int64
+	BookVersion      int64
+	Overdue          bool
 }
 
 func newGetReadingHistoryByUserResponse(r sqlc.GetReadingHistoryByUserRow) GetReadingHistoryByUserResponse {
@@ -218,19 +331,32 @@ func newGetReadingHistoryByUserResponse(r sqlc.GetReadingHistoryByUserRow) GetRe
 	sd := nilTime(r.StartDate)
 	ed := nilTime(r.EndDate)
 	return GetReadingHistoryByUserResponse{
-		BookID:        *id,
-		Title:         *t,
-		Genres:        g,
-		Description:   desc,
-		CoverImageURL: coverImgURL,
-		URL:           URL,
-		AuthorName:    a,
-		PublisherName: p,
-		PublishDate:   pd,
-		ISBN:          ISBN,
-		Status:        s,
-		StartDate:     sd,
-		EndDate:       ed,
+		BookID:           *id,
+		Title:            *t,
+		Genres:           g,
+		Description:      desc,
+		CoverImageURL:    coverImgURL,
+		URL:              URL,
+		AuthorName:       a,
+		PublisherName:    p,
+		PublishDate:      pd,
+		ISBN:             ISBN,
+		Status:           s,
+		StartDate:        sd,
+		EndDate:          ed,
+		PageCount:        nilInt32(r.PageCount),
+		Priority:         r.Priority,
+		QueuePosition:    nilInt32(r.QueuePosition),
+		Owned:            r.Owned,
+		Format:           NewBookFormat(r.Format),
+		PurchasePrice:    nilInt64(r.PurchasePrice),
+		PurchaseCurrency: nilString(r.PurchaseCurrency),
+		PurchaseStore:    nilString(r.PurchaseStore),
+		PurchaseDate:     nilTime(r.PurchaseDate),
+		DurationMinutes:  nilInt32(r.DurationMinutes),
+		Version:          r.Version,
+		BookVersion:      r.BookVersion.Int64,
+		Overdue:          r.Overdue,
 	}
 }
 
@@ -273,6 +399,7 @@ type GetReadingHistoryByUserAndBookResponse struct {
 	Status        ReadingStatus
 	StartDate     *time.Time
 	EndDate       *time.Time
+	Owned         bool
 }
 
 func newGetReadingHistoryByUserAndBookResponse(r sqlc.GetReadingHistoryByUserAndBookRow) *GetReadingHistoryByUserAndBookResponse {
@@ -303,6 +430,7 @@ func newGetReadingHistoryByUserAndBookResponse(r sqlc.GetReadingHistoryByUserAnd
 		Status:        s,
 		StartDate:     sd,
 		EndDate:       ed,
+		Owned:         r.Owned,
 	}
 }
 
@@ -390,6 +518,217 @@ func (r *ReadingHistoryRepositoryImpl) GetByUserAndStatus(ctx context.Context, r
 	return res, nil
 }
 
+func (r *ReadingHistoryRepositoryImpl) GetNextQueuePosition(ctx context.Context, userID int64) (int32, error) {
+	return r.querier.GetNextQueuePosition(ctx, userID)
+}
+
+type GetPublicReadingHistoryByUserRequest struct {
+	UserID int64
+	Limit  int32
+	Offset int32
+}
+
+type GetPublicReadingHistoryByUserResponse struct {
+	BookID        int64
+	Title         string
+	Genres        []string
+	Description   *string
+	CoverImageURL *string
+	URL           *string
+	AuthorName    *string
+	PublisherName *string
+	PublishDate   *time.Time
+	ISBN          *string
+	PageCount     *int32
+	Status        ReadingStatus
+	StartDate     *time.Time
+	EndDate       *time.Time
+}
+
+func newGetPublicReadingHistoryByUserResponse(r sqlc.GetPublicReadingHistoryByUserRow) GetPublicReadingHistoryByUserResponse {
+	return GetPublicReadingHistoryByUserResponse{
+		BookID:        r.ID.Int64,
+		Title:         r.Title.String,
+		Genres:        newGenres(r.Genres),
+		Description:   nilString(r.Description),
+		CoverImageURL: nilString(r.CoverImageUrl),
+		URL:           nilString(r.Url),
+		AuthorName:    nilString(r.AuthorName),
+		PublisherName: nilString(r.PublisherName),
+		PublishDate:   nilTime(r.PublishedDate),
+		ISBN:          nilString(r.Isbn),
+		PageCount:     nilInt32(r.PageCount),
+		Status:        NewReadingStatus[sqlc.ReadingStatus](r.Status),
+		StartDate:     nilTime(r.StartDate),
+		EndDate:       nilTime(r.EndDate),
+	}
+}
+
+// GetPublicByUser 本棚の公開範囲がpublicの本のみを取得する
+func (r *ReadingHistoryRepositoryImpl) GetPublicByUser(ctx context.Context, req GetPublicReadingHistoryByUserRequest) ([]GetPublicReadingHistoryByUserResponse, error) {
+	rows, err := r.querier.GetPublicReadingHistoryByUser(ctx, sqlc.GetPublicReadingHistoryByUserParams{
+		UserID: req.UserID,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]GetPublicReadingHistoryByUserResponse, len(rows))
+	for i, row := range rows {
+		res[i] = newGetPublicReadingHistoryByUserResponse(row)
+	}
+	return res, nil
+}
+
+type GetReadingQueueResponse struct {
+	BookID        int64
+	Title         string
+	Genres        []string
+	Description   *string
+	CoverImageURL *string
+	URL           *string
+	AuthorName    *string
+	PublisherName *string
+	PublishDate   *time.Time
+	ISBN          *string
+	Status        ReadingStatus
+	Priority      int16
+	QueuePosition int32
+	Owned         bool
+	Version       int64
+	Overdue       bool
+}
+
+func newGetReadingQueueResponse(r sqlc.GetReadingQueueRow) GetReadingQueueResponse {
+	return GetReadingQueueResponse{
+		BookID:        r.ID.Int64,
+		Title:         r.Title.String,
+		Genres:        newGenres(r.Genres),
+		Description:   nilString(r.Description),
+		CoverImageURL: nilString(r.CoverImageUrl),
+		URL:           nilString(r.Url),
+		AuthorName:    nilString(r.AuthorName),
+		PublisherName: nilString(r.PublisherName),
+		PublishDate:   nilTime(r.PublishedDate),
+		ISBN:          nilString(r.Isbn),
+		Status:        NewReadingStatus[sqlc.ReadingStatus](r.Status),
+		Priority:      r.Priority,
+		QueuePosition: r.QueuePosition.Int32,
+		Owned:         r.Owned,
+		Version:       r.Version,
+		Overdue:       r.Overdue,
+	}
+}
+
+// GetQueue 未読の本を次に読む順に返す
+func (r *ReadingHistoryRepositoryImpl) GetQueue(ctx context.Context, userID int64) ([]GetReadingQueueResponse, error) {
+	rows, err := r.querier.GetReadingQueue(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]GetReadingQueueResponse, len(rows))
+	for i, row := range rows {
+		res[i] = newGetReadingQueueResponse(row)
+	}
+	return res, nil
+}
+
+type GetReadingHistoryForSyncRequest struct {
+	UserID int64
+	BookID int64
+}
+
+type GetReadingHistoriesUpdatedSinceRequest struct {
+	UserID int64
+	Since  time.Time
+}
+
+type SyncReadingHistoryResponse struct {
+	BookID           int64
+	Title            string
+	Genres           []string
+	Description      *string
+	CoverImageURL    *string
+	URL              *string
+	AuthorName       *string
+	PublisherName    *string
+	PublishDate      *time.Time
+	ISBN             *string
+	PageCount        *int32
+	Status           ReadingStatus
+	StartDate        *time.Time
+	EndDate          *time.Time
+	Priority         int16
+	QueuePosition    *int32
+	Owned            bool
+	Format           BookFormat
+	PurchasePrice    *int64
+	PurchaseCurrency *string
+	PurchaseStore    *string
+	PurchaseDate     *time.Time
+	DurationMinutes  *int32
+	UpdatedAt        time.Time
+}
+
+func newSyncReadingHistoryResponse(r sqlc.GetReadingHistoriesUpdatedSinceRow) SyncReadingHistoryResponse {
+	return SyncReadingHistoryResponse{
+		BookID:           r.ID,
+		Title:            r.Title,
+		Genres:           newGenres(r.Genres),
+		Description:      nilString(r.Description),
+		CoverImageURL:    nilString(r.CoverImageUrl),
+		URL:              nilString(r.Url),
+		AuthorName:       nilString(r.AuthorName),
+		PublisherName:    nilString(r.PublisherName),
+		PublishDate:      nilTime(r.PublishedDate),
+		ISBN:             nilString(r.Isbn),
+		PageCount:        nilInt32(r.PageCount),
+		Status:           NewReadingStatus[sqlc.ReadingStatus](r.Status),
+		StartDate:        nilTime(r.StartDate),
+		EndDate:          nilTime(r.EndDate),
+		Priority:         r.Priority,
+		QueuePosition:    nilInt32(r.QueuePosition),
+		Owned:            r.Owned,
+		Format:           NewBookFormat(r.Format),
+		PurchasePrice:    nilInt64(r.PurchasePrice),
+		PurchaseCurrency: nilString(r.PurchaseCurrency),
+		PurchaseStore:    nilString(r.PurchaseStore),
+		PurchaseDate:     nilTime(r.PurchaseDate),
+		DurationMinutes:  nilInt32(r.DurationMinutes),
+		UpdatedAt:        r.UpdatedAt,
+	}
+}
+
+func (r *ReadingHistoryRepositoryImpl) GetForSync(ctx context.Context, req GetReadingHistoryForSyncRequest) (*SyncReadingHistoryResponse, error) {
+	row, err := r.querier.GetReadingHistoryForSync(ctx, sqlc.GetReadingHistoryForSyncParams{
+		UserID: req.UserID,
+		BookID: req.BookID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// 列が同じなので一覧取得の行に変換して使い回す
+	res := newSyncReadingHistoryResponse(sqlc.GetReadingHistoriesUpdatedSinceRow(row))
+	return &res, nil
+}
+
+// GetUpdatedSince 本か読書履歴がsinceより後に更新されたものを古い順に返す
+func (r *ReadingHistoryRepositoryImpl) GetUpdatedSince(ctx context.Context, req GetReadingHistoriesUpdatedSinceRequest) ([]SyncReadingHistoryResponse, error) {
+	rows, err := r.querier.GetReadingHistoriesUpdatedSince(ctx, sqlc.GetReadingHistoriesUpdatedSinceParams{
+		UserID: req.UserID,
+		Since:  req.Since,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]SyncReadingHistoryResponse, len(rows))
+	for i, row := range rows {
+		res[i] = newSyncReadingHistoryResponse(row)
+	}
+	return res, nil
+}
+
 type UpdateReadingHistoryRequest struct {
 	UserID    int64
 	BookID    int64
@@ -417,10 +756,14 @@ func (r UpdateReadingHistoryRequest) toParams() sqlc.UpdateReadingHistoryParams
 }
 
 type UpdateReadingHistoryResponse struct {
-	BookID    int64
-	Status    ReadingStatus
-	StartDate *time.Time
-	EndDate   *time.Time
+	BookID        int64
+	Status        ReadingStatus
+	StartDate     *time.Time
+	EndDate       *time.Time
+	Priority      int16
+	QueuePosition *int32
+	Owned         bool
+	Version       int64
 }
 
 func newUpdateReadingHistoryResponse(r sqlc.ReadingHistory) *UpdateReadingHistoryResponse {
@@ -429,10 +772,14 @@ func newUpdateReadingHistoryResponse(r sqlc.ReadingHistory) *UpdateReadingHistor
 	sd := nilTime(r.StartDate)
 	ed := nilTime(r.EndDate)
 	return &UpdateReadingHistoryResponse{
-		BookID:    bid,
-		Status:    s,
-		StartDate: sd,
-		EndDate:   ed,
+		BookID:        bid,
+		Status:        s,
+		StartDate:     sd,
+		EndDate:       ed,
+		Priority:      r.Priority,
+		QueuePosition: nilInt32(r.QueuePosition),
+		Owned:         r.Owned,
+		Version:       r.Version,
 	}
 }
 
@@ -443,3 +790,52 @@ func (r *ReadingHistoryRepositoryImpl) Update(ctx context.Context, req UpdateRea
 	}
 	return newUpdateReadingHistoryResponse(h), nil
 }
+
+type UpdateQueuePositionRequest struct {
+	UserID        int64
+	BookID        int64
+	QueuePosition int32
+}
+
+func (r *ReadingHistoryRepositoryImpl) UpdateQueuePosition(ctx context.Context, req UpdateQueuePositionRequest) error {
+	rowsAffected, err := r.querier.UpdateQueuePosition(ctx, sqlc.UpdateQueuePositionParams{
+		UserID:        req.UserID,
+		BookID:        req.BookID,
+		QueuePosition: sql.NullInt32{Int32: req.QueuePosition, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNoRowsUpdated
+	}
+	return nil
+}
+
+type UpdateWishlistEntryRequest struct {
+	UserID   int64
+	BookID   int64
+	Priority int16
+	Owned    bool
+	// nilでない場合はバージョンが一致するときだけ更新する
+	ExpectedVersion *int64
+}
+
+// UpdateWishlistEntry バージョンが一致しない場合はsql.ErrNoRowsを返す
+func (r *ReadingHistoryRepositoryImpl) UpdateWishlistEntry(ctx context.Context, req UpdateWishlistEntryRequest) (*UpdateReadingHistoryResponse, error) {
+	expectedVersion := sql.NullInt64{}
+	if req.ExpectedVersion != nil {
+		expectedVersion = sql.NullInt64{Int64: *req.ExpectedVersion, Valid: true}
+	}
+	h, err := r.querier.UpdateWishlistEntry(ctx, sqlc.UpdateWishlistEntryParams{
+		UserID:          req.UserID,
+		BookID:          req.BookID,
+		Priority:        req.Priority,
+		Owned:           req.Owned,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newUpdateReadingHistoryResponse(h), nil
+}
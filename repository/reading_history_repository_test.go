@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"github.com/stretchr/testify/require"
 	sqlc "readly/db/sqlc"
+	"readly/entity"
 	"testing"
 	"time"
 )
@@ -56,6 +57,18 @@ func TestDelete(t *testing.T) {
 	require.ErrorIs(t, err, sql.ErrNoRows)
 }
 
+func TestCountByBook(t *testing.T) {
+	u1 := createRandomUser(t)
+	u2 := createRandomUser(t)
+	b := createRandomBook(t)
+	_ = createReadingHistory(t, u1.ID, b.ID)
+	_ = createReadingHistory(t, u2.ID, b.ID)
+
+	count, err := readingHistoryRepo.CountByBook(context.Background(), b.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
 func TestGetByUser(t *testing.T) {
 	u := createRandomUser(t)
 	b1 := createRandomBook(t)
@@ -155,3 +168,28 @@ func TestUpdate(t *testing.T) {
 	require.Equal(t, now.Day(), urh.StartDate.Day())
 	require.Nil(t, urh.EndDate)
 }
+
+func TestReadingStatusConversion(t *testing.T) {
+	testCases := []struct {
+		name   string
+		entity entity.ReadingStatus
+		sqlc   sqlc.ReadingStatus
+		status ReadingStatus
+	}{
+		{name: "unread", entity: entity.Unread, sqlc: sqlc.ReadingStatusUnread, status: Unread},
+		{name: "reading", entity: entity.Reading, sqlc: sqlc.ReadingStatusReading, status: Reading},
+		{name: "done", entity: entity.Done, sqlc: sqlc.ReadingStatusDone, status: Done},
+		{name: "abandoned", entity: entity.Abandoned, sqlc: sqlc.ReadingStatusAbandoned, status: Abandoned},
+		{name: "on_hold", entity: entity.OnHold, sqlc: sqlc.ReadingStatusOnHold, status: OnHold},
+		{name: "unknown", entity: entity.Unknown, sqlc: sqlc.ReadingStatusUnknown, status: Unknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.status, NewReadingStatus(tc.entity))
+			require.Equal(t, tc.status, NewReadingStatus(tc.sqlc))
+			require.Equal(t, tc.entity, tc.status.ToEntity())
+			require.Equal(t, tc.sqlc, tc.status.toSqlc())
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type ReadingStatsRepository interface {
+	GetAverageReadingDays(ctx context.Context, req ReadingStatsRequest) (*GetAverageReadingDaysResponse, error)
+	GetFinishedAuthorCounts(ctx context.Context, req ReadingRankingRequest) ([]NameCountResponse, error)
+	GetFinishedBookCountsByMonth(ctx context.Context, req ReadingStatsRequest) ([]MonthlyCountResponse, error)
+	GetFinishedBooks(ctx context.Context, req ReadingStatsRequest) ([]FinishedBookResponse, error)
+	GetFinishedGenreCounts(ctx context.Context, req ReadingStatsRequest) ([]NameCountResponse, error)
+	GetFinishedPublisherCounts(ctx context.Context, req ReadingRankingRequest) ([]NameCountResponse, error)
+	GetMonthlySpending(ctx context.Context, req ReadingStatsRequest) ([]MonthlySpendingResponse, error)
+	GetSpendingByFormat(ctx context.Context, req ReadingStatsRequest) ([]FormatSpendingResponse, error)
+}
+
+type ReadingStatsRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewReadingStatsRepository(q sqlc.Querier) ReadingStatsRepository {
+	return &ReadingStatsRepositoryImpl{
+		querier: q,
+	}
+}
+
+type ReadingStatsRequest struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+}
+
+type ReadingRankingRequest struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+	Limit  int32
+}
+
+type MonthlyCountResponse struct {
+	Month time.Time
+	Count int64
+}
+
+type NameCountResponse struct {
+	Name  string
+	Count int64
+}
+
+type FinishedBookResponse struct {
+	BookID        int64
+	Title         string
+	AuthorName    *string
+	CoverImageURL *string
+	PageCount     *int32
+	StartDate     *time.Time
+	EndDate       *time.Time
+}
+
+type MonthlySpendingResponse struct {
+	Month     time.Time
+	Currency  string
+	Amount    int64
+	BookCount int64
+}
+
+type FormatSpendingResponse struct {
+	Format    BookFormat
+	Currency  string
+	Amount    int64
+	BookCount int64
+}
+
+type GetAverageReadingDaysResponse struct {
+	BookCount   int64
+	AverageDays float64
+}
+
+func (r *ReadingStatsRepositoryImpl) GetAverageReadingDays(ctx context.Context, req ReadingStatsRequest) (*GetAverageReadingDaysResponse, error) {
+	row, err := r.querier.GetAverageReadingDays(ctx, sqlc.GetAverageReadingDaysParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &GetAverageReadingDaysResponse{
+		BookCount:   row.BookCount,
+		AverageDays: row.AverageDays,
+	}, nil
+}
+
+func (r *ReadingStatsRepositoryImpl) GetFinishedAuthorCounts(ctx context.Context, req ReadingRankingRequest) ([]NameCountResponse, error) {
+	rows, err := r.querier.GetFinishedAuthorCounts(ctx, sqlc.GetFinishedAuthorCountsParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+		TopLimit: req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]NameCountResponse, len(rows))
+	for i, row := range rows {
+		res[i] = NameCountResponse{
+			Name:  row.AuthorName.String,
+			Count: row.BookCount,
+		}
+	}
+	return res, nil
+}
+
+func (r *ReadingStatsRepositoryImpl) GetFinishedBookCountsByMonth(ctx context.Context, req ReadingStatsRequest) ([]MonthlyCountResponse, error) {
+	rows, err := r.querier.GetFinishedBookCountsByMonth(ctx, sqlc.GetFinishedBookCountsByMonthParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]MonthlyCountResponse, len(rows))
+	for i, row := range rows {
+		res[i] = MonthlyCountResponse{
+			Month: row.Month,
+			Count: row.BookCount,
+		}
+	}
+	return res, nil
+}
+
+func (r *ReadingStatsRepositoryImpl) GetFinishedBooks(ctx context.Context, req ReadingStatsRequest) ([]FinishedBookResponse, error) {
+	rows, err := r.querier.GetFinishedBooks(ctx, sqlc.GetFinishedBooksParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]FinishedBookResponse, len(rows))
+	for i, row := range rows {
+		res[i] = FinishedBookResponse{
+			BookID:        row.ID,
+			Title:         row.Title,
+			AuthorName:    nilString(row.AuthorName),
+			CoverImageURL: nilString(row.CoverImageUrl),
+			PageCount:     nilInt32(row.PageCount),
+			StartDate:     nilTime(row.StartDate),
+			EndDate:       nilTime(row.EndDate),
+		}
+	}
+	return res, nil
+}
+
+func (r *ReadingStatsRepositoryImpl) GetFinishedGenreCounts(ctx context.Context, req ReadingStatsRequest) ([]NameCountResponse, error) {
+	rows, err := r.querier.GetFinishedGenreCounts(ctx, sqlc.GetFinishedGenreCountsParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]NameCountResponse, len(rows))
+	for i, row := range rows {
+		res[i] = NameCountResponse{
+			Name:  row.GenreName,
+			Count: row.BookCount,
+		}
+	}
+	return res, nil
+}
+
+func (r *ReadingStatsRepositoryImpl) GetFinishedPublisherCounts(ctx context.Context, req ReadingRankingRequest) ([]NameCountResponse, error) {
+	rows, err := r.querier.GetFinishedPublisherCounts(ctx, sqlc.GetFinishedPublisherCountsParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+		TopLimit: req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]NameCountResponse, len(rows))
+	for i, row := range rows {
+		res[i] = NameCountResponse{
+			Name:  row.PublisherName.String,
+			Count: row.BookCount,
+		}
+	}
+	return res, nil
+}
+
+func (r *ReadingStatsRepositoryImpl) GetMonthlySpending(ctx context.Context, req ReadingStatsRequest) ([]MonthlySpendingResponse, error) {
+	rows, err := r.querier.GetMonthlySpending(ctx, sqlc.GetMonthlySpendingParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]MonthlySpendingResponse, len(rows))
+	for i, row := range rows {
+		res[i] = MonthlySpendingResponse{
+			Month:     row.Month,
+			Currency:  row.Currency,
+			Amount:    row.Amount,
+			BookCount: row.BookCount,
+		}
+	}
+	return res, nil
+}
+
+func (r *ReadingStatsRepositoryImpl) GetSpendingByFormat(ctx context.Context, req ReadingStatsRequest) ([]FormatSpendingResponse, error) {
+	rows, err := r.querier.GetSpendingByFormat(ctx, sqlc.GetSpendingByFormatParams{
+		UserID:   req.UserID,
+		FromDate: req.From,
+		ToDate:   req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]FormatSpendingResponse, len(rows))
+	for i, row := range rows {
+		res[i] = FormatSpendingResponse{
+			Format:    NewBookFormat(row.Format),
+			Currency:  row.Currency,
+			Amount:    row.Amount,
+			BookCount: row.BookCount,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"time"
+)
+
+type RecommendationReason int
+
+const (
+	AuthorReason RecommendationReason = iota
+	GenreReason
+)
+
+func (r RecommendationReason) toSqlc() sqlc.RecommendationReason {
+	switch r {
+	case GenreReason:
+		return sqlc.RecommendationReasonGenre
+	default:
+		return sqlc.RecommendationReasonAuthor
+	}
+}
+
+func newRecommendationReason(r sqlc.RecommendationReason) RecommendationReason {
+	switch r {
+	case sqlc.RecommendationReasonGenre:
+		return GenreReason
+	default:
+		return AuthorReason
+	}
+}
+
+type RecommendationRepository interface {
+	Create(ctx context.Context, req CreateRecommendationRequest) error
+	DeleteByUser(ctx context.Context, userID int64) error
+	Get(ctx context.Context, req GetRecommendationsRequest) ([]RecommendationResponse, error)
+	GetCandidates(ctx context.Context, req GetRecommendationCandidatesRequest) ([]RecommendationCandidateResponse, error)
+	GetRefreshedAt(ctx context.Context, userID int64) (time.Time, error)
+	GetStaleUsers(ctx context.Context, req GetStaleRecommendationUsersRequest) ([]int64, error)
+	MarkRefreshed(ctx context.Context, userID int64) error
+}
+
+type RecommendationRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewRecommendationRepository(q sqlc.Querier) RecommendationRepository {
+	return &RecommendationRepositoryImpl{
+		querier: q,
+	}
+}
+
+type CreateRecommendationRequest struct {
+	UserID      int64
+	BookID      int64
+	Rank        int32
+	Score       int64
+	Reason      RecommendationReason
+	ReasonName  string
+	ReasonCount int64
+}
+
+func (r CreateRecommendationRequest) toParams() sqlc.CreateBookRecommendationParams {
+	return sqlc.CreateBookRecommendationParams{
+		UserID:      r.UserID,
+		BookID:      r.BookID,
+		Rank:        r.Rank,
+		Score:       r.Score,
+		Reason:      r.Reason.toSqlc(),
+		ReasonName:  r.ReasonName,
+		ReasonCount: r.ReasonCount,
+	}
+}
+
+func (r *RecommendationRepositoryImpl) Create(ctx context.Context, req CreateRecommendationRequest) error {
+	_, err := r.querier.CreateBookRecommendation(ctx, req.toParams())
+	return err
+}
+
+func (r *RecommendationRepositoryImpl) DeleteByUser(ctx context.Context, userID int64) error {
+	return r.querier.DeleteBookRecommendationsByUser(ctx, userID)
+}
+
+type GetRecommendationsRequest struct {
+	UserID int64
+	Limit  int32
+}
+
+type RecommendationResponse struct {
+	BookID        int64
+	Rank          int32
+	Score         int64
+	Reason        RecommendationReason
+	ReasonName    string
+	ReasonCount   int64
+	GeneratedAt   time.Time
+	Title         string
+	Genres        []string
+	Description   *string
+	CoverImageURL *string
+	URL           *string
+	AuthorName    *string
+	PublisherName *string
+	PublishDate   *time.Time
+	ISBN          *string
+}
+
+// Get キャッシュ作成後に登録された本は除いて順位順に返す
+func (r *RecommendationRepositoryImpl) Get(ctx context.Context, req GetRecommendationsRequest) ([]RecommendationResponse, error) {
+	rows, err := r.querier.GetBookRecommendations(ctx, sqlc.GetBookRecommendationsParams{
+		UserID:      req.UserID,
+		ResultLimit: req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]RecommendationResponse, len(rows))
+	for i, row := range rows {
+		res[i] = RecommendationResponse{
+			BookID:        row.BookID,
+			Rank:          row.Rank,
+			Score:         row.Score,
+			Reason:        newRecommendationReason(row.Reason),
+			ReasonName:    row.ReasonName,
+			ReasonCount:   row.ReasonCount,
+			GeneratedAt:   row.CreatedAt,
+			Title:         row.Title,
+			Genres:        newGenres(row.Genres),
+			Description:   nilString(row.Description),
+			CoverImageURL: nilString(row.CoverImageUrl),
+			URL:           nilString(row.Url),
+			AuthorName:    nilString(row.AuthorName),
+			PublisherName: nilString(row.PublisherName),
+			PublishDate:   nilTime(row.PublishedDate),
+			ISBN:          nilString(row.Isbn),
+		}
+	}
+	return res, nil
+}
+
+type GetRecommendationCandidatesRequest struct {
+	UserID int64
+	Limit  int32
+}
+
+type RecommendationCandidateResponse struct {
+	BookID      int64
+	AuthorName  *string
+	AuthorCount int64
+	GenreName   *string
+	GenreCount  int64
+	Score       int64
+}
+
+// GetCandidates 読了した本と著者・ジャンルが共通する未登録の本をスコア順に返す
+func (r *RecommendationRepositoryImpl) GetCandidates(ctx context.Context, req GetRecommendationCandidatesRequest) ([]RecommendationCandidateResponse, error) {
+	rows, err := r.querier.GetRecommendationCandidates(ctx, sqlc.GetRecommendationCandidatesParams{
+		UserID:         req.UserID,
+		CandidateLimit: req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]RecommendationCandidateResponse, len(rows))
+	for i, row := range rows {
+		res[i] = RecommendationCandidateResponse{
+			BookID:      row.BookID,
+			AuthorName:  nilString(row.AuthorName),
+			AuthorCount: row.AuthorCount,
+			GenreName:   nilString(row.GenreName),
+			GenreCount:  row.GenreCount,
+			Score:       row.Score,
+		}
+	}
+	return res, nil
+}
+
+func (r *RecommendationRepositoryImpl) GetRefreshedAt(ctx context.Context, userID int64) (time.Time, error) {
+	rr, err := r.querier.GetRecommendationRefresh(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return rr.RefreshedAt, nil
+}
+
+type GetStaleRecommendationUsersRequest struct {
+	StaleBefore time.Time
+	Limit       int32
+}
+
+// GetStaleUsers 一度も作成していない、期限切れ、または作成後に読書履歴が更新されたユーザーを返す
+func (r *RecommendationRepositoryImpl) GetStaleUsers(ctx context.Context, req GetStaleRecommendationUsersRequest) ([]int64, error) {
+	return r.querier.GetStaleRecommendationUsers(ctx, sqlc.GetStaleRecommendationUsersParams{
+		StaleBefore: req.StaleBefore,
+		BatchSize:   req.Limit,
+	})
+}
+
+func (r *RecommendationRepositoryImpl) MarkRefreshed(ctx context.Context, userID int64) error {
+	_, err := r.querier.UpsertRecommendationRefresh(ctx, userID)
+	return err
+}
@@ -13,6 +13,7 @@ type SessionRepository interface {
 	GetSessionByID(ctx context.Context, req GetSessionByIDRequest) (*SessionResponse, error)
 	GetSessionByUserID(ctx context.Context, req GetSessionByUserIDRequest) ([]SessionResponse, error)
 	DeleteSessionByUserID(ctx context.Context, req DeleteSessionByUserIDRequest) (int64, error)
+	RevokeSessionsByUserID(ctx context.Context, userID int64) (int64, error)
 }
 
 type SessionRepositoryImpl struct {
@@ -120,3 +121,8 @@ func (r *SessionRepositoryImpl) DeleteSessionByUserID(ctx context.Context, req D
 	}
 	return count, nil
 }
+
+// RevokeSessionsByUserID 失効させたセッションの数を返す
+func (r *SessionRepositoryImpl) RevokeSessionsByUserID(ctx context.Context, userID int64) (int64, error) {
+	return r.querier.RevokeSessionsByUserID(ctx, userID)
+}
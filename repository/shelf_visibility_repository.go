@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+)
+
+type ShelfVisibilityRepository interface {
+	GetByUser(ctx context.Context, userID int64) ([]ShelfVisibilityResponse, error)
+	Upsert(ctx context.Context, req UpsertShelfVisibilityRequest) (*ShelfVisibilityResponse, error)
+}
+
+type ShelfVisibilityRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewShelfVisibilityRepository(q sqlc.Querier) ShelfVisibilityRepository {
+	return &ShelfVisibilityRepositoryImpl{
+		querier: q,
+	}
+}
+
+type ShelfVisibilityResponse struct {
+	Status     ReadingStatus
+	Visibility Visibility
+}
+
+func newShelfVisibilityResponse(s sqlc.ShelfVisibility) ShelfVisibilityResponse {
+	return ShelfVisibilityResponse{
+		Status:     NewReadingStatus[sqlc.ReadingStatus](s.Status),
+		Visibility: NewVisibility[sqlc.Visibility](s.Visibility),
+	}
+}
+
+func (r *ShelfVisibilityRepositoryImpl) GetByUser(ctx context.Context, userID int64) ([]ShelfVisibilityResponse, error) {
+	rows, err := r.querier.GetShelfVisibilities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ShelfVisibilityResponse, len(rows))
+	for i, row := range rows {
+		res[i] = newShelfVisibilityResponse(row)
+	}
+	return res, nil
+}
+
+type UpsertShelfVisibilityRequest struct {
+	UserID     int64
+	Status     ReadingStatus
+	Visibility Visibility
+}
+
+func (r *ShelfVisibilityRepositoryImpl) Upsert(ctx context.Context, req UpsertShelfVisibilityRequest) (*ShelfVisibilityResponse, error) {
+	row, err := r.querier.UpsertShelfVisibility(ctx, sqlc.UpsertShelfVisibilityParams{
+		UserID:     req.UserID,
+		Status:     req.Status.toSqlc(),
+		Visibility: req.Visibility.toSqlc(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newShelfVisibilityResponse(row)
+	return &res, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+	"time"
+)
+
+type SyncTombstoneRepository interface {
+	Create(ctx context.Context, req SyncTombstoneRequest) error
+	Delete(ctx context.Context, req SyncTombstoneRequest) error
+	Get(ctx context.Context, req SyncTombstoneRequest) (*SyncTombstoneResponse, error)
+	GetSince(ctx context.Context, userID int64, since time.Time) ([]SyncTombstoneResponse, error)
+}
+
+type SyncTombstoneRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewSyncTombstoneRepository(q sqlc.Querier) SyncTombstoneRepository {
+	return &SyncTombstoneRepositoryImpl{
+		querier: q,
+	}
+}
+
+type SyncEntityType string
+
+const (
+	SyncBook SyncEntityType = "book"
+	SyncTag  SyncEntityType = "tag"
+	SyncNote SyncEntityType = "note"
+)
+
+func NewSyncEntityType(t entity.SyncEntityType) SyncEntityType {
+	switch t {
+	case entity.SyncTag:
+		return SyncTag
+	case entity.SyncNote:
+		return SyncNote
+	default:
+		return SyncBook
+	}
+}
+
+func (t SyncEntityType) ToEntity() entity.SyncEntityType {
+	switch t {
+	case SyncTag:
+		return entity.SyncTag
+	case SyncNote:
+		return entity.SyncNote
+	default:
+		return entity.SyncBook
+	}
+}
+
+type SyncTombstoneRequest struct {
+	UserID int64
+	Type   SyncEntityType
+	Key    string
+}
+
+type SyncTombstoneResponse struct {
+	Type      SyncEntityType
+	Key       string
+	DeletedAt time.Time
+}
+
+func newSyncTombstoneResponse(t sqlc.SyncTombstone) SyncTombstoneResponse {
+	return SyncTombstoneResponse{
+		Type:      SyncEntityType(t.EntityType),
+		Key:       t.EntityKey,
+		DeletedAt: t.DeletedAt,
+	}
+}
+
+// Create 既に削除済みの場合は削除日時を更新する
+func (r *SyncTombstoneRepositoryImpl) Create(ctx context.Context, req SyncTombstoneRequest) error {
+	return r.querier.CreateSyncTombstone(ctx, sqlc.CreateSyncTombstoneParams{
+		UserID:     req.UserID,
+		EntityType: string(req.Type),
+		EntityKey:  req.Key,
+	})
+}
+
+// Delete 削除したものが再び作られた場合に呼び出す
+func (r *SyncTombstoneRepositoryImpl) Delete(ctx context.Context, req SyncTombstoneRequest) error {
+	return r.querier.DeleteSyncTombstone(ctx, sqlc.DeleteSyncTombstoneParams{
+		UserID:     req.UserID,
+		EntityType: string(req.Type),
+		EntityKey:  req.Key,
+	})
+}
+
+func (r *SyncTombstoneRepositoryImpl) Get(ctx context.Context, req SyncTombstoneRequest) (*SyncTombstoneResponse, error) {
+	t, err := r.querier.GetSyncTombstone(ctx, sqlc.GetSyncTombstoneParams{
+		UserID:     req.UserID,
+		EntityType: string(req.Type),
+		EntityKey:  req.Key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newSyncTombstoneResponse(t)
+	return &res, nil
+}
+
+func (r *SyncTombstoneRepositoryImpl) GetSince(ctx context.Context, userID int64, since time.Time) ([]SyncTombstoneResponse, error) {
+	tombstones, err := r.querier.GetSyncTombstonesSince(ctx, sqlc.GetSyncTombstonesSinceParams{
+		UserID:    userID,
+		DeletedAt: since,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]SyncTombstoneResponse, len(tombstones))
+	for i, t := range tombstones {
+		res[i] = newSyncTombstoneResponse(t)
+	}
+	return res, nil
+}
@@ -8,7 +8,7 @@ import (
 )
 
 type Transactor interface {
-	Exec(ctx context.Context, fn func() error) error
+	Exec(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type TransactorImpl struct {
@@ -19,16 +19,21 @@ func New(db sqlc.DBTX) Transactor {
 	return TransactorImpl{db: db}
 }
 
-func (t TransactorImpl) Exec(ctx context.Context, fn func() error) error {
+func (t TransactorImpl) Exec(ctx context.Context, fn func(ctx context.Context) error) error {
 	switch t.db.(type) {
 	case *sql.DB:
 		return t.execTx(ctx, fn)
 	default:
-		return t.execFakeTx(fn)
+		return t.execFakeTx(ctx, fn)
 	}
 }
 
-func (t TransactorImpl) execTx(ctx context.Context, fn func() error) error {
+func (t TransactorImpl) execTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	// 既にトランザクション中であればそのトランザクションに含める
+	if _, ok := sqlc.TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
 	db, ok := t.db.(*sql.DB)
 	if !ok {
 		return fmt.Errorf("invalid database connection type: expected *sql.DB")
@@ -39,7 +44,7 @@ func (t TransactorImpl) execTx(ctx context.Context, fn func() error) error {
 		return err
 	}
 
-	err = fn()
+	err = fn(sqlc.WithTx(ctx, tx))
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
 			return rbErr
@@ -50,6 +55,6 @@ func (t TransactorImpl) execTx(ctx context.Context, fn func() error) error {
 	return tx.Commit()
 }
 
-func (t TransactorImpl) execFakeTx(fn func() error) error {
-	return fn()
+func (t TransactorImpl) execFakeTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
 }
@@ -2,14 +2,20 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	sqlc "readly/db/sqlc"
+	"time"
 )
 
 type UserRepository interface {
 	CreateUser(ctx context.Context, req CreateUserRequest) (*CreateUserResponse, error)
 	DeleteUser(ctx context.Context, id int64) error
+	GetAllUsers(ctx context.Context, req GetAllUsersRequest) ([]GetUserResponse, error)
 	GetUserByEmail(ctx context.Context, email string) (*GetUserResponse, error)
 	GetUserByID(ctx context.Context, id int64) (*GetUserResponse, error)
+	UpdatePrivacy(ctx context.Context, req UpdatePrivacyRequest) (*GetUserResponse, error)
+	UpdateTimezone(ctx context.Context, req UpdateTimezoneRequest) (*GetUserResponse, error)
+	UpdateDisabledAt(ctx context.Context, req UpdateDisabledAtRequest) (*GetUserResponse, error)
 	UpdateUser(ctx context.Context, req UpdateRequest) (*UpdateResponse, error)
 }
 
@@ -33,6 +39,7 @@ type CreateUserResponse struct {
 	ID    int64
 	Name  string
 	Email string
+	Role  UserRole
 }
 
 func (r *UserRepositoryImpl) CreateUser(ctx context.Context, req CreateUserRequest) (*CreateUserResponse, error) {
@@ -49,6 +56,7 @@ func (r *UserRepositoryImpl) CreateUser(ctx context.Context, req CreateUserReque
 		ID:    res.ID,
 		Name:  res.Name,
 		Email: res.Email,
+		Role:  NewUserRole[sqlc.UserRole](res.Role),
 	}
 	return u, nil
 }
@@ -62,10 +70,60 @@ func (r *UserRepositoryImpl) DeleteUser(ctx context.Context, id int64) error {
 }
 
 type GetUserResponse struct {
-	ID       int64
-	Name     string
-	Password string
-	Email    string
+	ID                 int64
+	Name               string
+	Password           string
+	Email              string
+	Timezone           string
+	ActivityVisibility Visibility
+	ProfileVisibility  Visibility
+	LibraryVisibility  Visibility
+	Role               UserRole
+	// nilでない場合は管理者によって無効化されている
+	DisabledAt *time.Time
+}
+
+func newGetUserResponse(u sqlc.User) *GetUserResponse {
+	return &GetUserResponse{
+		ID:                 u.ID,
+		Name:               u.Name,
+		Password:           u.HashedPassword,
+		Email:              u.Email,
+		Timezone:           u.Timezone,
+		ActivityVisibility: NewVisibility[sqlc.Visibility](u.ActivityVisibility),
+		ProfileVisibility:  NewVisibility[sqlc.Visibility](u.ProfileVisibility),
+		LibraryVisibility:  NewVisibility[sqlc.Visibility](u.LibraryVisibility),
+		Role:               NewUserRole[sqlc.UserRole](u.Role),
+		DisabledAt:         nilTime(u.DisabledAt),
+	}
+}
+
+type GetAllUsersRequest struct {
+	// 名前かメールアドレスの部分一致で検索する。%と_はワイルドカードではなく文字として扱う。nilの場合はすべてのユーザーを返す
+	Query  *string
+	Limit  int32
+	Offset int32
+}
+
+// GetAllUsers id順に返す
+func (r *UserRepositoryImpl) GetAllUsers(ctx context.Context, req GetAllUsersRequest) ([]GetUserResponse, error) {
+	query := sql.NullString{}
+	if req.Query != nil {
+		query = sql.NullString{String: *req.Query, Valid: true}
+	}
+	users, err := r.querier.GetAllUsers(ctx, sqlc.GetAllUsersParams{
+		Query:      query,
+		PageSize:   req.Limit,
+		PageOffset: req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]GetUserResponse, len(users))
+	for i, u := range users {
+		res[i] = *newGetUserResponse(u)
+	}
+	return res, nil
 }
 
 func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (*GetUserResponse, error) {
@@ -73,13 +131,7 @@ func (r *UserRepositoryImpl) GetUserByEmail(ctx context.Context, email string) (
 	if err != nil {
 		return nil, err
 	}
-	u := &GetUserResponse{
-		ID:       res.ID,
-		Name:     res.Name,
-		Password: res.HashedPassword,
-		Email:    res.Email,
-	}
-	return u, nil
+	return newGetUserResponse(res), nil
 }
 
 func (r *UserRepositoryImpl) GetUserByID(ctx context.Context, id int64) (*GetUserResponse, error) {
@@ -87,13 +139,66 @@ func (r *UserRepositoryImpl) GetUserByID(ctx context.Context, id int64) (*GetUse
 	if err != nil {
 		return nil, err
 	}
-	u := &GetUserResponse{
-		ID:       res.ID,
-		Name:     res.Name,
-		Password: res.HashedPassword,
-		Email:    res.Email,
+	return newGetUserResponse(res), nil
+}
+
+type UpdateDisabledAtRequest struct {
+	ID int64
+	// nilの場合は有効に戻す
+	DisabledAt *time.Time
+}
+
+func (r *UserRepositoryImpl) UpdateDisabledAt(ctx context.Context, req UpdateDisabledAtRequest) (*GetUserResponse, error) {
+	disabledAt := sql.NullTime{}
+	if req.DisabledAt != nil {
+		disabledAt = sql.NullTime{Time: *req.DisabledAt, Valid: true}
 	}
-	return u, nil
+	res, err := r.querier.UpdateUserDisabledAt(ctx, sqlc.UpdateUserDisabledAtParams{
+		ID:         req.ID,
+		DisabledAt: disabledAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newGetUserResponse(res), nil
+}
+
+type UpdatePrivacyRequest struct {
+	ID                 int64
+	ActivityVisibility Visibility
+	ProfileVisibility  Visibility
+	LibraryVisibility  Visibility
+}
+
+func (r *UserRepositoryImpl) UpdatePrivacy(ctx context.Context, req UpdatePrivacyRequest) (*GetUserResponse, error) {
+	args := sqlc.UpdateUserPrivacyParams{
+		ID:                 req.ID,
+		ActivityVisibility: req.ActivityVisibility.toSqlc(),
+		ProfileVisibility:  req.ProfileVisibility.toSqlc(),
+		LibraryVisibility:  req.LibraryVisibility.toSqlc(),
+	}
+	res, err := r.querier.UpdateUserPrivacy(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return newGetUserResponse(res), nil
+}
+
+type UpdateTimezoneRequest struct {
+	ID       int64
+	Timezone string
+}
+
+func (r *UserRepositoryImpl) UpdateTimezone(ctx context.Context, req UpdateTimezoneRequest) (*GetUserResponse, error) {
+	args := sqlc.UpdateUserTimezoneParams{
+		ID:       req.ID,
+		Timezone: req.Timezone,
+	}
+	res, err := r.querier.UpdateUserTimezone(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return newGetUserResponse(res), nil
 }
 
 type UpdateRequest struct {
@@ -5,6 +5,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"readly/testdata"
 	"testing"
+	"time"
 )
 
 func createRandomUser(t *testing.T) *CreateUserResponse {
@@ -35,3 +36,20 @@ func TestCreateUser(t *testing.T) {
 	require.Equal(t, user.Name, gu.Name)
 	require.Equal(t, user.Email, gu.Email)
 }
+
+func TestUpdateDisabledAt(t *testing.T) {
+	user := createRandomUser(t)
+	now := time.Now()
+
+	disabled, err := userRepo.UpdateDisabledAt(context.Background(), UpdateDisabledAtRequest{
+		ID:         user.ID,
+		DisabledAt: &now,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, disabled.DisabledAt)
+	require.WithinDuration(t, now, *disabled.DisabledAt, time.Second)
+
+	enabled, err := userRepo.UpdateDisabledAt(context.Background(), UpdateDisabledAtRequest{ID: user.ID})
+	require.NoError(t, err)
+	require.Nil(t, enabled.DisabledAt)
+}
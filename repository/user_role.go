@@ -0,0 +1,73 @@
+package repository
+
+import (
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+)
+
+type UserRole int
+
+const (
+	RoleUser UserRole = iota
+	RoleLibrarian
+	RoleAdmin
+)
+
+type UserRoleConvertible interface {
+	entity.UserRole | sqlc.UserRole
+}
+
+func (r UserRole) toSqlc() sqlc.UserRole {
+	switch r {
+	case RoleLibrarian:
+		return sqlc.UserRoleLibrarian
+	case RoleAdmin:
+		return sqlc.UserRoleAdmin
+	default:
+		return sqlc.UserRoleUser
+	}
+}
+
+func (r UserRole) ToEntity() entity.UserRole {
+	switch r {
+	case RoleLibrarian:
+		return entity.RoleLibrarian
+	case RoleAdmin:
+		return entity.RoleAdmin
+	default:
+		return entity.RoleUser
+	}
+}
+
+func newUserRoleFromSqlc(r sqlc.UserRole) UserRole {
+	switch r {
+	case sqlc.UserRoleLibrarian:
+		return RoleLibrarian
+	case sqlc.UserRoleAdmin:
+		return RoleAdmin
+	default:
+		return RoleUser
+	}
+}
+
+func newUserRoleFromEntity(e entity.UserRole) UserRole {
+	switch e {
+	case entity.RoleLibrarian:
+		return RoleLibrarian
+	case entity.RoleAdmin:
+		return RoleAdmin
+	default:
+		return RoleUser
+	}
+}
+
+func NewUserRole[T UserRoleConvertible](src T) UserRole {
+	switch v := any(src).(type) {
+	case entity.UserRole:
+		return newUserRoleFromEntity(v)
+	case sqlc.UserRole:
+		return newUserRoleFromSqlc(v)
+	default:
+		return RoleUser
+	}
+}
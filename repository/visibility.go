@@ -0,0 +1,73 @@
+package repository
+
+import (
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+)
+
+type Visibility int
+
+const (
+	Private Visibility = iota
+	FollowersOnly
+	Public
+)
+
+type VisibilityConvertible interface {
+	entity.Visibility | sqlc.Visibility
+}
+
+func (v Visibility) toSqlc() sqlc.Visibility {
+	switch v {
+	case Private:
+		return sqlc.VisibilityPrivate
+	case Public:
+		return sqlc.VisibilityPublic
+	default:
+		return sqlc.VisibilityFollowers
+	}
+}
+
+func (v Visibility) ToEntity() entity.Visibility {
+	switch v {
+	case Private:
+		return entity.Private
+	case Public:
+		return entity.Public
+	default:
+		return entity.FollowersOnly
+	}
+}
+
+func newVisibilityFromSqlc(v sqlc.Visibility) Visibility {
+	switch v {
+	case sqlc.VisibilityPrivate:
+		return Private
+	case sqlc.VisibilityPublic:
+		return Public
+	default:
+		return FollowersOnly
+	}
+}
+
+func newVisibilityFromEntity(e entity.Visibility) Visibility {
+	switch e {
+	case entity.Private:
+		return Private
+	case entity.Public:
+		return Public
+	default:
+		return FollowersOnly
+	}
+}
+
+func NewVisibility[T VisibilityConvertible](src T) Visibility {
+	switch v := any(src).(type) {
+	case entity.Visibility:
+		return newVisibilityFromEntity(v)
+	case sqlc.Visibility:
+		return newVisibilityFromSqlc(v)
+	default:
+		return FollowersOnly
+	}
+}
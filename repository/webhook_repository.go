@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	sqlc "readly/db/sqlc"
+	"readly/entity"
+	"time"
+)
+
+type WebhookDeliveryStatus int
+
+const (
+	DeliveryPending WebhookDeliveryStatus = iota
+	DeliverySucceeded
+	DeliveryDead
+)
+
+func (s WebhookDeliveryStatus) toSqlc() sqlc.WebhookDeliveryStatus {
+	switch s {
+	case DeliverySucceeded:
+		return sqlc.WebhookDeliveryStatusSucceeded
+	case DeliveryDead:
+		return sqlc.WebhookDeliveryStatusDead
+	default:
+		return sqlc.WebhookDeliveryStatusPending
+	}
+}
+
+func (s WebhookDeliveryStatus) ToEntity() entity.WebhookDeliveryStatus {
+	switch s {
+	case DeliverySucceeded:
+		return entity.DeliverySucceeded
+	case DeliveryDead:
+		return entity.DeliveryDead
+	default:
+		return entity.DeliveryPending
+	}
+}
+
+func newWebhookDeliveryStatus(s sqlc.WebhookDeliveryStatus) WebhookDeliveryStatus {
+	switch s {
+	case sqlc.WebhookDeliveryStatusSucceeded:
+		return DeliverySucceeded
+	case sqlc.WebhookDeliveryStatusDead:
+		return DeliveryDead
+	default:
+		return DeliveryPending
+	}
+}
+
+type WebhookRepository interface {
+	ClaimDueDeliveries(ctx context.Context, req ClaimWebhookDeliveriesRequest) ([]ClaimedWebhookDeliveryResponse, error)
+	CreateDelivery(ctx context.Context, req CreateWebhookDeliveryRequest) error
+	CreateEndpoint(ctx context.Context, req CreateWebhookEndpointRequest) (*WebhookEndpointResponse, error)
+	DeleteEndpoint(ctx context.Context, req WebhookEndpointRequest) error
+	GetDeliveriesByEndpoint(ctx context.Context, req GetWebhookDeliveriesRequest) ([]WebhookDeliveryResponse, error)
+	GetEndpointByID(ctx context.Context, req WebhookEndpointRequest) (*WebhookEndpointResponse, error)
+	GetEndpointsByEvent(ctx context.Context, req GetWebhookEndpointsByEventRequest) ([]WebhookEndpointResponse, error)
+	GetEndpointsByUser(ctx context.Context, userID int64) ([]WebhookEndpointResponse, error)
+	UpdateDeliveryResult(ctx context.Context, req UpdateWebhookDeliveryResultRequest) error
+}
+
+type WebhookRepositoryImpl struct {
+	querier sqlc.Querier
+}
+
+func NewWebhookRepository(q sqlc.Querier) WebhookRepository {
+	return &WebhookRepositoryImpl{
+		querier: q,
+	}
+}
+
+type WebhookEndpointResponse struct {
+	ID         int64
+	UserID     int64
+	URL        string
+	Secret     string
+	EventTypes []entity.DomainEventType
+	CreatedAt  time.Time
+}
+
+func newWebhookEndpointResponse(e sqlc.WebhookEndpoint) WebhookEndpointResponse {
+	eventTypes := make([]entity.DomainEventType, len(e.EventTypes))
+	for i, t := range e.EventTypes {
+		eventTypes[i] = entity.DomainEventType(t)
+	}
+	return WebhookEndpointResponse{
+		ID:         e.ID,
+		UserID:     e.UserID,
+		URL:        e.Url,
+		Secret:     e.Secret,
+		EventTypes: eventTypes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+type ClaimWebhookDeliveriesRequest struct {
+	Limit int32
+	// 配信中の他のプロセスが同じ配信を取得しないように、この時刻まで次回の配信を先送りする
+	LeaseUntil time.Time
+}
+
+type ClaimedWebhookDeliveryResponse struct {
+	ID        int64
+	EventID   int64
+	EventType entity.DomainEventType
+	Body      json.RawMessage
+	Attempts  int32
+	URL       string
+	Secret    string
+}
+
+func (r *WebhookRepositoryImpl) ClaimDueDeliveries(ctx context.Context, req ClaimWebhookDeliveriesRequest) ([]ClaimedWebhookDeliveryResponse, error) {
+	rows, err := r.querier.ClaimDueWebhookDeliveries(ctx, sqlc.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: req.LeaseUntil,
+		PageSize:   req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ClaimedWebhookDeliveryResponse, len(rows))
+	for i, row := range rows {
+		res[i] = ClaimedWebhookDeliveryResponse{
+			ID:        row.ID,
+			EventID:   row.EventID,
+			EventType: entity.DomainEventType(row.EventType),
+			Body:      row.Body,
+			Attempts:  row.Attempts,
+			URL:       row.Url,
+			Secret:    row.Secret,
+		}
+	}
+	return res, nil
+}
+
+type CreateWebhookDeliveryRequest struct {
+	EndpointID int64
+	EventID    int64
+	EventType  entity.DomainEventType
+	Body       json.RawMessage
+}
+
+// CreateDelivery 同じエンドポイントへの同じイベントの配信は1件だけ作成する
+func (r *WebhookRepositoryImpl) CreateDelivery(ctx context.Context, req CreateWebhookDeliveryRequest) error {
+	return r.querier.CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		EndpointID: req.EndpointID,
+		EventID:    req.EventID,
+		EventType:  string(req.EventType),
+		Body:       req.Body,
+	})
+}
+
+type CreateWebhookEndpointRequest struct {
+	UserID     int64
+	URL        string
+	Secret     string
+	EventTypes []entity.DomainEventType
+}
+
+func (r *WebhookRepositoryImpl) CreateEndpoint(ctx context.Context, req CreateWebhookEndpointRequest) (*WebhookEndpointResponse, error) {
+	eventTypes := make([]string, len(req.EventTypes))
+	for i, t := range req.EventTypes {
+		eventTypes[i] = string(t)
+	}
+	e, err := r.querier.CreateWebhookEndpoint(ctx, sqlc.CreateWebhookEndpointParams{
+		UserID:     req.UserID,
+		Url:        req.URL,
+		Secret:     req.Secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newWebhookEndpointResponse(e)
+	return &res, nil
+}
+
+type WebhookEndpointRequest struct {
+	ID     int64
+	UserID int64
+}
+
+func (r *WebhookRepositoryImpl) DeleteEndpoint(ctx context.Context, req WebhookEndpointRequest) error {
+	rows, err := r.querier.DeleteWebhookEndpoint(ctx, sqlc.DeleteWebhookEndpointParams{
+		ID:     req.ID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNoRowsDeleted
+	}
+	return nil
+}
+
+type GetWebhookDeliveriesRequest struct {
+	EndpointID int64
+	Limit      int32
+	Offset     int32
+}
+
+type WebhookDeliveryResponse struct {
+	ID                 int64
+	EventID            int64
+	EventType          entity.DomainEventType
+	Status             WebhookDeliveryStatus
+	Attempts           int32
+	NextAttemptAt      time.Time
+	LastResponseStatus *int32
+	LastError          *string
+	DeliveredAt        *time.Time
+	CreatedAt          time.Time
+}
+
+func (r *WebhookRepositoryImpl) GetDeliveriesByEndpoint(ctx context.Context, req GetWebhookDeliveriesRequest) ([]WebhookDeliveryResponse, error) {
+	deliveries, err := r.querier.GetWebhookDeliveriesByEndpoint(ctx, sqlc.GetWebhookDeliveriesByEndpointParams{
+		EndpointID: req.EndpointID,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		res[i] = WebhookDeliveryResponse{
+			ID:                 d.ID,
+			EventID:            d.EventID,
+			EventType:          entity.DomainEventType(d.EventType),
+			Status:             newWebhookDeliveryStatus(d.Status),
+			Attempts:           d.Attempts,
+			NextAttemptAt:      d.NextAttemptAt,
+			LastResponseStatus: nilInt32(d.LastResponseStatus),
+			LastError:          nilString(d.LastError),
+			DeliveredAt:        nilTime(d.DeliveredAt),
+			CreatedAt:          d.CreatedAt,
+		}
+	}
+	return res, nil
+}
+
+func (r *WebhookRepositoryImpl) GetEndpointByID(ctx context.Context, req WebhookEndpointRequest) (*WebhookEndpointResponse, error) {
+	e, err := r.querier.GetWebhookEndpointByID(ctx, sqlc.GetWebhookEndpointByIDParams{
+		ID:     req.ID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := newWebhookEndpointResponse(e)
+	return &res, nil
+}
+
+type GetWebhookEndpointsByEventRequest struct {
+	UserID    int64
+	EventType entity.DomainEventType
+}
+
+func (r *WebhookRepositoryImpl) GetEndpointsByEvent(ctx context.Context, req GetWebhookEndpointsByEventRequest) ([]WebhookEndpointResponse, error) {
+	endpoints, err := r.querier.GetWebhookEndpointsByEvent(ctx, sqlc.GetWebhookEndpointsByEventParams{
+		UserID:    req.UserID,
+		EventType: string(req.EventType),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]WebhookEndpointResponse, len(endpoints))
+	for i, e := range endpoints {
+		res[i] = newWebhookEndpointResponse(e)
+	}
+	return res, nil
+}
+
+func (r *WebhookRepositoryImpl) GetEndpointsByUser(ctx context.Context, userID int64) ([]WebhookEndpointResponse, error) {
+	endpoints, err := r.querier.GetWebhookEndpointsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]WebhookEndpointResponse, len(endpoints))
+	for i, e := range endpoints {
+		res[i] = newWebhookEndpointResponse(e)
+	}
+	return res, nil
+}
+
+type UpdateWebhookDeliveryResultRequest struct {
+	ID     int64
+	Status WebhookDeliveryStatus
+	// 接続できなかった場合はnil
+	ResponseStatus *int32
+	Error          *string
+	NextAttemptAt  time.Time
+	DeliveredAt    *time.Time
+}
+
+func (r *WebhookRepositoryImpl) UpdateDeliveryResult(ctx context.Context, req UpdateWebhookDeliveryResultRequest) error {
+	rs := sql.NullInt32{Int32: 0, Valid: false}
+	if req.ResponseStatus != nil {
+		rs = sql.NullInt32{Int32: *req.ResponseStatus, Valid: true}
+	}
+	le := sql.NullString{String: "", Valid: false}
+	if req.Error != nil {
+		le = sql.NullString{String: *req.Error, Valid: true}
+	}
+	da := sql.NullTime{Time: time.Time{}, Valid: false}
+	if req.DeliveredAt != nil {
+		da = sql.NullTime{Time: *req.DeliveredAt, Valid: true}
+	}
+	return r.querier.UpdateWebhookDeliveryResult(ctx, sqlc.UpdateWebhookDeliveryResultParams{
+		ID:                 req.ID,
+		Status:             req.Status.toSqlc(),
+		LastResponseStatus: rs,
+		LastError:          le,
+		NextAttemptAt:      req.NextAttemptAt,
+		DeliveredAt:        da,
+	})
+}
@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"readly/entity"
+	"readly/pb"
+	"readly/usecase"
+	"readly/util"
+)
+
+type AdminServerImpl struct {
+	pb.UnimplementedAdminServiceServer
+	listUsersUseCase          usecase.ListUsersUseCase
+	setUserDisabledUseCase    usecase.SetUserDisabledUseCase
+	revokeUserSessionsUseCase usecase.RevokeUserSessionsUseCase
+	userLibraryUseCase        usecase.GetUserLibraryUseCase
+	deleteCatalogBookUseCase  usecase.DeleteCatalogBookUseCase
+	listAuditLogsUseCase      usecase.ListAuditLogsUseCase
+}
+
+func NewAdminServer(
+	listUsersUseCase usecase.ListUsersUseCase,
+	setUserDisabledUseCase usecase.SetUserDisabledUseCase,
+	revokeUserSessionsUseCase usecase.RevokeUserSessionsUseCase,
+	userLibraryUseCase usecase.GetUserLibraryUseCase,
+	deleteCatalogBookUseCase usecase.DeleteCatalogBookUseCase,
+	listAuditLogsUseCase usecase.ListAuditLogsUseCase,
+) *AdminServerImpl {
+	return &AdminServerImpl{
+		listUsersUseCase:          listUsersUseCase,
+		setUserDisabledUseCase:    setUserDisabledUseCase,
+		revokeUserSessionsUseCase: revokeUserSessionsUseCase,
+		userLibraryUseCase:        userLibraryUseCase,
+		deleteCatalogBookUseCase:  deleteCatalogBookUseCase,
+		listAuditLogsUseCase:      listAuditLogsUseCase,
+	}
+}
+
+func (a *AdminServerImpl) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListUsersRequest{
+		ActorID: claims.UserID,
+		Role:    claims.Role,
+		Query:   req.Query,
+		Limit:   req.GetLimit(),
+		Offset:  req.GetOffset(),
+	}
+	users, err := a.listUsersUseCase.ListUsers(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	res := &pb.ListUsersResponse{
+		Users: make([]*pb.AdminUser, len(users)),
+	}
+	for i, u := range users {
+		res.Users[i] = toAdminUserPb(&u)
+	}
+	return res, nil
+}
+
+func (a *AdminServerImpl) DisableUser(ctx context.Context, req *pb.DisableUserRequest) (*pb.AdminUser, error) {
+	return a.setUserDisabled(ctx, req, req.GetUserId(), true)
+}
+
+func (a *AdminServerImpl) EnableUser(ctx context.Context, req *pb.EnableUserRequest) (*pb.AdminUser, error) {
+	return a.setUserDisabled(ctx, req, req.GetUserId(), false)
+}
+
+func (a *AdminServerImpl) setUserDisabled(ctx context.Context, req proto.Message, userID int64, disabled bool) (*pb.AdminUser, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.SetUserDisabledRequest{
+		ActorID:  claims.UserID,
+		Role:     claims.Role,
+		UserID:   userID,
+		Disabled: disabled,
+	}
+	user, err := a.setUserDisabledUseCase.SetUserDisabled(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toAdminUserPb(user), nil
+}
+
+func (a *AdminServerImpl) RevokeUserSessions(ctx context.Context, req *pb.RevokeUserSessionsRequest) (*pb.RevokeUserSessionsResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.RevokeUserSessionsRequest{
+		ActorID: claims.UserID,
+		Role:    claims.Role,
+		UserID:  req.GetUserId(),
+	}
+	revoked, err := a.revokeUserSessionsUseCase.RevokeUserSessions(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.RevokeUserSessionsResponse{
+		RevokedSessions: revoked,
+	}, nil
+}
+
+func (a *AdminServerImpl) GetUserLibrary(ctx context.Context, req *pb.GetUserLibraryRequest) (*pb.GetLibraryResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetUserLibraryRequest{
+		ActorID: claims.UserID,
+		Role:    claims.Role,
+		UserID:  req.GetUserId(),
+		Limit:   req.GetLimit(),
+		Offset:  req.GetOffset(),
+	}
+	books, err := a.userLibraryUseCase.GetUserLibrary(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.GetLibraryResponse{
+		Books: toBooksPb(books),
+	}, nil
+}
+
+func (a *AdminServerImpl) DeleteCatalogBook(ctx context.Context, req *pb.DeleteCatalogBookRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.DeleteCatalogBookRequest{
+		ActorID: claims.UserID,
+		Role:    claims.Role,
+		BookID:  req.GetBookId(),
+		Reason:  req.GetReason(),
+	}
+	err = a.deleteCatalogBookUseCase.DeleteCatalogBook(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (a *AdminServerImpl) ListAuditLogs(ctx context.Context, req *pb.ListAuditLogsRequest) (*pb.ListAuditLogsResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListAuditLogsRequest{
+		Role:     claims.Role,
+		TargetID: req.TargetId,
+		Limit:    req.GetLimit(),
+		Offset:   req.GetOffset(),
+	}
+	if req.TargetType != nil {
+		targetType := entity.AuditTargetType(req.GetTargetType())
+		args.TargetType = &targetType
+	}
+	logs, err := a.listAuditLogsUseCase.ListAuditLogs(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	res := &pb.ListAuditLogsResponse{
+		Logs: make([]*pb.AuditLog, len(logs)),
+	}
+	for i, l := range logs {
+		res.Logs[i] = toAuditLogPb(l)
+	}
+	return res, nil
+}
+
+func toUserRolePb(role entity.UserRole) pb.UserRole {
+	switch role {
+	case entity.RoleLibrarian:
+		return pb.UserRole_ROLE_LIBRARIAN
+	case entity.RoleAdmin:
+		return pb.UserRole_ROLE_ADMIN
+	default:
+		return pb.UserRole_ROLE_USER
+	}
+}
+
+func toAdminUserPb(user *entity.User) *pb.AdminUser {
+	return &pb.AdminUser{
+		Id:         user.ID,
+		Name:       user.Name,
+		Email:      user.Email,
+		Role:       toUserRolePb(user.Role),
+		DisabledAt: util.ToTimestampOrNil(user.DisabledAt),
+	}
+}
+
+func toAuditLogPb(log entity.AuditLog) *pb.AuditLog {
+	detail := &structpb.Struct{}
+	// 書き込み時にJSONオブジェクトとして保存しているため失敗しない
+	_ = detail.UnmarshalJSON(log.Detail)
+	return &pb.AuditLog{
+		Id:         log.ID,
+		ActorId:    log.ActorID,
+		Action:     string(log.Action),
+		TargetType: string(log.TargetType),
+		TargetId:   log.TargetID,
+		Detail:     detail,
+		CreatedAt:  util.ToTimestampOrNil(&log.CreatedAt),
+	}
+}
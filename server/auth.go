@@ -0,0 +1,18 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"readly/middleware"
+	"readly/service/auth"
+)
+
+// claimsFrom 認証はmiddlewareのインターセプターで行い、ハンドラーはコンテキストから認証情報を取り出す
+func claimsFrom(ctx context.Context) (*auth.Claims, error) {
+	claims, ok := middleware.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing authentication")
+	}
+	return claims, nil
+}
@@ -2,33 +2,81 @@ package server
 
 import (
 	"context"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"readly/entity"
-	"readly/middleware"
 	"readly/pb"
-	"readly/service/auth"
 	"readly/usecase"
 	"readly/util"
+	"time"
 )
 
 type BookServerImpl struct {
 	pb.UnimplementedBookServiceServer
-	maker           auth.TokenMaker
-	registerUseCase usecase.RegisterBookUseCase
-	deleteUseCase   usecase.DeleteBookUseCase
+	registerUseCase      usecase.RegisterBookUseCase
+	deleteUseCase        usecase.DeleteBookUseCase
+	updateUseCase        usecase.UpdateBookUseCase
+	readingStatsUseCase  usecase.GetReadingStatsUseCase
+	yearInReviewUseCase  usecase.GenerateYearInReviewUseCase
+	streakUseCase        usecase.GetReadingStreakUseCase
+	calendarUseCase      usecase.GetActivityCalendarUseCase
+	queueUseCase         usecase.GetReadingQueueUseCase
+	reorderUseCase       usecase.ReorderReadingQueueUseCase
+	popNextUseCase       usecase.PopNextBookUseCase
+	wishlistUseCase      usecase.UpdateWishlistEntryUseCase
+	libraryUseCase       usecase.GetLibraryUseCase
+	spendingUseCase      usecase.GetSpendingSummaryUseCase
+	recommendUseCase     usecase.RecommendBooksUseCase
+	watchLibraryUseCase  usecase.WatchLibraryUseCase
+	syncUseCase          usecase.SyncUseCase
+	batchRegisterUseCase usecase.BatchRegisterBooksUseCase
+	idempotencyUseCase   usecase.ExecuteWithIdempotencyKeyUseCase
 }
 
 func NewBookServer(
-	maker auth.TokenMaker,
 	registerUseCase usecase.RegisterBookUseCase,
 	deleteUseCase usecase.DeleteBookUseCase,
+	updateUseCase usecase.UpdateBookUseCase,
+	readingStatsUseCase usecase.GetReadingStatsUseCase,
+	yearInReviewUseCase usecase.GenerateYearInReviewUseCase,
+	streakUseCase usecase.GetReadingStreakUseCase,
+	calendarUseCase usecase.GetActivityCalendarUseCase,
+	queueUseCase usecase.GetReadingQueueUseCase,
+	reorderUseCase usecase.ReorderReadingQueueUseCase,
+	popNextUseCase usecase.PopNextBookUseCase,
+	wishlistUseCase usecase.UpdateWishlistEntryUseCase,
+	libraryUseCase usecase.GetLibraryUseCase,
+	spendingUseCase usecase.GetSpendingSummaryUseCase,
+	recommendUseCase usecase.RecommendBooksUseCase,
+	watchLibraryUseCase usecase.WatchLibraryUseCase,
+	syncUseCase usecase.SyncUseCase,
+	batchRegisterUseCase usecase.BatchRegisterBooksUseCase,
+	idempotencyUseCase usecase.ExecuteWithIdempotencyKeyUseCase,
 ) *BookServerImpl {
 	return &BookServerImpl{
-		maker:           maker,
-		registerUseCase: registerUseCase,
-		deleteUseCase:   deleteUseCase,
+		registerUseCase:      registerUseCase,
+		deleteUseCase:        deleteUseCase,
+		updateUseCase:        updateUseCase,
+		readingStatsUseCase:  readingStatsUseCase,
+		yearInReviewUseCase:  yearInReviewUseCase,
+		streakUseCase:        streakUseCase,
+		calendarUseCase:      calendarUseCase,
+		queueUseCase:         queueUseCase,
+		reorderUseCase:       reorderUseCase,
+		popNextUseCase:       popNextUseCase,
+		wishlistUseCase:      wishlistUseCase,
+		libraryUseCase:       libraryUseCase,
+		spendingUseCase:      spendingUseCase,
+		recommendUseCase:     recommendUseCase,
+		watchLibraryUseCase:  watchLibraryUseCase,
+		syncUseCase:          syncUseCase,
+		batchRegisterUseCase: batchRegisterUseCase,
+		idempotencyUseCase:   idempotencyUseCase,
 	}
 }
 
@@ -40,59 +88,234 @@ func (b *BookServerImpl) toReadingStatusEntity(status pb.ReadingStatus) entity.R
 		return entity.Reading
 	case pb.ReadingStatus_DONE:
 		return entity.Done
+	case pb.ReadingStatus_ABANDONED:
+		return entity.Abandoned
+	case pb.ReadingStatus_ON_HOLD:
+		return entity.OnHold
 	default:
 		return entity.Unknown
 	}
 }
 
 func (b *BookServerImpl) RegisterBook(ctx context.Context, req *pb.RegisterBookRequest) (*pb.Book, error) {
-	claims, err := middleware.Authenticate(ctx, b.maker)
+	claims, err := claimsFrom(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
 	}
 
-	// TODO:バリデーション
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := b.toRegisterBookRequest(claims.UserID, req)
+	res := &pb.Book{}
+	err = executeIdempotently(ctx, b.idempotencyUseCase, claims.UserID, "RegisterBook", req, res, func(ctx context.Context) (proto.Message, error) {
+		book, err := b.registerUseCase.RegisterBook(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return toBookPb(book), nil
+	})
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return res, nil
+}
 
-	args := usecase.RegisterBookRequest{
-		UserID:        claims.UserID,
-		Title:         req.GetTitle(),
-		Genres:        req.GetGenres(),
-		Description:   util.ToStringOrNil(req.GetDescription()),
-		CoverImageURL: util.ToStringOrNil(req.GetCoverImageUrl()),
-		URL:           util.ToStringOrNil(req.GetUrl()),
-		AuthorName:    util.ToStringOrNil(req.GetAuthorName()),
-		PublisherName: util.ToStringOrNil(req.GetPublisherName()),
-		PublishDate:   util.ToTimeOrNil(req.GetPublishDate()),
-		ISBN:          util.ToStringOrNil(req.GetIsbn()),
-		Status:        b.toReadingStatusEntity(req.GetReadingStatus()),
-		StartDate:     util.ToTimeOrNil(req.GetStartDate()),
-		EndDate:       util.ToTimeOrNil(req.GetEndDate()),
+func (b *BookServerImpl) toRegisterBookRequest(userID int64, req *pb.RegisterBookRequest) usecase.RegisterBookRequest {
+	return usecase.RegisterBookRequest{
+		UserID:           userID,
+		Title:            req.GetTitle(),
+		Genres:           req.GetGenres(),
+		Description:      util.ToStringOrNil(req.GetDescription()),
+		CoverImageURL:    util.ToStringOrNil(req.GetCoverImageUrl()),
+		URL:              util.ToStringOrNil(req.GetUrl()),
+		AuthorName:       util.ToStringOrNil(req.GetAuthorName()),
+		PublisherName:    util.ToStringOrNil(req.GetPublisherName()),
+		PublishDate:      util.ToTimeOrNil(req.GetPublishDate()),
+		ISBN:             util.ToStringOrNil(req.GetIsbn()),
+		PageCount:        req.PageCount,
+		Status:           b.toReadingStatusEntity(req.GetReadingStatus()),
+		StartDate:        util.ToTimeOrNil(req.GetStartDate()),
+		EndDate:          util.ToTimeOrNil(req.GetEndDate()),
+		Priority:         int16(req.GetPriority()),
+		Owned:            req.Owned,
+		Format:           entity.BookFormat(req.GetFormat()),
+		PurchasePrice:    req.PurchasePrice,
+		PurchaseCurrency: req.PurchaseCurrency,
+		PurchaseStore:    req.PurchaseStore,
+		PurchaseDate:     util.ToTimeOrNil(req.GetPurchaseDate()),
+		DurationMinutes:  req.DurationMinutes,
 	}
-	book, err := b.registerUseCase.RegisterBook(ctx, args)
+}
+
+func (b *BookServerImpl) BatchRegisterBooks(ctx context.Context, req *pb.BatchRegisterBooksRequest) (*pb.BatchRegisterBooksResponse, error) {
+	claims, err := claimsFrom(ctx)
 	if err != nil {
-		return nil, gRPCStatusError(err)
+		return nil, err
 	}
-	return &pb.Book{
-		Id:            book.ID,
-		Title:         book.Title,
-		Genres:        book.Genres,
-		Description:   book.Description,
-		CoverImageUrl: book.CoverImageURL,
-		Url:           book.URL,
-		AuthorName:    book.AuthorName,
-		PublisherName: book.PublisherName,
-		PublishDate:   util.ToTimestampOrNil(book.PublishDate),
-		Isbn:          book.ISBN,
-		ReadingStatus: pb.ReadingStatus(book.Status),
-		StartDate:     util.ToTimestampOrNil(book.StartDate),
-		EndDate:       util.ToTimestampOrNil(book.EndDate),
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	items := make([]usecase.RegisterBookRequest, len(req.GetItems()))
+	for i, item := range req.GetItems() {
+		items[i] = b.toRegisterBookRequest(claims.UserID, item)
+	}
+	args := usecase.BatchRegisterBooksRequest{
+		UserID: claims.UserID,
+		Items:  items,
+		Atomic: req.GetAtomic(),
+	}
+	res := &pb.BatchRegisterBooksResponse{}
+	err = executeIdempotently(ctx, b.idempotencyUseCase, claims.UserID, "BatchRegisterBooks", req, res, func(ctx context.Context) (proto.Message, error) {
+		batch, err := b.batchRegisterUseCase.BatchRegisterBooks(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		return toBatchRegisterBooksResponsePb(batch), nil
+	})
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return res, nil
+}
+
+func toBatchRegisterBooksResponsePb(res *usecase.BatchRegisterBooksResponse) *pb.BatchRegisterBooksResponse {
+	results := make([]*pb.BatchRegisterBookResult, len(res.Results))
+	for i, r := range res.Results {
+		if r.Err != nil {
+			results[i] = &pb.BatchRegisterBookResult{
+				ErrorCode:    int32(r.Err.ErrorCode),
+				ErrorMessage: r.Err.Message,
+			}
+			continue
+		}
+		results[i] = &pb.BatchRegisterBookResult{Book: toBookPb(r.Book)}
+	}
+	return &pb.BatchRegisterBooksResponse{Results: results}
+}
+
+func toBookPb(book *entity.Book) *pb.Book {
+	res := &pb.Book{
+		Id:               book.ID,
+		Title:            book.Title,
+		Genres:           book.Genres,
+		Description:      book.Description,
+		CoverImageUrl:    book.CoverImageURL,
+		Url:              book.URL,
+		AuthorName:       book.AuthorName,
+		PublisherName:    book.PublisherName,
+		PublishDate:      util.ToTimestampOrNil(book.PublishDate),
+		Isbn:             book.ISBN,
+		PageCount:        book.PageCount,
+		ReadingStatus:    pb.ReadingStatus(book.Status),
+		StartDate:        util.ToTimestampOrNil(book.StartDate),
+		EndDate:          util.ToTimestampOrNil(book.EndDate),
+		Priority:         int32(book.Priority),
+		QueuePosition:    book.QueuePosition,
+		Owned:            book.Owned,
+		Overdue:          book.Overdue,
+		Format:           pb.BookFormat(book.Format),
+		PurchasePrice:    book.PurchasePrice,
+		PurchaseCurrency: book.PurchaseCurrency,
+		PurchaseStore:    book.PurchaseStore,
+		PurchaseDate:     util.ToTimestampOrNil(book.PurchaseDate),
+		DurationMinutes:  book.DurationMinutes,
+	}
+	// 他のユーザーの本など、バージョンを取得していない場合は空にする
+	if book.Version > 0 {
+		res.Etag = formatETag(book.Version)
+	}
+	if book.CatalogVersion > 0 {
+		res.CatalogEtag = formatETag(book.CatalogVersion)
+	}
+	return res
+}
+
+func toBooksPb(books []entity.Book) []*pb.Book {
+	res := make([]*pb.Book, len(books))
+	for i := range books {
+		res[i] = toBookPb(&books[i])
+	}
+	return res
+}
+
+func (b *BookServerImpl) GetLibrary(ctx context.Context, req *pb.GetLibraryRequest) (*pb.GetLibraryResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetLibraryRequest{
+		UserID: claims.UserID,
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+	books, err := b.libraryUseCase.GetLibrary(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.GetLibraryResponse{
+		Books: toBooksPb(books),
 	}, nil
 }
 
+func (b *BookServerImpl) UpdateBook(ctx context.Context, req *pb.UpdateBookRequest) (*pb.Book, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.Etag)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	args := usecase.UpdateBookRequest{
+		UserID:          claims.UserID,
+		Role:            claims.Role,
+		BookID:          req.GetBookId(),
+		Title:           req.GetTitle(),
+		Description:     req.Description,
+		CoverImageURL:   req.CoverImageUrl,
+		URL:             req.Url,
+		AuthorName:      req.AuthorName,
+		PublisherName:   req.PublisherName,
+		PublishDate:     util.ToTimeOrNil(req.GetPublishDate()),
+		ISBN:            req.Isbn,
+		PageCount:       req.PageCount,
+		ExpectedVersion: version,
+	}
+	book, err := b.updateUseCase.UpdateBook(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	res := toBookPb(book)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(etagHeader, res.CatalogEtag)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set header: %s", err)
+	}
+	return res, nil
+}
+
 func (b *BookServerImpl) DeleteBook(ctx context.Context, req *pb.DeleteBookRequest) (*emptypb.Empty, error) {
-	claims, err := middleware.Authenticate(ctx, b.maker)
+	claims, err := claimsFrom(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, err.Error())
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
 	}
 	args := usecase.DeleteBookRequest{
 		UserID: claims.UserID,
@@ -100,7 +323,532 @@ func (b *BookServerImpl) DeleteBook(ctx context.Context, req *pb.DeleteBookReque
 	}
 	err = b.deleteUseCase.DeleteBook(ctx, args)
 	if err != nil {
-		return nil, gRPCStatusError(err)
+		return nil, gRPCStatusError(ctx, err)
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (b *BookServerImpl) GetReadingStats(ctx context.Context, req *pb.GetReadingStatsRequest) (*pb.GetReadingStatsResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// 期間の指定がない場合は直近1年間を集計対象とする
+	to := time.Now().UTC()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	from := to.AddDate(-1, 0, 0)
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+
+	args := usecase.GetReadingStatsRequest{
+		UserID: claims.UserID,
+		From:   from,
+		To:     to,
+	}
+	stats, err := b.readingStatsUseCase.GetReadingStats(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	months := make([]*pb.MonthlyCount, len(stats.FinishedByMonth))
+	for i, m := range stats.FinishedByMonth {
+		months[i] = &pb.MonthlyCount{
+			Month: util.ToTimestampOrNil(&m.Month),
+			Count: m.Count,
+		}
+	}
+	return &pb.GetReadingStatsResponse{
+		From:                util.ToTimestampOrNil(&stats.From),
+		To:                  util.ToTimestampOrNil(&stats.To),
+		FinishedBooks:       stats.FinishedBooks,
+		FinishedByMonth:     months,
+		Genres:              toNameCountsPb(stats.Genres),
+		TopAuthors:          toNameCountsPb(stats.TopAuthors),
+		TopPublishers:       toNameCountsPb(stats.TopPublishers),
+		AverageDaysToFinish: stats.AverageDaysToFinish,
+	}, nil
+}
+
+func (b *BookServerImpl) GenerateYearInReview(ctx context.Context, req *pb.GenerateYearInReviewRequest) (*pb.GenerateYearInReviewResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.GenerateYearInReviewRequest{
+		UserID: claims.UserID,
+		Year:   int(req.GetYear()),
+	}
+	res, err := b.yearInReviewUseCase.GenerateYearInReview(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	review := res.Review
+	genres := make([]*pb.NameCount, len(review.FavoriteGenres))
+	for i, g := range review.FavoriteGenres {
+		genres[i] = &pb.NameCount{
+			Name:  g.Name,
+			Count: g.Count,
+		}
+	}
+	books := make([]*pb.ReviewedBook, len(review.Books))
+	for i, book := range review.Books {
+		books[i] = toReviewedBookPb(&book)
+	}
+	var topMonth *pb.MonthlyCount
+	if review.TopMonth != nil {
+		month := time.Date(review.Year, review.TopMonth.Month, 1, 0, 0, 0, 0, time.UTC)
+		topMonth = &pb.MonthlyCount{
+			Month: util.ToTimestampOrNil(&month),
+			Count: review.TopMonth.Count,
+		}
+	}
+	return &pb.GenerateYearInReviewResponse{
+		Year:           int32(review.Year),
+		FinishedBooks:  review.FinishedBooks,
+		TotalPages:     review.TotalPages,
+		LongestBook:    toReviewedBookPb(review.LongestBook),
+		ShortestBook:   toReviewedBookPb(review.ShortestBook),
+		FavoriteGenres: genres,
+		TopMonth:       topMonth,
+		Books:          books,
+		Html:           res.HTML,
+	}, nil
+}
+
+func (b *BookServerImpl) GetSpendingSummary(ctx context.Context, req *pb.GetSpendingSummaryRequest) (*pb.GetSpendingSummaryResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetSpendingSummaryRequest{
+		UserID: claims.UserID,
+		Year:   int(req.GetYear()),
+	}
+	summary, err := b.spendingUseCase.GetSpendingSummary(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	totals := make([]*pb.CurrencyAmount, len(summary.Totals))
+	for i, t := range summary.Totals {
+		totals[i] = &pb.CurrencyAmount{
+			Currency:  t.Currency,
+			Amount:    t.Amount,
+			BookCount: t.BookCount,
+		}
+	}
+	months := make([]*pb.MonthlySpending, len(summary.Months))
+	for i, m := range summary.Months {
+		months[i] = &pb.MonthlySpending{
+			Month:     util.ToTimestampOrNil(&m.Month),
+			Currency:  m.Currency,
+			Amount:    m.Amount,
+			BookCount: m.BookCount,
+		}
+	}
+	formats := make([]*pb.FormatSpending, len(summary.Formats))
+	for i, f := range summary.Formats {
+		formats[i] = &pb.FormatSpending{
+			Format:    pb.BookFormat(f.Format),
+			Currency:  f.Currency,
+			Amount:    f.Amount,
+			BookCount: f.BookCount,
+		}
+	}
+	return &pb.GetSpendingSummaryResponse{
+		Year:    int32(summary.Year),
+		Totals:  totals,
+		Months:  months,
+		Formats: formats,
+	}, nil
+}
+
+func (b *BookServerImpl) GetReadingStreak(ctx context.Context, _ *pb.GetReadingStreakRequest) (*pb.GetReadingStreakResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetReadingStreakRequest{
+		UserID: claims.UserID,
+	}
+	streak, err := b.streakUseCase.GetReadingStreak(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.GetReadingStreakResponse{
+		CurrentStreak:    streak.CurrentStreak,
+		LongestStreak:    streak.LongestStreak,
+		LastActivityDate: util.ToTimestampOrNil(streak.LastActivityDate),
+		Timezone:         streak.Timezone,
+	}, nil
+}
+
+func (b *BookServerImpl) GetActivityCalendar(ctx context.Context, req *pb.GetActivityCalendarRequest) (*pb.GetActivityCalendarResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// 期間の指定がない場合は直近1年間を対象とする
+	to := time.Now().UTC()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+	from := to.AddDate(-1, 0, 1)
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+
+	args := usecase.GetActivityCalendarRequest{
+		UserID: claims.UserID,
+		From:   from,
+		To:     to,
+	}
+	calendar, err := b.calendarUseCase.GetActivityCalendar(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	days := make([]*pb.DailyActivity, len(calendar.Days))
+	for i, d := range calendar.Days {
+		days[i] = &pb.DailyActivity{
+			Date:  util.ToTimestampOrNil(&d.Date),
+			Count: d.Count,
+		}
+	}
+	return &pb.GetActivityCalendarResponse{
+		From:     util.ToTimestampOrNil(&calendar.From),
+		To:       util.ToTimestampOrNil(&calendar.To),
+		Timezone: calendar.Timezone,
+		Days:     days,
+	}, nil
+}
+
+func (b *BookServerImpl) GetReadingQueue(ctx context.Context, _ *pb.GetReadingQueueRequest) (*pb.GetReadingQueueResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetReadingQueueRequest{
+		UserID: claims.UserID,
+	}
+	books, err := b.queueUseCase.GetReadingQueue(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.GetReadingQueueResponse{
+		Books: toBooksPb(books),
+	}, nil
+}
+
+func (b *BookServerImpl) ReorderReadingQueue(ctx context.Context, req *pb.ReorderReadingQueueRequest) (*pb.ReorderReadingQueueResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ReorderReadingQueueRequest{
+		UserID:  claims.UserID,
+		BookIDs: req.GetBookIds(),
+	}
+	books, err := b.reorderUseCase.ReorderReadingQueue(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.ReorderReadingQueueResponse{
+		Books: toBooksPb(books),
+	}, nil
+}
+
+func (b *BookServerImpl) PopNextBook(ctx context.Context, _ *pb.PopNextBookRequest) (*pb.Book, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := usecase.PopNextBookRequest{
+		UserID: claims.UserID,
+	}
+	book, err := b.popNextUseCase.PopNextBook(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toBookPb(book), nil
+}
+
+func (b *BookServerImpl) UpdateWishlistEntry(ctx context.Context, req *pb.UpdateWishlistEntryRequest) (*pb.UpdateWishlistEntryResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.Etag)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	args := usecase.UpdateWishlistEntryRequest{
+		UserID:          claims.UserID,
+		BookID:          req.GetBookId(),
+		Priority:        int16(req.GetPriority()),
+		Owned:           req.GetOwned(),
+		ExpectedVersion: version,
+	}
+	entry, err := b.wishlistUseCase.UpdateWishlistEntry(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	etag := formatETag(entry.Version)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(etagHeader, etag)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set header: %s", err)
+	}
+	return &pb.UpdateWishlistEntryResponse{
+		BookId:        entry.BookID,
+		Priority:      int32(entry.Priority),
+		QueuePosition: entry.QueuePosition,
+		Owned:         entry.Owned,
+		Etag:          etag,
+	}, nil
+}
+
+func (b *BookServerImpl) RecommendBooks(ctx context.Context, req *pb.RecommendBooksRequest) (*pb.RecommendBooksResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.RecommendBooksRequest{
+		UserID: claims.UserID,
+		Limit:  req.GetLimit(),
+	}
+	recommendations, err := b.recommendUseCase.RecommendBooks(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	res := make([]*pb.Recommendation, len(recommendations))
+	for i, r := range recommendations {
+		res[i] = &pb.Recommendation{
+			Book:        toBookPb(&r.Book),
+			Score:       r.Score,
+			Explanation: r.Explanation,
+			GeneratedAt: util.ToTimestampOrNil(&r.GeneratedAt),
+		}
+	}
+	return &pb.RecommendBooksResponse{
+		Recommendations: res,
+	}, nil
+}
+
+func (b *BookServerImpl) Sync(ctx context.Context, req *pb.SyncRequest) (*pb.SyncResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.SyncRequest{
+		UserID:    claims.UserID,
+		SyncToken: req.GetSyncToken(),
+		Histories: make([]usecase.SyncReadingHistoryChange, len(req.GetHistories())),
+		Tags:      make([]usecase.SyncTagChange, len(req.GetTags())),
+		Notes:     make([]usecase.SyncNoteChange, len(req.GetNotes())),
+	}
+	for i, h := range req.GetHistories() {
+		args.Histories[i] = usecase.SyncReadingHistoryChange{
+			BookID:    h.GetBookId(),
+			Status:    b.toReadingStatusEntity(h.GetStatus()),
+			StartDate: util.ToTimeOrNil(h.GetStartDate()),
+			EndDate:   util.ToTimeOrNil(h.GetEndDate()),
+			Priority:  int16(h.GetPriority()),
+			Owned:     h.GetOwned(),
+			UpdatedAt: util.ToTimeOrZero(h.GetUpdatedAt()),
+		}
+	}
+	for i, t := range req.GetTags() {
+		args.Tags[i] = usecase.SyncTagChange{
+			BookID:    t.GetBookId(),
+			Name:      t.GetName(),
+			Deleted:   t.GetDeleted(),
+			UpdatedAt: util.ToTimeOrZero(t.GetUpdatedAt()),
+		}
+	}
+	for i, n := range req.GetNotes() {
+		args.Notes[i] = usecase.SyncNoteChange{
+			ID:        n.GetId(),
+			BookID:    n.GetBookId(),
+			Body:      n.GetBody(),
+			Deleted:   n.GetDeleted(),
+			UpdatedAt: util.ToTimeOrZero(n.GetUpdatedAt()),
+		}
+	}
+	res, err := b.syncUseCase.Sync(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toSyncResponsePb(res), nil
+}
+
+func toSyncResponsePb(res *usecase.SyncResponse) *pb.SyncResponse {
+	books := make([]*pb.SyncedBook, len(res.Books))
+	for i, b := range res.Books {
+		books[i] = &pb.SyncedBook{
+			Book:      toBookPb(&b.Book),
+			UpdatedAt: timestamppb.New(b.UpdatedAt),
+		}
+	}
+	tags := make([]*pb.BookTag, len(res.Tags))
+	for i, t := range res.Tags {
+		tags[i] = &pb.BookTag{
+			BookId:    t.BookID,
+			Name:      t.Name,
+			UpdatedAt: timestamppb.New(t.UpdatedAt),
+		}
+	}
+	notes := make([]*pb.BookNote, len(res.Notes))
+	for i, n := range res.Notes {
+		notes[i] = &pb.BookNote{
+			Id:        n.ID,
+			BookId:    n.BookID,
+			Body:      n.Body,
+			CreatedAt: timestamppb.New(n.CreatedAt),
+			UpdatedAt: timestamppb.New(n.UpdatedAt),
+		}
+	}
+	tombstones := make([]*pb.SyncTombstone, len(res.Tombstones))
+	for i, t := range res.Tombstones {
+		tombstones[i] = &pb.SyncTombstone{
+			Type:      pb.SyncEntityType(t.Type),
+			Key:       t.Key,
+			DeletedAt: timestamppb.New(t.DeletedAt),
+		}
+	}
+	conflicts := make([]*pb.SyncConflict, len(res.Conflicts))
+	for i, c := range res.Conflicts {
+		conflicts[i] = &pb.SyncConflict{
+			Type: pb.SyncEntityType(c.Type),
+			Key:  c.Key,
+		}
+	}
+	return &pb.SyncResponse{
+		SyncToken:  res.SyncToken,
+		Books:      books,
+		Tags:       tags,
+		Notes:      notes,
+		Tombstones: tombstones,
+		Conflicts:  conflicts,
+	}
+}
+
+func (b *BookServerImpl) WatchLibrary(req *pb.WatchLibraryRequest, stream pb.BookService_WatchLibraryServer) error {
+	ctx := stream.Context()
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return err
+	}
+
+	args := usecase.WatchLibraryRequest{
+		UserID:      claims.UserID,
+		ResumeToken: req.GetResumeToken(),
+	}
+	err = b.watchLibraryUseCase.WatchLibrary(ctx, args, func(e entity.LibraryEvent) error {
+		return stream.Send(toLibraryEventPb(e))
+	})
+	if err != nil {
+		// クライアントが切断した場合はエラーにしない
+		if ctx.Err() != nil {
+			return nil
+		}
+		return gRPCStatusError(ctx, err)
+	}
+	return nil
+}
+
+func toLibraryEventPb(e entity.LibraryEvent) *pb.LibraryEvent {
+	res := &pb.LibraryEvent{
+		Type:           pb.LibraryEventType(e.Type),
+		BookId:         e.BookID,
+		Title:          e.Title,
+		Status:         pb.ReadingStatus(e.Status),
+		PreviousStatus: pb.ReadingStatus(e.PreviousStatus),
+		Priority:       int32(e.Priority),
+		Owned:          e.Owned,
+		ResumeToken:    e.ResumeToken,
+	}
+	// LibrarySyncedは発生時刻を持たない
+	if !e.OccurredAt.IsZero() {
+		res.OccurredAt = util.ToTimestampOrNil(&e.OccurredAt)
+	}
+	return res
+}
+
+func toReviewedBookPb(book *entity.ReviewedBook) *pb.ReviewedBook {
+	if book == nil {
+		return nil
+	}
+	return &pb.ReviewedBook{
+		Id:            book.ID,
+		Title:         book.Title,
+		AuthorName:    book.AuthorName,
+		CoverImageUrl: book.CoverImageURL,
+		PageCount:     book.PageCount,
+		StartDate:     util.ToTimestampOrNil(book.StartDate),
+		EndDate:       util.ToTimestampOrNil(book.EndDate),
+	}
+}
+
+func toNameCountsPb(src []usecase.NameCount) []*pb.NameCount {
+	res := make([]*pb.NameCount, len(src))
+	for i, s := range src {
+		res[i] = &pb.NameCount{
+			Name:  s.Name,
+			Count: s.Count,
+		}
+	}
+	return res
+}
@@ -3,23 +3,26 @@
 package server
 
 import (
+	"context"
 	"github.com/stretchr/testify/require"
+	"html/template"
 	sqlc "readly/db/sqlc"
-	"readly/env"
 	"readly/repository"
-	"readly/service/auth"
-	"readly/testdata"
+	"readly/service/event"
+	"readly/service/report"
 	"readly/usecase"
 	"testing"
 	"time"
 )
 
+// fakeCoverFetcher テストから外部に接続しないよう、表紙は取得せずにプレースホルダーを表示させる
+type fakeCoverFetcher struct{}
+
+func (fakeCoverFetcher) Fetch(context.Context, string) (template.URL, error) {
+	return "", report.ErrInvalidCover
+}
+
 func NewTestBookServer(t *testing.T) *BookServerImpl {
-	config := env.Config{
-		TokenSymmetricKey:    testdata.RandomString(32),
-		AccessTokenDuration:  time.Minute,
-		RefreshTokenDuration: time.Hour,
-	}
 	fa := sqlc.FakeAdapter{}
 	db, q := fa.Connect("", "")
 	transaction := repository.New(db)
@@ -27,16 +30,62 @@ func NewTestBookServer(t *testing.T) *BookServerImpl {
 	userRepo := repository.NewUserRepository(q)
 	bookRepo := repository.NewBookRepository(q)
 	readingHistoryRepo := repository.NewReadingHistoryRepository(q)
+	readingActivityRepo := repository.NewReadingActivityRepository(q)
+	feedRepo := repository.NewFeedRepository(q)
+	outboxRepo := repository.NewOutboxRepository(q)
+	tombstoneRepo := repository.NewSyncTombstoneRepository(q)
+	readingStatsRepo := repository.NewReadingStatsRepository(q)
+	catalogRepo := repository.NewCatalogRepository(q)
 
-	maker, err := auth.NewPasetoMaker(config.TokenSymmetricKey)
+	registerBookUseCase := usecase.NewRegisterBookUseCase(transaction, bookRepo, readingHistoryRepo, readingActivityRepo, userRepo, feedRepo, outboxRepo, catalogRepo)
+	deleteBookUseCase := usecase.NewDeleteBookUseCase(transaction, bookRepo, readingHistoryRepo, userRepo, outboxRepo, tombstoneRepo)
+	updateBookUseCase := usecase.NewUpdateBookUseCase(transaction, bookRepo, catalogRepo)
+	readingStatsUseCase := usecase.NewGetReadingStatsUseCase(readingStatsRepo)
+	renderer, err := report.NewHTMLRenderer(fakeCoverFetcher{})
 	require.NoError(t, err)
-
-	registerBookUseCase := usecase.NewRegisterBookUseCase(transaction, bookRepo, readingHistoryRepo, userRepo)
-	deleteBookUseCase := usecase.NewDeleteBookUseCase(transaction, bookRepo, readingHistoryRepo, userRepo)
+	yearInReviewUseCase := usecase.NewGenerateYearInReviewUseCase(readingStatsRepo, renderer)
+	streakUseCase := usecase.NewGetReadingStreakUseCase(userRepo, readingActivityRepo)
+	calendarUseCase := usecase.NewGetActivityCalendarUseCase(userRepo, readingActivityRepo)
+	queueUseCase := usecase.NewGetReadingQueueUseCase(readingHistoryRepo)
+	reorderUseCase := usecase.NewReorderReadingQueueUseCase(transaction, readingHistoryRepo)
+	popNextUseCase := usecase.NewPopNextBookUseCase(transaction, readingHistoryRepo, readingActivityRepo, feedRepo, outboxRepo)
+	wishlistUseCase := usecase.NewUpdateWishlistEntryUseCase(transaction, readingHistoryRepo, outboxRepo)
+	libraryUseCase := usecase.NewGetLibraryUseCase(readingHistoryRepo)
+	spendingUseCase := usecase.NewGetSpendingSummaryUseCase(readingStatsRepo)
+	recommendationRepo := repository.NewRecommendationRepository(q)
+	recommendUseCase := usecase.NewRecommendBooksUseCase(transaction, recommendationRepo)
+	watchLibraryUseCase := usecase.NewWatchLibraryUseCase(outboxRepo, event.NewBus())
+	syncUseCase := usecase.NewSyncUseCase(
+		transaction,
+		readingHistoryRepo,
+		readingActivityRepo,
+		feedRepo,
+		outboxRepo,
+		repository.NewBookTagRepository(q),
+		repository.NewBookNoteRepository(q),
+		tombstoneRepo,
+	)
+	batchRegisterUseCase := usecase.NewBatchRegisterBooksUseCase(transaction, registerBookUseCase)
+	idempotencyUseCase := usecase.NewExecuteWithIdempotencyKeyUseCase(transaction, repository.NewIdempotencyKeyRepository(q), time.Hour)
 
 	return NewBookServer(
-		maker,
 		registerBookUseCase,
 		deleteBookUseCase,
+		updateBookUseCase,
+		readingStatsUseCase,
+		yearInReviewUseCase,
+		streakUseCase,
+		calendarUseCase,
+		queueUseCase,
+		reorderUseCase,
+		popNextUseCase,
+		wishlistUseCase,
+		libraryUseCase,
+		spendingUseCase,
+		recommendUseCase,
+		watchLibraryUseCase,
+		syncUseCase,
+		batchRegisterUseCase,
+		idempotencyUseCase,
 	)
 }
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"readly/entity"
+	"readly/pb"
+	"readly/usecase"
+)
+
+type CatalogServerImpl struct {
+	pb.UnimplementedCatalogServiceServer
+	duplicatesUseCase usecase.GetDuplicateCatalogEntriesUseCase
+	mergeUseCase      usecase.MergeCatalogEntryUseCase
+}
+
+func NewCatalogServer(
+	duplicatesUseCase usecase.GetDuplicateCatalogEntriesUseCase,
+	mergeUseCase usecase.MergeCatalogEntryUseCase,
+) *CatalogServerImpl {
+	return &CatalogServerImpl{
+		duplicatesUseCase: duplicatesUseCase,
+		mergeUseCase:      mergeUseCase,
+	}
+}
+
+func (c *CatalogServerImpl) ListDuplicateCatalogEntries(ctx context.Context, req *pb.ListDuplicateCatalogEntriesRequest) (*pb.ListDuplicateCatalogEntriesResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetDuplicateCatalogEntriesRequest{
+		Role: claims.Role,
+	}
+	if req.GetKind() != pb.CatalogKind_CATALOG_KIND_UNSPECIFIED {
+		kind := toCatalogKind(req.GetKind())
+		args.Kind = &kind
+	}
+	candidates, err := c.duplicatesUseCase.GetDuplicateCatalogEntries(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	res := &pb.ListDuplicateCatalogEntriesResponse{
+		Candidates: make([]*pb.DuplicateCandidate, len(candidates)),
+	}
+	for i, d := range candidates {
+		res.Candidates[i] = &pb.DuplicateCandidate{
+			Kind:           toCatalogKindPb(d.Kind),
+			NormalizedName: d.NormalizedName,
+			Names:          d.Names,
+		}
+	}
+	return res, nil
+}
+
+func (c *CatalogServerImpl) MergeCatalogEntry(ctx context.Context, req *pb.MergeCatalogEntryRequest) (*pb.MergeCatalogEntryResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.MergeCatalogEntryRequest{
+		Role:      claims.Role,
+		Kind:      toCatalogKind(req.GetKind()),
+		Source:    req.GetSource(),
+		Canonical: req.GetCanonical(),
+	}
+	res, err := c.mergeUseCase.MergeCatalogEntry(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.MergeCatalogEntryResponse{
+		Canonical:  res.Canonical,
+		MovedBooks: res.MovedBooks,
+	}, nil
+}
+
+func toCatalogKind(kind pb.CatalogKind) entity.CatalogKind {
+	switch kind {
+	case pb.CatalogKind_AUTHOR:
+		return entity.CatalogAuthor
+	case pb.CatalogKind_PUBLISHER:
+		return entity.CatalogPublisher
+	case pb.CatalogKind_GENRE:
+		return entity.CatalogGenre
+	default:
+		return ""
+	}
+}
+
+func toCatalogKindPb(kind entity.CatalogKind) pb.CatalogKind {
+	switch kind {
+	case entity.CatalogAuthor:
+		return pb.CatalogKind_AUTHOR
+	case entity.CatalogPublisher:
+		return pb.CatalogKind_PUBLISHER
+	case entity.CatalogGenre:
+		return pb.CatalogKind_GENRE
+	default:
+		return pb.CatalogKind_CATALOG_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,356 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"readly/entity"
+	"readly/pb"
+	"readly/usecase"
+	"readly/util"
+	"strings"
+)
+
+type ClubServerImpl struct {
+	pb.UnimplementedClubServiceServer
+	createUseCase     usecase.CreateClubUseCase
+	getUseCase        usecase.GetClubUseCase
+	inviteUseCase     usecase.InviteToClubUseCase
+	joinUseCase       usecase.JoinClubUseCase
+	leaveUseCase      usecase.LeaveClubUseCase
+	roleUseCase       usecase.UpdateClubMemberRoleUseCase
+	scheduleUseCase   usecase.SetClubScheduleUseCase
+	progressUseCase   usecase.UpdateClubProgressUseCase
+	postUseCase       usecase.PostClubDiscussionUseCase
+	discussionUseCase usecase.ListClubDiscussionUseCase
+}
+
+func NewClubServer(
+	createUseCase usecase.CreateClubUseCase,
+	getUseCase usecase.GetClubUseCase,
+	inviteUseCase usecase.InviteToClubUseCase,
+	joinUseCase usecase.JoinClubUseCase,
+	leaveUseCase usecase.LeaveClubUseCase,
+	roleUseCase usecase.UpdateClubMemberRoleUseCase,
+	scheduleUseCase usecase.SetClubScheduleUseCase,
+	progressUseCase usecase.UpdateClubProgressUseCase,
+	postUseCase usecase.PostClubDiscussionUseCase,
+	discussionUseCase usecase.ListClubDiscussionUseCase,
+) *ClubServerImpl {
+	return &ClubServerImpl{
+		createUseCase:     createUseCase,
+		getUseCase:        getUseCase,
+		inviteUseCase:     inviteUseCase,
+		joinUseCase:       joinUseCase,
+		leaveUseCase:      leaveUseCase,
+		roleUseCase:       roleUseCase,
+		scheduleUseCase:   scheduleUseCase,
+		progressUseCase:   progressUseCase,
+		postUseCase:       postUseCase,
+		discussionUseCase: discussionUseCase,
+	}
+}
+
+func (s *ClubServerImpl) CreateClub(ctx context.Context, req *pb.CreateClubRequest) (*pb.BookClub, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	err = util.StringValidator(name).ValidateLength(1, 255)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "name %s", err.Error())
+	}
+
+	args := usecase.CreateClubRequest{
+		UserID:      claims.UserID,
+		Name:        name,
+		Description: req.Description,
+	}
+	club, err := s.createUseCase.CreateClub(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toBookClubPb(club), nil
+}
+
+func (s *ClubServerImpl) GetClub(ctx context.Context, req *pb.GetClubRequest) (*pb.BookClub, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetClubRequest{
+		ClubID: req.GetClubId(),
+		UserID: claims.UserID,
+	}
+	club, err := s.getUseCase.GetClub(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toBookClubPb(club), nil
+}
+
+func (s *ClubServerImpl) InviteToClub(ctx context.Context, req *pb.InviteToClubRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.InviteToClubRequest{
+		ClubID:    req.GetClubId(),
+		InviterID: claims.UserID,
+		InviteeID: req.GetUserId(),
+	}
+	err = s.inviteUseCase.InviteToClub(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ClubServerImpl) JoinClub(ctx context.Context, req *pb.JoinClubRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.JoinClubRequest{
+		ClubID: req.GetClubId(),
+		UserID: claims.UserID,
+	}
+	err = s.joinUseCase.JoinClub(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ClubServerImpl) LeaveClub(ctx context.Context, req *pb.LeaveClubRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.LeaveClubRequest{
+		ClubID: req.GetClubId(),
+		UserID: claims.UserID,
+	}
+	err = s.leaveUseCase.LeaveClub(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ClubServerImpl) UpdateClubMemberRole(ctx context.Context, req *pb.UpdateClubMemberRoleRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.UpdateClubMemberRoleRequest{
+		ClubID:       req.GetClubId(),
+		UserID:       claims.UserID,
+		TargetUserID: req.GetUserId(),
+		Role:         entity.ClubRole(req.GetRole()),
+	}
+	err = s.roleUseCase.UpdateClubMemberRole(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ClubServerImpl) SetClubSchedule(ctx context.Context, req *pb.SetClubScheduleRequest) (*pb.SetClubScheduleResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	sections := make([]usecase.ClubSectionRequest, len(req.GetSections()))
+	for i, section := range req.GetSections() {
+		if section.GetDueDate() == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "due_date is required")
+		}
+		sections[i] = usecase.ClubSectionRequest{
+			Title:      section.GetTitle(),
+			Chapter:    section.Chapter,
+			TargetPage: section.TargetPage,
+			DueDate:    section.GetDueDate().AsTime(),
+		}
+	}
+	args := usecase.SetClubScheduleRequest{
+		ClubID:   req.GetClubId(),
+		UserID:   claims.UserID,
+		BookID:   req.GetBookId(),
+		Sections: sections,
+	}
+	result, err := s.scheduleUseCase.SetClubSchedule(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.SetClubScheduleResponse{
+		Sections: toClubSectionsPb(result),
+	}, nil
+}
+
+func (s *ClubServerImpl) UpdateClubProgress(ctx context.Context, req *pb.UpdateClubProgressRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.UpdateClubProgressRequest{
+		ClubID:      req.GetClubId(),
+		UserID:      claims.UserID,
+		CurrentPage: req.GetCurrentPage(),
+	}
+	err = s.progressUseCase.UpdateClubProgress(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ClubServerImpl) PostClubDiscussion(ctx context.Context, req *pb.PostClubDiscussionRequest) (*pb.ClubPost, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.GetBody())
+	err = util.StringValidator(body).ValidateLength(1, 4000)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "body %s", err.Error())
+	}
+
+	args := usecase.PostClubDiscussionRequest{
+		ClubID:    req.GetClubId(),
+		SectionID: req.GetSectionId(),
+		UserID:    claims.UserID,
+		Body:      body,
+	}
+	post, err := s.postUseCase.PostClubDiscussion(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toClubPostPb(post), nil
+}
+
+func (s *ClubServerImpl) ListClubDiscussion(ctx context.Context, req *pb.ListClubDiscussionRequest) (*pb.ListClubDiscussionResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListClubDiscussionRequest{
+		ClubID:    req.GetClubId(),
+		SectionID: req.GetSectionId(),
+		UserID:    claims.UserID,
+		Limit:     req.GetLimit(),
+		Offset:    req.GetOffset(),
+	}
+	posts, err := s.discussionUseCase.ListClubDiscussion(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	res := &pb.ListClubDiscussionResponse{
+		Posts: make([]*pb.ClubPost, len(posts)),
+	}
+	for i := range posts {
+		res.Posts[i] = toClubPostPb(&posts[i])
+	}
+	return res, nil
+}
+
+func toBookClubPb(club *entity.BookClub) *pb.BookClub {
+	res := &pb.BookClub{
+		Id:          club.ID,
+		Name:        club.Name,
+		Description: club.Description,
+		Sections:    toClubSectionsPb(club.Sections),
+		Members:     make([]*pb.ClubMember, len(club.Members)),
+		CreatedAt:   util.ToTimestampOrNil(&club.CreatedAt),
+	}
+	if club.CurrentBook != nil {
+		res.CurrentBook = toBookPb(club.CurrentBook)
+	}
+	for i, m := range club.Members {
+		res.Members[i] = &pb.ClubMember{
+			UserId:            m.UserID,
+			Name:              m.Name,
+			Role:              pb.ClubRole(m.Role),
+			CurrentPage:       m.CurrentPage,
+			ProgressUpdatedAt: util.ToTimestampOrNil(m.ProgressUpdatedAt),
+			JoinedAt:          util.ToTimestampOrNil(&m.JoinedAt),
+		}
+	}
+	return res
+}
+
+func toClubSectionsPb(sections []entity.ClubSection) []*pb.ClubSection {
+	res := make([]*pb.ClubSection, len(sections))
+	for i, s := range sections {
+		res[i] = &pb.ClubSection{
+			Id:         s.ID,
+			Title:      s.Title,
+			Chapter:    s.Chapter,
+			TargetPage: s.TargetPage,
+			DueDate:    util.ToTimestampOrNil(&s.DueDate),
+		}
+	}
+	return res
+}
+
+func toClubPostPb(post *entity.ClubPost) *pb.ClubPost {
+	return &pb.ClubPost{
+		Id:        post.ID,
+		UserId:    post.UserID,
+		UserName:  post.UserName,
+		Body:      post.Body,
+		CreatedAt: util.ToTimestampOrNil(&post.CreatedAt),
+	}
+}
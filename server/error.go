@@ -1,31 +1,97 @@
 package server
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"readly/usecase"
+	"strconv"
 )
 
-func gRPCStatusError(err error) error {
+const (
+	// errorDomain google.rpc.ErrorInfoのドメイン
+	errorDomain = "readly"
+	// errorCodeKey google.rpc.ErrorInfoのメタデータでusecase.ErrorCodeを持つキー
+	errorCodeKey = "code"
+	// unknownErrorCode usecase.Errorではないエラーのコード。controllerと同じ値にする
+	unknownErrorCode = -1
+)
+
+// gRPCStatusError ステータスのメッセージは開発者向けの英語のまま、利用者向けのメッセージはLocalizedMessageで返す
+func gRPCStatusError(ctx context.Context, err error) error {
 	var e *usecase.Error
 	if !errors.As(err, &e) {
 		return status.Errorf(codes.Internal, err.Error())
 	}
-	switch e.StatusCode {
+	st := status.New(gRPCCode(e.StatusCode), e.Message)
+	locale := newMetadataFrom(ctx).Locale
+	withDetails, detailsErr := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: fmt.Sprintf("E%d", e.ErrorCode),
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				errorCodeKey: strconv.Itoa(int(e.ErrorCode)),
+			},
+		},
+		&errdetails.LocalizedMessage{
+			Locale:  string(locale),
+			Message: e.LocalizedMessage(locale),
+		},
+	)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+func gRPCCode(sc usecase.StatusCode) codes.Code {
+	switch sc {
 	case usecase.BadRequest:
-		return status.Errorf(codes.InvalidArgument, e.Message)
+		return codes.InvalidArgument
 	case usecase.UnAuthorized:
-		return status.Errorf(codes.Unauthenticated, e.Message)
+		return codes.Unauthenticated
 	case usecase.Forbidden:
-		return status.Errorf(codes.PermissionDenied, e.Message)
+		return codes.PermissionDenied
 	case usecase.NotFound:
-		return status.Errorf(codes.NotFound, e.Message)
+		return codes.NotFound
 	case usecase.Conflict:
-		return status.Errorf(codes.AlreadyExists, e.Message)
+		return codes.AlreadyExists
+	case usecase.PreconditionFailed:
+		return codes.FailedPrecondition
 	case usecase.Internal:
-		return status.Errorf(codes.Internal, e.Message)
+		return codes.Internal
+	case usecase.Maintenance:
+		return codes.Unavailable
 	default:
-		return status.Errorf(codes.Internal, e.Message)
+		return codes.Internal
+	}
+}
+
+// errorCode ErrorInfoに含まれるusecase.ErrorCodeを返す。含まれていない場合はunknownErrorCode
+func errorCode(st *status.Status) int {
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != errorDomain {
+			continue
+		}
+		code, err := strconv.Atoi(info.GetMetadata()[errorCodeKey])
+		if err != nil {
+			continue
+		}
+		return code
+	}
+	return unknownErrorCode
+}
+
+// localizedMessage LocalizedMessageがない場合はステータスのメッセージを返す
+func localizedMessage(st *status.Status) string {
+	for _, detail := range st.Details() {
+		if m, ok := detail.(*errdetails.LocalizedMessage); ok {
+			return m.GetMessage()
+		}
 	}
+	return st.Message()
 }
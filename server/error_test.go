@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"readly/usecase"
+	"testing"
+)
+
+func TestGRPCStatusError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		want     int
+		wantText string
+	}{
+		{
+			name:     "email already registered",
+			err:      &usecase.Error{StatusCode: usecase.Conflict, ErrorCode: usecase.EmailAlreadyRegisteredError, Message: "email already registered"},
+			wantCode: codes.AlreadyExists,
+			want:     int(usecase.EmailAlreadyRegisteredError),
+			wantText: "このメールアドレスはすでに登録されています",
+		},
+		{
+			name:     "invalid password",
+			err:      &usecase.Error{StatusCode: usecase.BadRequest, ErrorCode: usecase.InvalidPasswordError, Message: "invalid password"},
+			wantCode: codes.InvalidArgument,
+			want:     int(usecase.InvalidPasswordError),
+			wantText: "メールアドレスまたはパスワードが正しくありません",
+		},
+		{
+			name:     "not a usecase error",
+			err:      errors.New("unexpected"),
+			wantCode: codes.Internal,
+			want:     unknownErrorCode,
+			wantText: "unexpected",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := status.Convert(gRPCStatusError(context.Background(), tc.err))
+			require.Equal(t, tc.wantCode, st.Code())
+			require.Equal(t, tc.want, errorCode(st))
+			require.Equal(t, tc.wantText, localizedMessage(st))
+
+			var e *usecase.Error
+			if !errors.As(tc.err, &e) {
+				require.Empty(t, st.Details())
+				return
+			}
+			require.Equal(t, e.Message, st.Message())
+			require.Len(t, st.Details(), 2)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			require.Equal(t, errorDomain, info.GetDomain())
+		})
+	}
+}
+
+func TestGRPCStatusError_Locale(t *testing.T) {
+	err := &usecase.Error{StatusCode: usecase.NotFound, ErrorCode: usecase.NotFoundBookError, Message: "book not found"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(acceptLanguageHeader, "en-US"))
+
+	st := status.Convert(gRPCStatusError(ctx, err))
+	require.Equal(t, "book not found", st.Message())
+	require.Equal(t, "Book not found", localizedMessage(st))
+	m, ok := st.Details()[1].(*errdetails.LocalizedMessage)
+	require.True(t, ok)
+	require.Equal(t, "en", m.GetLocale())
+}
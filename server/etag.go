@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/metadata"
+	"strconv"
+	"strings"
+)
+
+const (
+	etagHeader    = "etag"
+	ifMatchHeader = "if-match"
+)
+
+// formatETag HTTPのETagヘッダーと同じく引用符で囲む
+func formatETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag 弱いETagや引用符のない値も受け付ける
+func parseETag(etag string) (int64, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	v = strings.Trim(v, `"`)
+	version, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid etag: %s", etag)
+	}
+	return version, nil
+}
+
+// expectedVersion リクエストのetagを優先し、なければIf-Matchを使う。どちらもない場合と"*"の場合はnilを返す
+func expectedVersion(ctx context.Context, etag *string) (*int64, error) {
+	v := ""
+	if etag != nil {
+		v = *etag
+	} else if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(ifMatchHeader); len(values) > 0 {
+			v = values[0]
+		}
+	}
+	if v == "" || strings.TrimSpace(v) == "*" {
+		return nil, nil
+	}
+	version, err := parseETag(v)
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"testing"
+)
+
+func TestParseETag(t *testing.T) {
+	testCases := []struct {
+		name    string
+		etag    string
+		want    int64
+		wantErr bool
+	}{
+		{name: "quoted", etag: `"3"`, want: 3},
+		{name: "weak", etag: `W/"3"`, want: 3},
+		{name: "without quotes", etag: "3", want: 3},
+		{name: "not a number", etag: `"abc"`, wantErr: true},
+		{name: "zero", etag: `"0"`, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseETag(tc.etag)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+			require.Equal(t, `"3"`, formatETag(got))
+		})
+	}
+}
+
+func TestExpectedVersion(t *testing.T) {
+	etag := `"5"`
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ifMatchHeader, `"7"`))
+
+	// リクエストのetagを優先する
+	got, err := expectedVersion(ctx, &etag)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), *got)
+
+	got, err = expectedVersion(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), *got)
+
+	got, err = expectedVersion(context.Background(), nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	wildcard := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ifMatchHeader, "*"))
+	got, err = expectedVersion(wildcard, nil)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log/slog"
+	"net/http"
+	"readly/pb"
+)
+
+// HTTPErrorHandler Ginのコントローラーと同じ{code, message}の形式でエラーを返す。messageはAccept-Languageに合わせたもの
+// FailedPreconditionはIf-Matchが一致しなかったことを表すため、400ではなく412を返す
+func HTTPErrorHandler(
+	_ context.Context,
+	_ *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	_ *http.Request,
+	err error,
+) {
+	const fallback = `{"code": -1, "message": "failed to marshal error message"}`
+
+	st := status.Convert(err)
+	body := &pb.ErrorResponse{
+		Code:    int32(errorCode(st)),
+		Message: localizedMessage(st),
+		Details: st.Proto().GetDetails(),
+	}
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", marshaler.ContentType(body))
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", st.Message())
+	}
+
+	buf, err := marshaler.Marshal(body)
+	if err != nil {
+		slog.Error("failed to marshal error message", "message", st.Message(), "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, fallback)
+		return
+	}
+
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if st.Code() == codes.FailedPrecondition {
+		httpStatus = http.StatusPreconditionFailed
+	}
+	w.WriteHeader(httpStatus)
+	if _, err := w.Write(buf); err != nil {
+		slog.Error("failed to write error response", "error", err)
+	}
+}
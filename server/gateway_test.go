@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"readly/usecase"
+	"testing"
+)
+
+func TestHTTPErrorHandler(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		want     int
+		wantCode int
+		wantText string
+	}{
+		{name: "failed precondition is mapped to 412", err: status.Error(codes.FailedPrecondition, "modified"), want: http.StatusPreconditionFailed, wantCode: unknownErrorCode, wantText: "modified"},
+		{name: "invalid argument is mapped to 400", err: status.Error(codes.InvalidArgument, "invalid"), want: http.StatusBadRequest, wantCode: unknownErrorCode, wantText: "invalid"},
+		{name: "not found is mapped to 404", err: status.Error(codes.NotFound, "not found"), want: http.StatusNotFound, wantCode: unknownErrorCode, wantText: "not found"},
+		{
+			name:     "usecase error code is returned",
+			err:      gRPCStatusError(context.Background(), &usecase.Error{StatusCode: usecase.Conflict, ErrorCode: usecase.EmailAlreadyRegisteredError, Message: "email already registered"}),
+			want:     http.StatusConflict,
+			wantCode: int(usecase.EmailAlreadyRegisteredError),
+			wantText: "このメールアドレスはすでに登録されています",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux := runtime.NewServeMux()
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/v1/wishlist", nil)
+			HTTPErrorHandler(context.Background(), mux, &runtime.JSONPb{}, rec, req, tc.err)
+			require.Equal(t, tc.want, rec.Code)
+
+			var body struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tc.wantCode, body.Code)
+			require.Equal(t, tc.wantText, body.Message)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"google.golang.org/protobuf/proto"
+	"readly/usecase"
+)
+
+// executeIdempotently Idempotency-Keyが指定された場合は同じキーのリクエストを一度だけ実行し、保存したレスポンスをresに設定する。
+// 指定されていない場合はfnをそのまま実行する
+func executeIdempotently(
+	ctx context.Context,
+	useCase usecase.ExecuteWithIdempotencyKeyUseCase,
+	userID int64,
+	method string,
+	req proto.Message,
+	res proto.Message,
+	fn func(ctx context.Context) (proto.Message, error),
+) error {
+	key := newMetadataFrom(ctx).IdempotencyKey
+	if key == "" {
+		m, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		proto.Merge(res, m)
+		return nil
+	}
+
+	hash, err := hashRequest(req)
+	if err != nil {
+		return err
+	}
+	args := usecase.ExecuteWithIdempotencyKeyRequest{
+		UserID:      userID,
+		Key:         key,
+		Method:      method,
+		RequestHash: hash,
+	}
+	data, err := useCase.ExecuteWithIdempotencyKey(ctx, args, func(ctx context.Context) ([]byte, error) {
+		m, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(m)
+	})
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, res)
+}
+
+// hashRequest 同じ内容のリクエストが同じ値になるようにDeterministicでシリアライズする
+func hashRequest(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"readly/pb"
+	"readly/usecase"
+	"testing"
+)
+
+type fakeExecuteWithIdempotencyKeyUseCase struct {
+	saved map[string][]byte
+	reqs  []usecase.ExecuteWithIdempotencyKeyRequest
+}
+
+func (f *fakeExecuteWithIdempotencyKeyUseCase) ExecuteWithIdempotencyKey(
+	ctx context.Context,
+	req usecase.ExecuteWithIdempotencyKeyRequest,
+	fn func(ctx context.Context) ([]byte, error),
+) ([]byte, error) {
+	f.reqs = append(f.reqs, req)
+	if res, ok := f.saved[req.Key]; ok {
+		return res, nil
+	}
+	res, err := fn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	f.saved[req.Key] = res
+	return res, nil
+}
+
+func TestExecuteIdempotently(t *testing.T) {
+	useCase := &fakeExecuteWithIdempotencyKeyUseCase{saved: map[string][]byte{}}
+	req := &pb.RegisterBookRequest{Title: "title", Genres: []string{"novel"}}
+	calls := 0
+	fn := func(ctx context.Context) (proto.Message, error) {
+		calls++
+		return &pb.Book{Id: int64(calls), Title: "title"}, nil
+	}
+
+	// キーがない場合は毎回実行する
+	res := &pb.Book{}
+	require.NoError(t, executeIdempotently(context.Background(), useCase, 1, "RegisterBook", req, res, fn))
+	require.Equal(t, int64(1), res.Id)
+	require.Empty(t, useCase.reqs)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKeyHeader, "key"))
+	first := &pb.Book{}
+	require.NoError(t, executeIdempotently(ctx, useCase, 1, "RegisterBook", req, first, fn))
+	second := &pb.Book{}
+	require.NoError(t, executeIdempotently(ctx, useCase, 1, "RegisterBook", req, second, fn))
+
+	require.Equal(t, 2, calls)
+	require.True(t, proto.Equal(first, second))
+	require.Len(t, useCase.reqs, 2)
+	require.Equal(t, "key", useCase.reqs[0].Key)
+	require.Equal(t, useCase.reqs[0].RequestHash, useCase.reqs[1].RequestHash)
+}
+
+func TestHashRequest(t *testing.T) {
+	h1, err := hashRequest(&pb.RegisterBookRequest{Title: "title"})
+	require.NoError(t, err)
+	h2, err := hashRequest(&pb.RegisterBookRequest{Title: "title"})
+	require.NoError(t, err)
+	h3, err := hashRequest(&pb.RegisterBookRequest{Title: "other"})
+	require.NoError(t, err)
+
+	require.Len(t, h1, 64)
+	require.Equal(t, h1, h2)
+	require.NotEqual(t, h1, h3)
+}
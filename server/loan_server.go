@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"readly/entity"
+	"readly/pb"
+	"readly/usecase"
+	"readly/util"
+)
+
+type LoanServerImpl struct {
+	pb.UnimplementedLoanServiceServer
+	lendUseCase        usecase.LendBookUseCase
+	returnUseCase      usecase.ReturnBookUseCase
+	activeLoansUseCase usecase.ListActiveLoansUseCase
+	overdueUseCase     usecase.ListOverdueLoansUseCase
+}
+
+func NewLoanServer(
+	lendUseCase usecase.LendBookUseCase,
+	returnUseCase usecase.ReturnBookUseCase,
+	activeLoansUseCase usecase.ListActiveLoansUseCase,
+	overdueUseCase usecase.ListOverdueLoansUseCase,
+) *LoanServerImpl {
+	return &LoanServerImpl{
+		lendUseCase:        lendUseCase,
+		returnUseCase:      returnUseCase,
+		activeLoansUseCase: activeLoansUseCase,
+		overdueUseCase:     overdueUseCase,
+	}
+}
+
+func (l *LoanServerImpl) LendBook(ctx context.Context, req *pb.LendBookRequest) (*pb.Loan, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.LendBookRequest{
+		UserID:         claims.UserID,
+		BookID:         req.GetBookId(),
+		BorrowerName:   req.BorrowerName,
+		BorrowerUserID: req.BorrowerUserId,
+		LoanDate:       util.ToTimeOrNil(req.GetLoanDate()),
+		DueDate:        util.ToTimeOrNil(req.GetDueDate()),
+	}
+	loan, err := l.lendUseCase.LendBook(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toLoanPb(loan), nil
+}
+
+func (l *LoanServerImpl) ReturnBook(ctx context.Context, req *pb.ReturnBookRequest) (*pb.Loan, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ReturnBookRequest{
+		UserID:     claims.UserID,
+		LoanID:     req.GetLoanId(),
+		ReturnDate: util.ToTimeOrNil(req.GetReturnDate()),
+	}
+	loan, err := l.returnUseCase.ReturnBook(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return toLoanPb(loan), nil
+}
+
+func (l *LoanServerImpl) ListActiveLoans(ctx context.Context, _ *pb.ListActiveLoansRequest) (*pb.ListActiveLoansResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListActiveLoansRequest{
+		UserID: claims.UserID,
+	}
+	loans, err := l.activeLoansUseCase.ListActiveLoans(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.ListActiveLoansResponse{
+		Loans: toLoansPb(loans),
+	}, nil
+}
+
+func (l *LoanServerImpl) ListOverdueLoans(ctx context.Context, _ *pb.ListOverdueLoansRequest) (*pb.ListOverdueLoansResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListOverdueLoansRequest{
+		UserID: claims.UserID,
+	}
+	loans, err := l.overdueUseCase.ListOverdueLoans(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.ListOverdueLoansResponse{
+		Loans: toLoansPb(loans),
+	}, nil
+}
+
+func toLoanPb(loan *entity.Loan) *pb.Loan {
+	return &pb.Loan{
+		Id:             loan.ID,
+		Book:           toBookPb(&loan.Book),
+		BorrowerName:   loan.BorrowerName,
+		BorrowerUserId: loan.BorrowerUserID,
+		LoanDate:       util.ToTimestampOrNil(&loan.LoanDate),
+		DueDate:        util.ToTimestampOrNil(loan.DueDate),
+		ReturnDate:     util.ToTimestampOrNil(loan.ReturnDate),
+		Overdue:        loan.Overdue,
+	}
+}
+
+func toLoansPb(loans []entity.Loan) []*pb.Loan {
+	res := make([]*pb.Loan, len(loans))
+	for i := range loans {
+		res[i] = toLoanPb(&loans[i])
+	}
+	return res
+}
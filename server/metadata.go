@@ -4,21 +4,32 @@ import (
 	"context"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"net"
+	"readly/util"
+	"strings"
 )
 
 const (
 	grpcGatewayUserAgentHeader = "grpcgateway-user-agent"
 	userAgentHeader            = "user-agent"
 	xForwardedForHeader        = "x-forwarded-for"
+	idempotencyKeyHeader       = "idempotency-key"
+	acceptLanguageHeader       = "accept-language"
 )
 
 type Metadata struct {
-	UserAgent string
-	IPAddress string
+	UserAgent      string
+	IPAddress      string
+	IdempotencyKey string
+	Locale         util.Locale
+	// X-Forwarded-Forのすべての値をカンマ区切りで連結したもの
+	ForwardedFor string
+	// 直接接続してきた相手のアドレス。gRPC-Gateway経由の場合はゲートウェイのアドレス
+	PeerAddress string
 }
 
 func newMetadataFrom(ctx context.Context) *Metadata {
-	meta := &Metadata{}
+	meta := &Metadata{Locale: util.DefaultLocale}
 
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		if userAgents := md.Get(grpcGatewayUserAgentHeader); len(userAgents) > 0 {
@@ -28,14 +39,51 @@ func newMetadataFrom(ctx context.Context) *Metadata {
 		}
 		if ipAddresses := md.Get(xForwardedForHeader); len(ipAddresses) > 0 {
 			meta.IPAddress = ipAddresses[0]
+			meta.ForwardedFor = strings.Join(ipAddresses, ", ")
+		}
+		if keys := md.Get(idempotencyKeyHeader); len(keys) > 0 {
+			meta.IdempotencyKey = keys[0]
+		}
+		if languages := md.Get(acceptLanguageHeader); len(languages) > 0 {
+			meta.Locale = util.ParseLocale(strings.Join(languages, ","))
 		}
 	}
 
-	if meta.IPAddress == "" {
-		if ip, ok := peer.FromContext(ctx); ok {
-			meta.IPAddress = ip.Addr.String()
+	if p, ok := peer.FromContext(ctx); ok {
+		meta.PeerAddress = p.Addr.String()
+		if meta.IPAddress == "" {
+			meta.IPAddress = meta.PeerAddress
 		}
 	}
 
 	return meta
 }
+
+// ClientIP レート制限に使うクライアントのIPアドレス。
+// X-Forwarded-Forの先頭は呼び出し元が自由に指定できるため、同じホストで動くgRPC-Gatewayから転送された場合に限り
+// ゲートウェイが末尾に追加した接続元のアドレスを使い、それ以外は直接接続してきた相手のアドレスを使う
+func (m *Metadata) ClientIP() string {
+	peerIP := hostOnly(m.PeerAddress)
+	if m.ForwardedFor != "" && (peerIP == "" || isLoopback(peerIP)) {
+		entries := strings.Split(m.ForwardedFor, ",")
+		return hostOnly(entries[len(entries)-1])
+	}
+	if peerIP != "" {
+		return peerIP
+	}
+	return hostOnly(m.IPAddress)
+}
+
+// hostOnly ポート番号を除いたアドレス
+func hostOnly(address string) string {
+	address = strings.TrimSpace(address)
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
+
+func isLoopback(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
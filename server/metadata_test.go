@@ -4,6 +4,9 @@ import (
 	"context"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"readly/util"
 	"testing"
 )
 
@@ -38,8 +41,10 @@ func TestNewMetadataFrom(t *testing.T) {
 				"PostmanRuntime/7.43.0",
 				"127.0.0.1"),
 			want: &Metadata{
-				UserAgent: "PostmanRuntime/7.43.0",
-				IPAddress: "127.0.0.1",
+				UserAgent:    "PostmanRuntime/7.43.0",
+				IPAddress:    "127.0.0.1",
+				Locale:       util.DefaultLocale,
+				ForwardedFor: "127.0.0.1",
 			},
 		},
 		{
@@ -49,8 +54,10 @@ func TestNewMetadataFrom(t *testing.T) {
 				"",
 				"127.0.0.1"),
 			want: &Metadata{
-				UserAgent: "grpc-node-js/1.11.0-postman.1",
-				IPAddress: "127.0.0.1",
+				UserAgent:    "grpc-node-js/1.11.0-postman.1",
+				IPAddress:    "127.0.0.1",
+				Locale:       util.DefaultLocale,
+				ForwardedFor: "127.0.0.1",
 			},
 		},
 	}
@@ -63,3 +70,60 @@ func TestNewMetadataFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMetadataFrom_IdempotencyKey(t *testing.T) {
+	md := metadata.Pairs(idempotencyKeyHeader, "7b3c8e2a-key")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	got := newMetadataFrom(ctx)
+	require.Equal(t, "7b3c8e2a-key", got.IdempotencyKey)
+}
+
+func TestNewMetadataFrom_Locale(t *testing.T) {
+	md := metadata.Pairs(acceptLanguageHeader, "en-US,en;q=0.9")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	require.Equal(t, util.English, newMetadataFrom(ctx).Locale)
+
+	require.Equal(t, util.Japanese, newMetadataFrom(context.Background()).Locale)
+}
+
+func TestMetadata_ClientIP(t *testing.T) {
+	testCases := []struct {
+		name         string
+		ipAddress    string
+		forwardedFor string
+		peerAddress  string
+		want         string
+	}{
+		{name: "ip address only", ipAddress: "127.0.0.1", want: "127.0.0.1"},
+		{name: "ip address with port", ipAddress: "127.0.0.1:54321", want: "127.0.0.1"},
+		{name: "ipv6 address with port", ipAddress: "[::1]:54321", want: "::1"},
+		{name: "direct grpc caller", peerAddress: "203.0.113.1:54321", want: "203.0.113.1"},
+		{
+			name:         "forwarded by gateway uses the address appended by the gateway",
+			forwardedFor: "198.51.100.7, 203.0.113.1",
+			peerAddress:  "127.0.0.1:40000",
+			want:         "203.0.113.1",
+		},
+		{
+			name:         "forwarded for sent by direct grpc caller is ignored",
+			forwardedFor: "198.51.100.7",
+			peerAddress:  "203.0.113.1:54321",
+			want:         "203.0.113.1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := &Metadata{IPAddress: tc.ipAddress, ForwardedFor: tc.forwardedFor, PeerAddress: tc.peerAddress}
+			require.Equal(t, tc.want, meta.ClientIP())
+		})
+	}
+}
+
+func TestNewMetadataFrom_ClientIPFromGateway(t *testing.T) {
+	// 呼び出し元が送ったX-Forwarded-Forの後ろにゲートウェイが接続元のアドレスを追加する
+	md := metadata.Pairs(xForwardedForHeader, "198.51.100.7", xForwardedForHeader, "10.0.0.1, 203.0.113.1")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 40000}})
+	require.Equal(t, "203.0.113.1", newMetadataFrom(ctx).ClientIP())
+}
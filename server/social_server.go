@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"readly/entity"
+	"readly/env"
+	"readly/middleware"
+	"readly/pb"
+	"readly/service/auth"
+	"readly/usecase"
+	"readly/util"
+	"time"
+)
+
+const cacheControlHeader = "cache-control"
+
+type SocialServerImpl struct {
+	pb.UnimplementedSocialServiceServer
+	config           env.Config
+	followUseCase    usecase.FollowUserUseCase
+	unfollowUseCase  usecase.UnfollowUserUseCase
+	followersUseCase usecase.ListFollowersUseCase
+	followingUseCase usecase.ListFollowingUseCase
+	feedUseCase      usecase.GetFeedUseCase
+	profileUseCase   usecase.GetPublicProfileUseCase
+	profileLimiter   *middleware.RateLimiter
+}
+
+func NewSocialServer(
+	config env.Config,
+	followUseCase usecase.FollowUserUseCase,
+	unfollowUseCase usecase.UnfollowUserUseCase,
+	followersUseCase usecase.ListFollowersUseCase,
+	followingUseCase usecase.ListFollowingUseCase,
+	feedUseCase usecase.GetFeedUseCase,
+	profileUseCase usecase.GetPublicProfileUseCase,
+) *SocialServerImpl {
+	return &SocialServerImpl{
+		config:           config,
+		followUseCase:    followUseCase,
+		unfollowUseCase:  unfollowUseCase,
+		followersUseCase: followersUseCase,
+		followingUseCase: followingUseCase,
+		feedUseCase:      feedUseCase,
+		profileUseCase:   profileUseCase,
+		profileLimiter:   middleware.NewRateLimiter(config.PublicProfileRateLimit, time.Minute),
+	}
+}
+
+func (s *SocialServerImpl) FollowUser(ctx context.Context, req *pb.FollowUserRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.FollowUserRequest{
+		FollowerID: claims.UserID,
+		FolloweeID: req.GetUserId(),
+	}
+	err = s.followUseCase.FollowUser(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SocialServerImpl) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.UnfollowUserRequest{
+		FollowerID: claims.UserID,
+		FolloweeID: req.GetUserId(),
+	}
+	err = s.unfollowUseCase.UnfollowUser(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SocialServerImpl) ListFollowers(ctx context.Context, req *pb.ListFollowersRequest) (*pb.ListFollowersResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListFollowsRequest{
+		UserID: targetUserID(claims, req.GetUserId()),
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+	users, err := s.followersUseCase.ListFollowers(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.ListFollowersResponse{
+		Users: toFollowUsersPb(users),
+	}, nil
+}
+
+func (s *SocialServerImpl) ListFollowing(ctx context.Context, req *pb.ListFollowingRequest) (*pb.ListFollowingResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListFollowsRequest{
+		UserID: targetUserID(claims, req.GetUserId()),
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+	users, err := s.followingUseCase.ListFollowing(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.ListFollowingResponse{
+		Users: toFollowUsersPb(users),
+	}, nil
+}
+
+func (s *SocialServerImpl) GetFeed(ctx context.Context, req *pb.GetFeedRequest) (*pb.GetFeedResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.GetFeedRequest{
+		UserID: claims.UserID,
+		Cursor: req.GetCursor(),
+		Limit:  req.GetLimit(),
+	}
+	feed, err := s.feedUseCase.GetFeed(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	events := make([]*pb.FeedEvent, len(feed.Events))
+	for i, e := range feed.Events {
+		events[i] = &pb.FeedEvent{
+			Id:        e.ID,
+			Type:      pb.FeedEventType(e.Type),
+			UserId:    e.UserID,
+			UserName:  e.UserName,
+			Book:      toBookPb(&e.Book),
+			CreatedAt: util.ToTimestampOrNil(&e.CreatedAt),
+		}
+	}
+	return &pb.GetFeedResponse{
+		Events:     events,
+		NextCursor: feed.NextCursor,
+	}, nil
+}
+
+func (s *SocialServerImpl) GetPublicProfile(ctx context.Context, req *pb.GetPublicProfileRequest) (*pb.GetPublicProfileResponse, error) {
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// 認証なしで呼び出せるため、IPアドレスごとに呼び出し回数を制限する
+	if !s.profileLimiter.Allow(newMetadataFrom(ctx).ClientIP()) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many requests")
+	}
+
+	args := usecase.GetPublicProfileRequest{
+		UserID: req.GetUserId(),
+		Limit:  req.GetLimit(),
+		Offset: req.GetOffset(),
+	}
+	profile, err := s.profileUseCase.GetPublicProfile(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	// 公開された情報のみのため共有キャッシュも許可する
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(s.config.PublicProfileCacheMaxAge.Seconds()))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(cacheControlHeader, cacheControl)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set header: %s", err)
+	}
+
+	books := make([]*pb.Book, len(profile.Books))
+	for i := range profile.Books {
+		books[i] = toBookPb(&profile.Books[i])
+	}
+	return &pb.GetPublicProfileResponse{
+		UserId: profile.UserID,
+		Name:   profile.Name,
+		Books:  books,
+	}, nil
+}
+
+// targetUserID user_idを省略した場合は自分自身を対象にする
+func targetUserID(claims *auth.Claims, userID int64) int64 {
+	if userID == 0 {
+		return claims.UserID
+	}
+	return userID
+}
+
+func toFollowUsersPb(users []entity.FollowUser) []*pb.FollowUser {
+	res := make([]*pb.FollowUser, len(users))
+	for i, u := range users {
+		res[i] = &pb.FollowUser{
+			Id:         u.ID,
+			Name:       u.Name,
+			FollowedAt: util.ToTimestampOrNil(&u.FollowedAt),
+		}
+	}
+	return res
+}
@@ -2,57 +2,43 @@ package server
 
 import (
 	"context"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"readly/entity"
 	"readly/env"
 	"readly/pb"
-	"readly/service/auth"
 	"readly/usecase"
-	"readly/util"
 )
 
 type UserServerImpl struct {
 	pb.UnimplementedUserServiceServer
 	config              env.Config
-	maker               auth.TokenMaker
 	signUpUseCase       usecase.SignUpUseCase
 	signInUseCase       usecase.SignInUseCase
 	refreshTokenUseCase usecase.RefreshAccessTokenUseCase
+	timezoneUseCase     usecase.UpdateTimezoneUseCase
+	privacyUseCase      usecase.UpdatePrivacySettingsUseCase
 }
 
 func NewUserServer(
 	config env.Config,
-	maker auth.TokenMaker,
 	signUpUseCase usecase.SignUpUseCase,
 	signInUseCase usecase.SignInUseCase,
 	refreshTokenUseCase usecase.RefreshAccessTokenUseCase,
+	timezoneUseCase usecase.UpdateTimezoneUseCase,
+	privacyUseCase usecase.UpdatePrivacySettingsUseCase,
 ) *UserServerImpl {
 	return &UserServerImpl{
 		config:              config,
-		maker:               maker,
 		signUpUseCase:       signUpUseCase,
 		signInUseCase:       signInUseCase,
 		refreshTokenUseCase: refreshTokenUseCase,
+		timezoneUseCase:     timezoneUseCase,
+		privacyUseCase:      privacyUseCase,
 	}
 }
 
 func (s *UserServerImpl) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.SignInResponse, error) {
-
-	validateFunc := func() error {
-		err := util.StringValidator(req.GetEmail()).ValidateEmail()
-		if err != nil {
-			return err
-		}
-		err = util.StringValidator(req.GetPassword()).ValidatePassword()
-		if err != nil {
-			return err
-		}
-		return nil
-	}
-
-	err := validateFunc()
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	if err := validate(ctx, req); err != nil {
+		return nil, err
 	}
 
 	meta := newMetadataFrom(ctx)
@@ -64,7 +50,7 @@ func (s *UserServerImpl) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb
 	}
 	result, err := s.signInUseCase.SignIn(ctx, args)
 	if err != nil {
-		return nil, gRPCStatusError(err)
+		return nil, gRPCStatusError(ctx, err)
 	}
 	return &pb.SignInResponse{
 		AccessToken:  result.AccessToken,
@@ -76,26 +62,8 @@ func (s *UserServerImpl) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb
 }
 
 func (s *UserServerImpl) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.SignUpResponse, error) {
-
-	validateFunc := func() error {
-		err := util.StringValidator(req.GetName()).ValidateUsername()
-		if err != nil {
-			return err
-		}
-		err = util.StringValidator(req.GetEmail()).ValidateEmail()
-		if err != nil {
-			return err
-		}
-		err = util.StringValidator(req.GetPassword()).ValidatePassword()
-		if err != nil {
-			return err
-		}
-		return nil
-	}
-
-	err := validateFunc()
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	if err := validate(ctx, req); err != nil {
+		return nil, err
 	}
 
 	meta := newMetadataFrom(ctx)
@@ -108,7 +76,7 @@ func (s *UserServerImpl) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb
 	}
 	result, err := s.signUpUseCase.SignUp(ctx, args)
 	if err != nil {
-		return nil, gRPCStatusError(err)
+		return nil, gRPCStatusError(ctx, err)
 	}
 	return &pb.SignUpResponse{
 		AccessToken:  result.AccessToken,
@@ -120,16 +88,95 @@ func (s *UserServerImpl) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb
 }
 
 func (s *UserServerImpl) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
 	args := usecase.RefreshAccessTokenRequest{
 		RefreshToken: req.RefreshToken,
 	}
 
 	result, err := s.refreshTokenUseCase.Refresh(ctx, args)
 	if err != nil {
-		return nil, gRPCStatusError(err)
+		return nil, gRPCStatusError(ctx, err)
 	}
 
 	return &pb.RefreshTokenResponse{
 		AccessToken: result.AccessToken,
 	}, nil
 }
+
+func (s *UserServerImpl) UpdateTimezone(ctx context.Context, req *pb.UpdateTimezoneRequest) (*pb.UpdateTimezoneResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.UpdateTimezoneRequest{
+		UserID:   claims.UserID,
+		Timezone: req.GetTimezone(),
+	}
+	result, err := s.timezoneUseCase.UpdateTimezone(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	return &pb.UpdateTimezoneResponse{
+		Timezone: result.Timezone,
+	}, nil
+}
+
+func (s *UserServerImpl) UpdatePrivacySettings(ctx context.Context, req *pb.UpdatePrivacySettingsRequest) (*pb.UpdatePrivacySettingsResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.UpdatePrivacySettingsRequest{
+		UserID: claims.UserID,
+	}
+	if req.ActivityVisibility != nil {
+		v := entity.Visibility(req.GetActivityVisibility())
+		args.ActivityVisibility = &v
+	}
+	if req.ProfileVisibility != nil {
+		v := entity.Visibility(req.GetProfileVisibility())
+		args.ProfileVisibility = &v
+	}
+	if req.LibraryVisibility != nil {
+		v := entity.Visibility(req.GetLibraryVisibility())
+		args.LibraryVisibility = &v
+	}
+	for _, shelf := range req.GetShelves() {
+		args.Shelves = append(args.Shelves, entity.ShelfVisibility{
+			Status:     entity.ReadingStatus(shelf.GetReadingStatus()),
+			Visibility: entity.Visibility(shelf.GetVisibility()),
+		})
+	}
+	result, err := s.privacyUseCase.UpdatePrivacySettings(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+
+	shelves := make([]*pb.ShelfVisibility, len(result.Shelves))
+	for i, shelf := range result.Shelves {
+		shelves[i] = &pb.ShelfVisibility{
+			ReadingStatus: pb.ReadingStatus(shelf.Status),
+			Visibility:    pb.Visibility(shelf.Visibility),
+		}
+	}
+	return &pb.UpdatePrivacySettingsResponse{
+		ActivityVisibility: pb.Visibility(result.ActivityVisibility),
+		ProfileVisibility:  pb.Visibility(result.ProfileVisibility),
+		LibraryVisibility:  pb.Visibility(result.LibraryVisibility),
+		Shelves:            shelves,
+	}, nil
+}
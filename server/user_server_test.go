@@ -31,20 +31,25 @@ func NewTestUserServer(t *testing.T) *UserServerImpl {
 
 	userRepo := repository.NewUserRepository(q)
 	sessionRepo := repository.NewSessionRepository(q)
+	shelfVisibilityRepo := repository.NewShelfVisibilityRepository(q)
+	outboxRepo := repository.NewOutboxRepository(q)
 
 	maker, err := auth.NewPasetoMaker(config.TokenSymmetricKey)
 	require.NoError(t, err)
 
-	signUpUseCase := usecase.NewSignUpUseCase(config, maker, transaction, sessionRepo, userRepo)
+	signUpUseCase := usecase.NewSignUpUseCase(config, maker, transaction, sessionRepo, userRepo, outboxRepo)
 	signInUseCase := usecase.NewSignInUseCase(config, maker, transaction, sessionRepo, userRepo)
-	refreshTokenUseCase := usecase.NewRefreshAccessTokenUseCase(config, maker, sessionRepo)
+	refreshTokenUseCase := usecase.NewRefreshAccessTokenUseCase(config, maker, sessionRepo, userRepo)
+	timezoneUseCase := usecase.NewUpdateTimezoneUseCase(userRepo)
+	privacyUseCase := usecase.NewUpdatePrivacySettingsUseCase(transaction, userRepo, shelfVisibilityRepo)
 
 	return NewUserServer(
 		config,
-		maker,
 		signUpUseCase,
 		signInUseCase,
 		refreshTokenUseCase,
+		timezoneUseCase,
+		privacyUseCase,
 	)
 }
 
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"readly/pb"
+	"readly/util"
+)
+
+var (
+	maxItemsMessage = util.Message{
+		util.English:  "must contain at most %d items",
+		util.Japanese: "%d件以下で指定してください",
+	}
+	gteMessage = util.Message{
+		util.English:  "must be greater than or equal to %d",
+		util.Japanese: "%d以上で指定してください",
+	}
+	lteMessage = util.Message{
+		util.English:  "must be less than or equal to %d",
+		util.Japanese: "%d以下で指定してください",
+	}
+	notBeforeMessage = util.Message{
+		util.English:  "must not be before %s",
+		util.Japanese: "%s以降の日時を指定してください",
+	}
+)
+
+type fieldViolation struct {
+	field string
+	err   *util.LocalizedError
+}
+
+// validate validate.protoのルールをリクエストに適用し、違反があればgoogle.rpc.BadRequestを付与したInvalidArgumentを返す
+// 違反の説明はリクエストのロケールで返し、ステータスのメッセージは英語のままにする
+func validate(ctx context.Context, msg proto.Message) error {
+	violations := validateMessage(msg.ProtoReflect(), "")
+	if len(violations) == 0 {
+		return nil
+	}
+	locale := newMetadataFrom(ctx).Locale
+	first := violations[0]
+	message := fmt.Sprintf("%s: %s", first.field, first.err.Error())
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.field,
+			Description: v.err.Localize(locale),
+		})
+	}
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(
+		badRequest,
+		&errdetails.LocalizedMessage{
+			Locale:  string(locale),
+			Message: fmt.Sprintf("%s: %s", first.field, first.err.Localize(locale)),
+		},
+	)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+	return st.Err()
+}
+
+func validateMessage(m protoreflect.Message, prefix string) []fieldViolation {
+	var violations []fieldViolation
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := string(fd.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		rules := fieldRules(fd)
+
+		if fd.IsMap() {
+			continue
+		}
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			if rules != nil && rules.MaxItems != nil && uint32(list.Len()) > rules.GetMaxItems() {
+				violations = append(violations, fieldViolation{path, util.NewLocalizedError(maxItemsMessage, rules.GetMaxItems())})
+			}
+			for j := 0; j < list.Len(); j++ {
+				itemPath := fmt.Sprintf("%s[%d]", path, j)
+				if fd.Kind() == protoreflect.MessageKind {
+					violations = append(violations, validateMessage(list.Get(j).Message(), itemPath)...)
+					continue
+				}
+				if err := validateValue(fd, rules, list.Get(j)); err != nil {
+					violations = append(violations, fieldViolation{itemPath, err})
+				}
+			}
+			continue
+		}
+		// optionalやメッセージ型で未指定の場合は検証しない
+		if fd.HasPresence() && !m.Has(fd) {
+			continue
+		}
+		if fd.Kind() == protoreflect.MessageKind {
+			if rules != nil && rules.GetNotBefore() != "" {
+				if err := validateNotBefore(m, fd, rules.GetNotBefore()); err != nil {
+					violations = append(violations, fieldViolation{path, err})
+				}
+			}
+			violations = append(violations, validateMessage(m.Get(fd).Message(), path)...)
+			continue
+		}
+		if err := validateValue(fd, rules, m.Get(fd)); err != nil {
+			violations = append(violations, fieldViolation{path, err})
+		}
+	}
+	return violations
+}
+
+func fieldRules(fd protoreflect.FieldDescriptor) *pb.FieldRules {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil || !proto.HasExtension(opts, pb.E_Rules) {
+		return nil
+	}
+	rules, _ := proto.GetExtension(opts, pb.E_Rules).(*pb.FieldRules)
+	return rules
+}
+
+func validateValue(fd protoreflect.FieldDescriptor, rules *pb.FieldRules, v protoreflect.Value) *util.LocalizedError {
+	if rules == nil {
+		return nil
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return validateString(rules, v.String())
+	case protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return validateInt(rules, v.Int())
+	default:
+		return nil
+	}
+}
+
+func validateString(rules *pb.FieldRules, s string) *util.LocalizedError {
+	validator := util.StringValidator(s)
+	var err error
+	if rules.MinLen != nil || rules.MaxLen != nil {
+		maxLen := math.MaxInt
+		if rules.MaxLen != nil {
+			maxLen = int(rules.GetMaxLen())
+		}
+		err = validator.ValidateLength(int(rules.GetMinLen()), maxLen)
+	}
+	if err == nil {
+		switch {
+		case rules.GetUrl():
+			err = validator.ValidateURL()
+		case rules.GetIsbn():
+			err = validator.ValidateISBN()
+		case rules.GetEmail():
+			err = validator.ValidateEmail()
+		case rules.GetUsername():
+			err = validator.ValidateUsername()
+		case rules.GetPassword():
+			err = validator.ValidatePassword()
+		}
+	}
+	var e *util.LocalizedError
+	if errors.As(err, &e) {
+		return e
+	}
+	return nil
+}
+
+func validateInt(rules *pb.FieldRules, n int64) *util.LocalizedError {
+	if rules.Gte != nil && n < rules.GetGte() {
+		return util.NewLocalizedError(gteMessage, rules.GetGte())
+	}
+	if rules.Lte != nil && n > rules.GetLte() {
+		return util.NewLocalizedError(lteMessage, rules.GetLte())
+	}
+	return nil
+}
+
+// validateNotBefore 比較対象のフィールドが未指定の場合は検証しない
+func validateNotBefore(m protoreflect.Message, fd protoreflect.FieldDescriptor, name string) *util.LocalizedError {
+	other := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if other == nil || !m.Has(other) {
+		return nil
+	}
+	t, ok := m.Get(fd).Message().Interface().(*timestamppb.Timestamp)
+	if !ok {
+		return nil
+	}
+	from, ok := m.Get(other).Message().Interface().(*timestamppb.Timestamp)
+	if !ok {
+		return nil
+	}
+	if t.AsTime().Before(from.AsTime()) {
+		return util.NewLocalizedError(notBeforeMessage, name)
+	}
+	return nil
+}
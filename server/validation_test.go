@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"readly/pb"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	now := time.Now()
+	validBook := func() *pb.RegisterBookRequest {
+		return &pb.RegisterBookRequest{
+			Title:         "ノルウェイの森",
+			Genres:        []string{"小説"},
+			CoverImageUrl: proto.String("https://example.com/cover.png"),
+			Isbn:          proto.String("9784101001548"),
+			StartDate:     timestamppb.New(now.Add(-time.Hour)),
+			EndDate:       timestamppb.New(now),
+			Priority:      3,
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		req    proto.Message
+		fields []string
+	}{
+		{
+			name: "valid",
+			req:  validBook(),
+		},
+		{
+			name: "empty title",
+			req: func() proto.Message {
+				req := validBook()
+				req.Title = ""
+				return req
+			}(),
+			fields: []string{"title"},
+		},
+		{
+			name: "title counts characters not bytes",
+			req: func() proto.Message {
+				req := validBook()
+				req.Title = strings.Repeat("森", 255)
+				return req
+			}(),
+		},
+		{
+			name: "invalid url and isbn",
+			req: func() proto.Message {
+				req := validBook()
+				req.CoverImageUrl = proto.String("cover.png")
+				req.Isbn = proto.String("9784101001549")
+				return req
+			}(),
+			fields: []string{"cover_image_url", "isbn"},
+		},
+		{
+			name: "end date before start date",
+			req: func() proto.Message {
+				req := validBook()
+				req.EndDate = timestamppb.New(now.Add(-2 * time.Hour))
+				return req
+			}(),
+			fields: []string{"end_date"},
+		},
+		{
+			name: "too many genres and empty genre",
+			req: func() proto.Message {
+				req := validBook()
+				req.Genres = make([]string, 11)
+				for i := range req.Genres {
+					req.Genres[i] = "小説"
+				}
+				req.Genres[1] = ""
+				return req
+			}(),
+			fields: []string{"genres", "genres[1]"},
+		},
+		{
+			name: "priority out of range",
+			req: func() proto.Message {
+				req := validBook()
+				req.Priority = 6
+				return req
+			}(),
+			fields: []string{"priority"},
+		},
+		{
+			name: "nested items",
+			req: &pb.BatchRegisterBooksRequest{
+				Items: []*pb.RegisterBookRequest{
+					validBook(),
+					{Title: ""},
+				},
+			},
+			fields: []string{"items[1].title"},
+		},
+		{
+			name:   "sign up",
+			req:    &pb.SignUpRequest{Name: "User123", Email: "invalid", Password: "Abcd1-^$*.@"},
+			fields: []string{"email"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validate(context.Background(), tc.req)
+			if len(tc.fields) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+
+			var fields []string
+			for _, detail := range st.Details() {
+				badRequest, ok := detail.(*errdetails.BadRequest)
+				if !ok {
+					continue
+				}
+				for _, v := range badRequest.GetFieldViolations() {
+					require.NotEmpty(t, v.GetDescription())
+					fields = append(fields, v.GetField())
+				}
+			}
+			require.Equal(t, tc.fields, fields)
+		})
+	}
+}
+
+func TestValidate_Locale(t *testing.T) {
+	req := &pb.RegisterBookRequest{Title: "ノルウェイの森", Priority: 6}
+
+	testCases := []struct {
+		name           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "japanese by default", want: "priority: 5以下で指定してください"},
+		{name: "english", acceptLanguage: "en", want: "priority: must be less than or equal to 5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.acceptLanguage != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(acceptLanguageHeader, tc.acceptLanguage))
+			}
+			st := status.Convert(validate(ctx, req))
+			require.Equal(t, "priority: must be less than or equal to 5", st.Message())
+			require.Equal(t, tc.want, localizedMessage(st))
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"readly/entity"
+	"readly/pb"
+	"readly/usecase"
+	"readly/util"
+	"strings"
+)
+
+type WebhookServerImpl struct {
+	pb.UnimplementedWebhookServiceServer
+	createUseCase     usecase.CreateWebhookUseCase
+	listUseCase       usecase.ListWebhooksUseCase
+	deleteUseCase     usecase.DeleteWebhookUseCase
+	deliveriesUseCase usecase.ListWebhookDeliveriesUseCase
+}
+
+func NewWebhookServer(
+	createUseCase usecase.CreateWebhookUseCase,
+	listUseCase usecase.ListWebhooksUseCase,
+	deleteUseCase usecase.DeleteWebhookUseCase,
+	deliveriesUseCase usecase.ListWebhookDeliveriesUseCase,
+) *WebhookServerImpl {
+	return &WebhookServerImpl{
+		createUseCase:     createUseCase,
+		listUseCase:       listUseCase,
+		deleteUseCase:     deleteUseCase,
+		deliveriesUseCase: deliveriesUseCase,
+	}
+}
+
+func (s *WebhookServerImpl) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	url := strings.TrimSpace(req.GetUrl())
+	err = util.StringValidator(url).ValidateLength(1, 2048)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "url %s", err.Error())
+	}
+	eventTypes := make([]entity.DomainEventType, len(req.GetEventTypes()))
+	for i, t := range req.GetEventTypes() {
+		e, ok := toDomainEventType(t)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event type")
+		}
+		eventTypes[i] = e
+	}
+
+	args := usecase.CreateWebhookRequest{
+		UserID:     claims.UserID,
+		URL:        url,
+		EventTypes: eventTypes,
+	}
+	result, err := s.createUseCase.CreateWebhook(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &pb.CreateWebhookResponse{
+		Webhook: toWebhookPb(result.Webhook),
+		Secret:  result.Secret,
+	}, nil
+}
+
+func (s *WebhookServerImpl) ListWebhooks(ctx context.Context, _ *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.listUseCase.ListWebhooks(ctx, usecase.ListWebhooksRequest{UserID: claims.UserID})
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	res := &pb.ListWebhooksResponse{
+		Webhooks: make([]*pb.Webhook, len(webhooks)),
+	}
+	for i, w := range webhooks {
+		res.Webhooks[i] = toWebhookPb(w)
+	}
+	return res, nil
+}
+
+func (s *WebhookServerImpl) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.DeleteWebhookRequest{
+		UserID:    claims.UserID,
+		WebhookID: req.GetWebhookId(),
+	}
+	err = s.deleteUseCase.DeleteWebhook(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *WebhookServerImpl) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	claims, err := claimsFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	args := usecase.ListWebhookDeliveriesRequest{
+		UserID:    claims.UserID,
+		WebhookID: req.GetWebhookId(),
+		Limit:     req.GetLimit(),
+		Offset:    req.GetOffset(),
+	}
+	deliveries, err := s.deliveriesUseCase.ListWebhookDeliveries(ctx, args)
+	if err != nil {
+		return nil, gRPCStatusError(ctx, err)
+	}
+	res := &pb.ListWebhookDeliveriesResponse{
+		Deliveries: make([]*pb.WebhookDelivery, len(deliveries)),
+	}
+	for i, d := range deliveries {
+		eventType, _ := toWebhookEventTypePb(d.EventType)
+		res.Deliveries[i] = &pb.WebhookDelivery{
+			Id:                 d.ID,
+			EventId:            d.EventID,
+			EventType:          eventType,
+			Status:             pb.WebhookDeliveryStatus(d.Status),
+			Attempts:           d.Attempts,
+			LastResponseStatus: d.LastResponseStatus,
+			LastError:          d.LastError,
+			NextAttemptAt:      util.ToTimestampOrNil(d.NextAttemptAt),
+			DeliveredAt:        util.ToTimestampOrNil(d.DeliveredAt),
+			CreatedAt:          util.ToTimestampOrNil(&d.CreatedAt),
+		}
+	}
+	return res, nil
+}
+
+func toWebhookPb(w entity.Webhook) *pb.Webhook {
+	res := &pb.Webhook{
+		Id:         w.ID,
+		Url:        w.URL,
+		EventTypes: make([]pb.WebhookEventType, 0, len(w.EventTypes)),
+		CreatedAt:  util.ToTimestampOrNil(&w.CreatedAt),
+	}
+	for _, t := range w.EventTypes {
+		if e, ok := toWebhookEventTypePb(t); ok {
+			res.EventTypes = append(res.EventTypes, e)
+		}
+	}
+	return res
+}
+
+func toDomainEventType(t pb.WebhookEventType) (entity.DomainEventType, bool) {
+	switch t {
+	case pb.WebhookEventType_BOOK_REGISTERED:
+		return entity.BookRegistered, true
+	case pb.WebhookEventType_BOOK_UPDATED:
+		return entity.BookUpdated, true
+	case pb.WebhookEventType_BOOK_DELETED:
+		return entity.BookDeleted, true
+	case pb.WebhookEventType_STATUS_CHANGED:
+		return entity.StatusChanged, true
+	case pb.WebhookEventType_USER_SIGNED_UP:
+		return entity.UserSignedUp, true
+	default:
+		return "", false
+	}
+}
+
+func toWebhookEventTypePb(t entity.DomainEventType) (pb.WebhookEventType, bool) {
+	switch t {
+	case entity.BookRegistered:
+		return pb.WebhookEventType_BOOK_REGISTERED, true
+	case entity.BookUpdated:
+		return pb.WebhookEventType_BOOK_UPDATED, true
+	case entity.BookDeleted:
+		return pb.WebhookEventType_BOOK_DELETED, true
+	case entity.StatusChanged:
+		return pb.WebhookEventType_STATUS_CHANGED, true
+	case entity.UserSignedUp:
+		return pb.WebhookEventType_USER_SIGNED_UP, true
+	default:
+		return 0, false
+	}
+}
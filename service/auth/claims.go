@@ -3,21 +3,25 @@ package auth
 import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"readly/entity"
 	"time"
 )
 
 type Claims struct {
 	UserID int64 `json:"user_id"`
+	// 発行時のロール。ロールを変更した場合は再発行されるまで反映されない
+	Role entity.UserRole `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func NewClaims(userID int64, duration time.Duration) (Claims, error) {
+func NewClaims(userID int64, role entity.UserRole, duration time.Duration) (Claims, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return Claims{}, err
 	}
 	return Claims{
 		UserID: userID,
+		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ID:        id.String(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"readly/entity"
 	"time"
 )
 
@@ -20,8 +21,8 @@ func NewJWTMaker(secretKey string) (*JWTMaker, error) {
 	return &JWTMaker{secretKey: secretKey}, nil
 }
 
-func (j *JWTMaker) Generate(userID int64, duration time.Duration) (*Payload, error) {
-	claims, err := NewClaims(userID, duration)
+func (j *JWTMaker) Generate(userID int64, role entity.UserRole, duration time.Duration) (*Payload, error) {
+	claims, err := NewClaims(userID, role, duration)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/o1egl/paseto"
 	"golang.org/x/crypto/chacha20poly1305"
+	"readly/entity"
 	"time"
 )
 
@@ -23,8 +24,8 @@ func NewPasetoMaker(symmetricKey string) (*PasetoMaker, error) {
 	}, nil
 }
 
-func (p *PasetoMaker) Generate(userID int64, duration time.Duration) (*Payload, error) {
-	claims, err := NewClaims(userID, duration)
+func (p *PasetoMaker) Generate(userID int64, role entity.UserRole, duration time.Duration) (*Payload, error) {
+	claims, err := NewClaims(userID, role, duration)
 	if err != nil {
 		return nil, err
 	}
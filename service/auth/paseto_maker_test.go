@@ -3,6 +3,7 @@ package auth
 import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
+	"readly/entity"
 	"readly/testdata"
 	"testing"
 	"time"
@@ -39,7 +40,7 @@ func TestPasetoMaker(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			payload, err := maker.Generate(tc.userID, tc.duration)
+			payload, err := maker.Generate(tc.userID, entity.RoleLibrarian, tc.duration)
 			if tc.genErr == nil {
 				require.NoError(t, err)
 				require.NotEmpty(t, payload.ID)
@@ -54,6 +55,7 @@ func TestPasetoMaker(t *testing.T) {
 				require.NoError(t, err)
 				require.NotEmpty(t, c.ID)
 				require.Equal(t, userID, c.UserID)
+				require.Equal(t, entity.RoleLibrarian, c.Role)
 			} else {
 				require.Contains(t, err.Error(), tc.verifyErr.Error())
 				require.Nil(t, c)
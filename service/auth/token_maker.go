@@ -1,8 +1,11 @@
 package auth
 
-import "time"
+import (
+	"readly/entity"
+	"time"
+)
 
 type TokenMaker interface {
-	Generate(userID int64, duration time.Duration) (*Payload, error)
+	Generate(userID int64, role entity.UserRole, duration time.Duration) (*Payload, error)
 	Verify(token string) (*Claims, error)
 }
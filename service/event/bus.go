@@ -0,0 +1,90 @@
+package event
+
+import (
+	"context"
+	"readly/entity"
+	"sync"
+)
+
+const defaultSubscriptionBuffer = 64
+
+// Bus プロセス内でユーザーごとにイベントを配る。Sinkとしてリレーに登録する
+type Bus struct {
+	mu     sync.Mutex
+	subs   map[int64]map[*Subscription]struct{}
+	buffer int
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subs:   map[int64]map[*Subscription]struct{}{},
+		buffer: defaultSubscriptionBuffer,
+	}
+}
+
+// Subscription 受信が追いつかずバッファが溢れた場合はチャネルが閉じられる
+type Subscription struct {
+	bus    *Bus
+	userID int64
+	ch     chan entity.DomainEvent
+	closed bool
+}
+
+func (s *Subscription) Events() <-chan entity.DomainEvent {
+	return s.ch
+}
+
+// Close 複数回呼び出しても良い
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.remove(s)
+}
+
+func (b *Bus) Subscribe(userID int64) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &Subscription{
+		bus:    b,
+		userID: userID,
+		ch:     make(chan entity.DomainEvent, b.buffer),
+	}
+	if b.subs[userID] == nil {
+		b.subs[userID] = map[*Subscription]struct{}{}
+	}
+	b.subs[userID][sub] = struct{}{}
+	return sub
+}
+
+// Publish 購読者を待たない。ユーザーを特定できないイベントは捨てる
+func (b *Bus) Publish(_ context.Context, e entity.DomainEvent) error {
+	userID, err := e.UserID()
+	if err != nil || userID == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs[userID] {
+		select {
+		case sub.ch <- e:
+		default:
+			b.remove(sub)
+		}
+	}
+	return nil
+}
+
+// remove b.muを保持した状態で呼び出す
+func (b *Bus) remove(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	close(sub.ch)
+	delete(b.subs[sub.userID], sub)
+	if len(b.subs[sub.userID]) == 0 {
+		delete(b.subs, sub.userID)
+	}
+}
@@ -0,0 +1,70 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"testing"
+)
+
+func newBusTestEvent(t *testing.T, id int64, userID int64) entity.DomainEvent {
+	payload, err := json.Marshal(entity.BookRegisteredPayload{UserID: userID, BookID: id})
+	require.NoError(t, err)
+	return entity.DomainEvent{ID: id, Type: entity.BookRegistered, Payload: payload}
+}
+
+func TestBus_Publish(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe(1)
+	defer sub.Close()
+	other := bus.Subscribe(2)
+	defer other.Close()
+
+	err := bus.Publish(context.Background(), newBusTestEvent(t, 10, 1))
+	require.NoError(t, err)
+
+	got := <-sub.Events()
+	require.Equal(t, int64(10), got.ID)
+	require.Empty(t, other.Events())
+}
+
+func TestBus_PublishWithoutUser(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe(1)
+	defer sub.Close()
+
+	err := bus.Publish(context.Background(), entity.DomainEvent{ID: 1, Type: entity.BookRegistered, Payload: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	require.Empty(t, sub.Events())
+}
+
+func TestBus_PublishOverflow(t *testing.T) {
+	bus := NewBus()
+	bus.buffer = 1
+	sub := bus.Subscribe(1)
+
+	require.NoError(t, bus.Publish(context.Background(), newBusTestEvent(t, 1, 1)))
+	require.NoError(t, bus.Publish(context.Background(), newBusTestEvent(t, 2, 1)))
+
+	got, ok := <-sub.Events()
+	require.True(t, ok)
+	require.Equal(t, int64(1), got.ID)
+	_, ok = <-sub.Events()
+	require.False(t, ok)
+
+	// 溢れた後のCloseは何もしない
+	sub.Close()
+	require.Empty(t, bus.subs)
+}
+
+func TestSubscription_Close(t *testing.T) {
+	bus := NewBus()
+	sub := bus.Subscribe(1)
+	sub.Close()
+	sub.Close()
+
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	require.NoError(t, bus.Publish(context.Background(), newBusTestEvent(t, 1, 1)))
+}
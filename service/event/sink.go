@@ -0,0 +1,39 @@
+package event
+
+import (
+	"context"
+	"log/slog"
+	"readly/entity"
+)
+
+// Sink 同じイベントが複数回届くことがあるため、実装はイベントのIDで冪等にする
+type Sink interface {
+	Publish(ctx context.Context, e entity.DomainEvent) error
+}
+
+type SinkFunc func(ctx context.Context, e entity.DomainEvent) error
+
+func (f SinkFunc) Publish(ctx context.Context, e entity.DomainEvent) error {
+	return f(ctx, e)
+}
+
+// LogSink 受け取ったイベントをログに出力する
+type LogSink struct {
+	logger *slog.Logger
+}
+
+func NewLogSink(logger *slog.Logger) *LogSink {
+	return &LogSink{
+		logger: logger,
+	}
+}
+
+func (s *LogSink) Publish(ctx context.Context, e entity.DomainEvent) error {
+	s.logger.InfoContext(ctx, "domain event published",
+		"id", e.ID,
+		"type", e.Type,
+		"payload", string(e.Payload),
+		"occurred_at", e.OccurredAt,
+	)
+	return nil
+}
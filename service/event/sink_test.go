@@ -0,0 +1,44 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"readly/entity"
+	"testing"
+	"time"
+)
+
+func TestLogSink_Publish(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewLogSink(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	e := entity.DomainEvent{
+		ID:         1,
+		Type:       entity.BookRegistered,
+		Payload:    json.RawMessage(`{"book_id":1}`),
+		OccurredAt: time.Now(),
+	}
+	err := sink.Publish(context.Background(), e)
+	require.NoError(t, err)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	require.Equal(t, float64(1), got["id"])
+	require.Equal(t, string(entity.BookRegistered), got["type"])
+	require.Equal(t, `{"book_id":1}`, got["payload"])
+}
+
+func TestSinkFunc_Publish(t *testing.T) {
+	var got entity.DomainEvent
+	sink := SinkFunc(func(_ context.Context, e entity.DomainEvent) error {
+		got = e
+		return nil
+	})
+	err := sink.Publish(context.Background(), entity.DomainEvent{ID: 2, Type: entity.UserSignedUp})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), got.ID)
+	require.Equal(t, entity.UserSignedUp, got.Type)
+}
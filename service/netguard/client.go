@@ -0,0 +1,60 @@
+package netguard
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress 接続先がサーバー内部のネットワークのアドレスに解決された
+var ErrForbiddenAddress = errors.New("netguard: destination resolves to a non-public address")
+
+// cgnat キャリアグレードNAT(RFC 6598)の共有アドレス空間
+var cgnat = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsPublicIP ループバック、プライベート、リンクローカル、未指定、CGNATのアドレスでない場合にtrueを返す。
+// IPv4射影IPv6アドレスはIPv4アドレスとして判定する
+func IsPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsUnspecified() ||
+		cgnat.Contains(ip))
+}
+
+// NewClient ユーザーが指定したURLに接続するためのクライアントを返す。
+// URLの検証後に名前解決の結果が変わる場合に備えて、実際に接続するアドレスをallowIPで検査する。
+// プロキシを経由せず、リダイレクトにも従わない
+func NewClient(timeout time.Duration, allowIP func(ip net.IP) bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !allowIP(ip) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package netguard
+
+import (
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	testCases := []struct {
+		ip       string
+		expected bool
+	}{
+		{ip: "93.184.216.34", expected: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{ip: "127.0.0.1", expected: false},
+		{ip: "10.1.2.3", expected: false},
+		{ip: "172.16.0.1", expected: false},
+		{ip: "192.168.1.1", expected: false},
+		{ip: "169.254.169.254", expected: false},
+		{ip: "100.64.0.1", expected: false},
+		{ip: "0.0.0.0", expected: false},
+		{ip: "::1", expected: false},
+		{ip: "fd00::1", expected: false},
+		{ip: "fe80::1", expected: false},
+		{ip: "::ffff:127.0.0.1", expected: false},
+		{ip: "::ffff:169.254.169.254", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			require.Equal(t, tc.expected, IsPublicIP(net.ParseIP(tc.ip)))
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	internalCalled := false
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		internalCalled = true
+	}))
+	defer internal.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer srv.Close()
+
+	t.Run("Reject host resolving to local address", func(t *testing.T) {
+		// URLの検証を通るホスト名でも、接続時に解決されたアドレスで拒否する
+		u, err := url.Parse(srv.URL)
+		require.NoError(t, err)
+		u.Host = "localhost:" + u.Port()
+
+		_, err = NewClient(time.Second, IsPublicIP).Get(u.String())
+		require.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+
+	t.Run("Do not follow redirect", func(t *testing.T) {
+		client := NewClient(time.Second, func(ip net.IP) bool { return true })
+		res, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusFound, res.StatusCode)
+		require.False(t, internalCalled)
+	})
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"readly/service/netguard"
+	"time"
+)
+
+const (
+	coverFetchTimeout = 5 * time.Second
+	// maxCoverSize HTMLが肥大化しないように、これより大きい表紙画像は埋め込まない
+	maxCoverSize = 1 << 20
+)
+
+// coverContentTypes 埋め込む画像の形式。スクリプトを含められるSVGは除外する
+var coverContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+var ErrInvalidCover = errors.New("report: cover is not an embeddable image")
+
+type CoverFetcher interface {
+	// Fetch 表紙画像を取得し、HTMLに埋め込めるdata URIとして返す
+	Fetch(ctx context.Context, url string) (template.URL, error)
+}
+
+type HTTPCoverFetcher struct {
+	client *http.Client
+}
+
+func NewHTTPCoverFetcher() *HTTPCoverFetcher {
+	return newHTTPCoverFetcher(netguard.IsPublicIP)
+}
+
+// newHTTPCoverFetcher 表紙のURLはユーザーが登録するため、サーバー内部のネットワークには接続しない。
+// allowIPはテストでループバックのサーバーから取得するために差し替える
+func newHTTPCoverFetcher(allowIP func(ip net.IP) bool) *HTTPCoverFetcher {
+	return &HTTPCoverFetcher{
+		client: netguard.NewClient(coverFetchTimeout, allowIP),
+	}
+}
+
+func (f *HTTPCoverFetcher) Fetch(ctx context.Context, url string) (template.URL, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", "readly-report")
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w: status %d", ErrInvalidCover, res.StatusCode)
+	}
+	contentType, _, err := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if err != nil || !coverContentTypes[contentType] {
+		return "", fmt.Errorf("%w: content type %q", ErrInvalidCover, res.Header.Get("Content-Type"))
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxCoverSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxCoverSize {
+		return "", fmt.Errorf("%w: larger than %d bytes", ErrInvalidCover, maxCoverSize)
+	}
+	// Content-Typeを検証したdata URIのため、html/templateのURLのエスケープを通さずに埋め込む
+	return template.URL("data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(body)), nil
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/require"
+	"html/template"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"readly/service/netguard"
+	"testing"
+)
+
+func newTestHTTPCoverFetcher() *HTTPCoverFetcher {
+	return newHTTPCoverFetcher(func(ip net.IP) bool { return true })
+}
+
+func TestHTTPCoverFetcher_Fetch(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/cover.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(png)
+		case "/cover.svg":
+			w.Header().Set("Content-Type", "image/svg+xml")
+			_, _ = w.Write([]byte("<svg></svg>"))
+		case "/large.png":
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(bytes.Repeat([]byte{0}, maxCoverSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	testCases := []struct {
+		name    string
+		fetcher *HTTPCoverFetcher
+		url     string
+		check   func(t *testing.T, cover template.URL, err error)
+	}{
+		{
+			name:    "Fetch cover as data URI",
+			fetcher: newTestHTTPCoverFetcher(),
+			url:     srv.URL + "/cover.png",
+			check: func(t *testing.T, cover template.URL, err error) {
+				require.NoError(t, err)
+				require.Equal(t, template.URL("data:image/png;base64,iVBORw0KGgo="), cover)
+			},
+		},
+		{
+			name:    "Fetch cover failure if content type is not allowed",
+			fetcher: newTestHTTPCoverFetcher(),
+			url:     srv.URL + "/cover.svg",
+			check: func(t *testing.T, cover template.URL, err error) {
+				require.ErrorIs(t, err, ErrInvalidCover)
+				require.Empty(t, cover)
+			},
+		},
+		{
+			name:    "Fetch cover failure if cover is too large",
+			fetcher: newTestHTTPCoverFetcher(),
+			url:     srv.URL + "/large.png",
+			check: func(t *testing.T, cover template.URL, err error) {
+				require.ErrorIs(t, err, ErrInvalidCover)
+				require.Empty(t, cover)
+			},
+		},
+		{
+			name:    "Fetch cover failure if cover is not found",
+			fetcher: newTestHTTPCoverFetcher(),
+			url:     srv.URL + "/missing.png",
+			check: func(t *testing.T, cover template.URL, err error) {
+				require.ErrorIs(t, err, ErrInvalidCover)
+				require.Empty(t, cover)
+			},
+		},
+		{
+			name:    "Fetch cover failure if host is local address",
+			fetcher: NewHTTPCoverFetcher(),
+			url:     srv.URL + "/cover.png",
+			check: func(t *testing.T, cover template.URL, err error) {
+				require.ErrorIs(t, err, netguard.ErrForbiddenAddress)
+				require.Empty(t, cover)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cover, err := tc.fetcher.Fetch(context.Background(), tc.url)
+			tc.check(t, cover, err)
+		})
+	}
+}
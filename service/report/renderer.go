@@ -0,0 +1,85 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"html/template"
+	"readly/entity"
+	"sync"
+)
+
+//go:embed template/*.html
+var templateFS embed.FS
+
+// maxConcurrentCoverFetches 表紙画像を同時に取得する数の上限
+const maxConcurrentCoverFetches = 4
+
+type Renderer interface {
+	RenderYearInReview(ctx context.Context, review entity.YearInReview) (string, error)
+}
+
+type HTMLRenderer struct {
+	yearInReview *template.Template
+	coverFetcher CoverFetcher
+}
+
+func NewHTMLRenderer(coverFetcher CoverFetcher) (*HTMLRenderer, error) {
+	t, err := template.ParseFS(templateFS, "template/year_in_review.html")
+	if err != nil {
+		return nil, err
+	}
+	return &HTMLRenderer{
+		yearInReview: t,
+		coverFetcher: coverFetcher,
+	}, nil
+}
+
+type yearInReviewData struct {
+	entity.YearInReview
+	// 書籍IDごとのdata URIの表紙画像。取得できなかった書籍はプレースホルダーを表示する
+	Covers map[int64]template.URL
+}
+
+// RenderYearInReview 表紙画像もdata URIとして埋め込み、外部リソースに依存しない単一のHTMLとして出力する
+func (r *HTMLRenderer) RenderYearInReview(ctx context.Context, review entity.YearInReview) (string, error) {
+	data := yearInReviewData{
+		YearInReview: review,
+		Covers:       r.fetchCovers(ctx, review.Books),
+	}
+	var buf bytes.Buffer
+	if err := r.yearInReview.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (r *HTMLRenderer) fetchCovers(ctx context.Context, books []entity.ReviewedBook) map[int64]template.URL {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, maxConcurrentCoverFetches)
+		covers = make(map[int64]template.URL, len(books))
+	)
+	for _, b := range books {
+		if b.CoverImageURL == nil || len(*b.CoverImageURL) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(id int64, url string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			cover, err := r.coverFetcher.Fetch(ctx, url)
+			// 表紙を取得できなくてもレポートは出力する
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			covers[id] = cover
+			mu.Unlock()
+		}(b.ID, *b.CoverImageURL)
+	}
+	wg.Wait()
+	return covers
+}
@@ -0,0 +1,106 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"html/template"
+	"readly/entity"
+	"testing"
+	"time"
+)
+
+type fakeCoverFetcher map[string]template.URL
+
+func (f fakeCoverFetcher) Fetch(_ context.Context, url string) (template.URL, error) {
+	cover, ok := f[url]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return cover, nil
+}
+
+func TestHTMLRenderer_RenderYearInReview(t *testing.T) {
+	cover := "https://example.com/cover.png"
+	dataURI := template.URL("data:image/png;base64,iVBORw0KGgo=")
+	renderer, err := NewHTMLRenderer(fakeCoverFetcher{cover: dataURI})
+	require.NoError(t, err)
+
+	pageCount := int32(320)
+	longest := entity.ReviewedBook{
+		ID:            1,
+		Title:         "<script>alert(1)</script>",
+		CoverImageURL: &cover,
+		PageCount:     &pageCount,
+	}
+	missingCover := "https://example.com/missing.png"
+	withoutCover := entity.ReviewedBook{
+		ID:            2,
+		Title:         "No cover",
+		CoverImageURL: &missingCover,
+	}
+
+	testCases := []struct {
+		name   string
+		review entity.YearInReview
+		check  func(t *testing.T, html string, err error)
+	}{
+		{
+			name: "Render year in review with books",
+			review: entity.YearInReview{
+				Year:           2024,
+				FinishedBooks:  1,
+				TotalPages:     320,
+				LongestBook:    &longest,
+				ShortestBook:   &longest,
+				FavoriteGenres: []entity.GenreCount{{Name: "Novel", Count: 1}},
+				TopMonth:       &entity.MonthCount{Month: time.March, Count: 1},
+				Books:          []entity.ReviewedBook{longest},
+			},
+			check: func(t *testing.T, html string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, html, "2024 Year in Review")
+				require.Contains(t, html, "March")
+				require.Contains(t, html, "Novel (1)")
+				require.Contains(t, html, "(320 pages)")
+				require.Contains(t, html, `src="`+string(dataURI)+`"`)
+				require.NotContains(t, html, cover)
+				require.NotContains(t, html, "<script>")
+				require.NotContains(t, html, "<link")
+			},
+		},
+		{
+			name: "Render placeholder if cover cannot be fetched",
+			review: entity.YearInReview{
+				Year:          2024,
+				FinishedBooks: 1,
+				Books:         []entity.ReviewedBook{withoutCover},
+			},
+			check: func(t *testing.T, html string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, html, `<div class="placeholder">No cover</div>`)
+				require.NotContains(t, html, "<img")
+				require.NotContains(t, html, missingCover)
+			},
+		},
+		{
+			name: "Render year in review without books",
+			review: entity.YearInReview{
+				Year: 2024,
+			},
+			check: func(t *testing.T, html string, err error) {
+				require.NoError(t, err)
+				require.Contains(t, html, "2024 Year in Review")
+				require.NotContains(t, html, "Top month")
+				require.NotContains(t, html, "<img")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			html, err := renderer.RenderYearInReview(context.Background(), tc.review)
+			tc.check(t, html, err)
+		})
+	}
+}
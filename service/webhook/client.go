@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"readly/service/netguard"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Readly-Signature"
+	EventHeader     = "X-Readly-Event"
+	DeliveryHeader  = "X-Readly-Delivery"
+)
+
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID int64
+	EventType  string
+	Body       []byte
+}
+
+type Client interface {
+	// Send 接続できた場合はステータスコードに関わらずエラーを返さない
+	Send(ctx context.Context, req Request) (int, error)
+}
+
+type HTTPClient struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewHTTPClient(timeout time.Duration) *HTTPClient {
+	return newHTTPClient(timeout, netguard.IsPublicIP)
+}
+
+// newHTTPClient allowIPはテストでループバックのサーバーに送信するために差し替える
+func newHTTPClient(timeout time.Duration, allowIP func(ip net.IP) bool) *HTTPClient {
+	return &HTTPClient{
+		// リダイレクト先には送信せず、リダイレクトのレスポンスを配信の結果とする
+		client: netguard.NewClient(timeout, allowIP),
+		now:    time.Now,
+	}
+}
+
+func (c *HTTPClient) Send(ctx context.Context, req Request) (int, error) {
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("User-Agent", "readly-webhook")
+	r.Header.Set(EventHeader, req.EventType)
+	r.Header.Set(DeliveryHeader, strconv.FormatInt(req.DeliveryID, 10))
+	r.Header.Set(SignatureHeader, Sign(req.Secret, c.now(), req.Body))
+
+	res, err := c.client.Do(r)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	return res.StatusCode, nil
+}
+
+// Sign 受信側で古いリクエストの再送を弾けるように、送信時刻も署名の対象に含める。
+// 形式は "t=<UNIX時刻>,v1=<HMAC-SHA256(secret, "<UNIX時刻>.<body>")の16進数>"
+func Sign(secret string, at time.Time, body []byte) string {
+	ts := strconv.FormatInt(at.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("t=%s,v1=%s", ts, hex.EncodeToString(mac.Sum(nil)))
+}
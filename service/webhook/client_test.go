@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"readly/service/netguard"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	body := []byte(`{"id":1}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	expected := "t=1700000000,v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	require.Equal(t, expected, Sign("secret", at, body))
+	require.NotEqual(t, expected, Sign("other", at, body))
+	require.NotEqual(t, expected, Sign("secret", at.Add(time.Second), body))
+}
+
+func TestHTTPClient_Send(t *testing.T) {
+	body := []byte(`{"id":1,"type":"book_registered"}`)
+	var received *http.Request
+	var receivedBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	at := time.Unix(1700000000, 0)
+	client := newTestHTTPClient()
+	client.now = func() time.Time { return at }
+
+	status, err := client.Send(context.Background(), Request{
+		URL:        srv.URL,
+		Secret:     "secret",
+		DeliveryID: 10,
+		EventType:  "book_registered",
+		Body:       body,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, status)
+	require.Equal(t, http.MethodPost, received.Method)
+	require.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	require.Equal(t, "book_registered", received.Header.Get(EventHeader))
+	require.Equal(t, "10", received.Header.Get(DeliveryHeader))
+	require.Equal(t, Sign("secret", at, body), received.Header.Get(SignatureHeader))
+	require.Equal(t, body, receivedBody)
+}
+
+func TestHTTPClient_SendReturnsStatusOfFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	status, err := newTestHTTPClient().Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusInternalServerError, status)
+}
+
+func TestHTTPClient_SendConnectionError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	_, err := newTestHTTPClient().Send(context.Background(), Request{URL: url, Body: []byte(`{}`)})
+	require.Error(t, err)
+}
+
+// newTestHTTPClient httptestのサーバーはループバックで待ち受けるため、すべてのアドレスへの接続を許可する
+func newTestHTTPClient() *HTTPClient {
+	return newHTTPClient(time.Second, func(ip net.IP) bool { return true })
+}
+
+func TestHTTPClient_SendRejectsHostResolvingToLocalAddress(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	// URLの検証を通るホスト名でも、接続時に解決されたアドレスで拒否する
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	u.Host = "localhost:" + u.Port()
+
+	_, err = NewHTTPClient(time.Second).Send(context.Background(), Request{URL: u.String(), Body: []byte(`{}`)})
+	require.ErrorIs(t, err, netguard.ErrForbiddenAddress)
+	require.False(t, called)
+}
+
+func TestHTTPClient_SendDoesNotFollowRedirect(t *testing.T) {
+	internalCalled := false
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		internalCalled = true
+	}))
+	defer internal.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusFound)
+	}))
+	defer srv.Close()
+
+	status, err := newTestHTTPClient().Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusFound, status)
+	require.False(t, internalCalled)
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+)
+
+func requireErrorCode(t *testing.T, err error, statusCode StatusCode, errorCode ErrorCode) {
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, statusCode, e.StatusCode)
+	require.Equal(t, errorCode, e.ErrorCode)
+}
+
+func TestAdmin(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	signInUseCase := newTestSignInUseCase(t)
+	refreshUseCase := newTestRefreshAccessTokenUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	listUsersUseCase := newTestListUsersUseCase(t)
+	disableUseCase := newTestSetUserDisabledUseCase(t)
+	revokeUseCase := newTestRevokeUserSessionsUseCase(t)
+	libraryUseCase := newTestGetUserLibraryUseCase(t)
+	deleteCatalogUseCase := newTestDeleteCatalogBookUseCase(t)
+	auditLogsUseCase := newTestListAuditLogsUseCase(t)
+
+	admin := signUpTestUser(t)
+	password := testdata.RandomString(16)
+	target, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: password,
+	})
+	require.NoError(t, err)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: target.UserID,
+		Title:  testdata.RandomString(10),
+		Genres: []string{testdata.RandomString(6)},
+		Status: entity.Reading,
+	})
+	require.NoError(t, err)
+
+	t.Run("Non admin is forbidden", func(t *testing.T) {
+		_, err := listUsersUseCase.ListUsers(context.Background(), ListUsersRequest{
+			ActorID: target.UserID,
+			Role:    entity.RoleLibrarian,
+		})
+		requireErrorCode(t, err, Forbidden, PermissionDeniedError)
+
+		err = deleteCatalogUseCase.DeleteCatalogBook(context.Background(), DeleteCatalogBookRequest{
+			ActorID: target.UserID,
+			Role:    entity.RoleLibrarian,
+			BookID:  book.ID,
+		})
+		requireErrorCode(t, err, Forbidden, PermissionDeniedError)
+	})
+
+	t.Run("Search users by email", func(t *testing.T) {
+		users, err := listUsersUseCase.ListUsers(context.Background(), ListUsersRequest{
+			ActorID: admin.UserID,
+			Role:    entity.RoleAdmin,
+			Query:   &target.Email,
+		})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		require.Equal(t, target.UserID, users[0].ID)
+		require.Equal(t, entity.RoleUser, users[0].Role)
+		require.Nil(t, users[0].DisabledAt)
+	})
+
+	t.Run("View user's library", func(t *testing.T) {
+		books, err := libraryUseCase.GetUserLibrary(context.Background(), GetUserLibraryRequest{
+			ActorID: admin.UserID,
+			Role:    entity.RoleAdmin,
+			UserID:  target.UserID,
+		})
+		require.NoError(t, err)
+		require.Len(t, books, 1)
+		require.Equal(t, book.ID, books[0].ID)
+	})
+
+	t.Run("Cannot disable yourself", func(t *testing.T) {
+		_, err := disableUseCase.SetUserDisabled(context.Background(), SetUserDisabledRequest{
+			ActorID:  admin.UserID,
+			Role:     entity.RoleAdmin,
+			UserID:   admin.UserID,
+			Disabled: true,
+		})
+		requireErrorCode(t, err, BadRequest, CannotDisableSelfError)
+	})
+
+	t.Run("Disabled user cannot sign in or refresh", func(t *testing.T) {
+		user, err := disableUseCase.SetUserDisabled(context.Background(), SetUserDisabledRequest{
+			ActorID:  admin.UserID,
+			Role:     entity.RoleAdmin,
+			UserID:   target.UserID,
+			Disabled: true,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, user.DisabledAt)
+
+		_, err = signInUseCase.SignIn(context.Background(), SignInRequest{
+			Email:    target.Email,
+			Password: password,
+		})
+		requireErrorCode(t, err, Forbidden, UserDisabledError)
+
+		// 無効化と同時にセッションが失効している
+		_, err = refreshUseCase.Refresh(context.Background(), RefreshAccessTokenRequest{
+			RefreshToken: target.RefreshToken,
+		})
+		requireErrorCode(t, err, UnAuthorized, InvalidTokenError)
+
+		user, err = disableUseCase.SetUserDisabled(context.Background(), SetUserDisabledRequest{
+			ActorID: admin.UserID,
+			Role:    entity.RoleAdmin,
+			UserID:  target.UserID,
+		})
+		require.NoError(t, err)
+		require.Nil(t, user.DisabledAt)
+
+		_, err = signInUseCase.SignIn(context.Background(), SignInRequest{
+			Email:    target.Email,
+			Password: password,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Revoke sessions", func(t *testing.T) {
+		revoked, err := revokeUseCase.RevokeUserSessions(context.Background(), RevokeUserSessionsRequest{
+			ActorID: admin.UserID,
+			Role:    entity.RoleAdmin,
+			UserID:  target.UserID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), revoked)
+
+		_, err = revokeUseCase.RevokeUserSessions(context.Background(), RevokeUserSessionsRequest{
+			ActorID: admin.UserID,
+			Role:    entity.RoleAdmin,
+			UserID:  0,
+		})
+		requireErrorCode(t, err, NotFound, NotFoundUserError)
+	})
+
+	t.Run("Delete catalog book", func(t *testing.T) {
+		err := deleteCatalogUseCase.DeleteCatalogBook(context.Background(), DeleteCatalogBookRequest{
+			ActorID: admin.UserID,
+			Role:    entity.RoleAdmin,
+			BookID:  book.ID,
+			Reason:  "spam",
+		})
+		require.NoError(t, err)
+
+		_, err = querier.GetBooksByID(context.Background(), book.ID)
+		require.Error(t, err)
+
+		err = deleteCatalogUseCase.DeleteCatalogBook(context.Background(), DeleteCatalogBookRequest{
+			ActorID: admin.UserID,
+			Role:    entity.RoleAdmin,
+			BookID:  book.ID,
+		})
+		requireErrorCode(t, err, NotFound, NotFoundBookError)
+	})
+
+	t.Run("Every admin action is audited", func(t *testing.T) {
+		targetType := entity.AuditTargetUser
+		logs, err := auditLogsUseCase.ListAuditLogs(context.Background(), ListAuditLogsRequest{
+			Role:       entity.RoleAdmin,
+			TargetType: &targetType,
+			TargetID:   &target.UserID,
+		})
+		require.NoError(t, err)
+		actions := make([]entity.AuditAction, len(logs))
+		for i, l := range logs {
+			require.Equal(t, admin.UserID, l.ActorID)
+			actions[i] = l.Action
+		}
+		require.Equal(t, []entity.AuditAction{
+			entity.AuditRevokeSessions,
+			entity.AuditEnableUser,
+			entity.AuditDisableUser,
+			entity.AuditViewLibrary,
+		}, actions)
+
+		bookType := entity.AuditTargetBook
+		logs, err = auditLogsUseCase.ListAuditLogs(context.Background(), ListAuditLogsRequest{
+			Role:       entity.RoleAdmin,
+			TargetType: &bookType,
+			TargetID:   &book.ID,
+		})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		require.Equal(t, entity.AuditDeleteBook, logs[0].Action)
+		require.JSONEq(t, `{"title":"`+book.Title+`","reason":"spam","affected_users":1}`, string(logs[0].Detail))
+	})
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+const maxBatchRegisterBooks = 100
+
+type BatchRegisterBooksUseCase interface {
+	BatchRegisterBooks(ctx context.Context, req BatchRegisterBooksRequest) (*BatchRegisterBooksResponse, error)
+}
+
+type BatchRegisterBooksUseCaseImpl struct {
+	transactor      repository.Transactor
+	registerUseCase RegisterBookUseCase
+}
+
+func NewBatchRegisterBooksUseCase(
+	transactor repository.Transactor,
+	registerUseCase RegisterBookUseCase,
+) BatchRegisterBooksUseCase {
+	return &BatchRegisterBooksUseCaseImpl{
+		transactor:      transactor,
+		registerUseCase: registerUseCase,
+	}
+}
+
+type BatchRegisterBooksRequest struct {
+	// UserIDは各要素ではなくこちらを使う
+	UserID int64
+	Items  []RegisterBookRequest
+	// trueの場合は1件でも失敗すると全件を登録しない。falseの場合は1件ずつ別のトランザクションで登録する
+	Atomic bool
+}
+
+type BatchRegisterBookResult struct {
+	Book *entity.Book
+	// 登録に失敗した場合のみ設定される
+	Err *Error
+}
+
+// BatchRegisterBooksResponse ResultsはItemsと同じ順序で並ぶ
+type BatchRegisterBooksResponse struct {
+	Results []BatchRegisterBookResult
+}
+
+func (u *BatchRegisterBooksUseCaseImpl) BatchRegisterBooks(ctx context.Context, req BatchRegisterBooksRequest) (*BatchRegisterBooksResponse, error) {
+	if len(req.Items) == 0 || len(req.Items) > maxBatchRegisterBooks {
+		return nil, newError(BadRequest, InvalidBatchError, "items must contain between 1 and 100 books")
+	}
+	items := make([]RegisterBookRequest, len(req.Items))
+	for i, item := range req.Items {
+		item.UserID = req.UserID
+		items[i] = item
+	}
+	if req.Atomic {
+		return u.registerAtomically(ctx, items)
+	}
+
+	res := &BatchRegisterBooksResponse{Results: make([]BatchRegisterBookResult, len(items))}
+	for i, item := range items {
+		res.Results[i] = u.register(ctx, item)
+	}
+	return res, nil
+}
+
+// registerAtomically 失敗した要素にはその原因を、それ以外の要素にはBatchRolledBackErrorを返す
+func (u *BatchRegisterBooksUseCaseImpl) registerAtomically(ctx context.Context, items []RegisterBookRequest) (*BatchRegisterBooksResponse, error) {
+	res := &BatchRegisterBooksResponse{Results: make([]BatchRegisterBookResult, len(items))}
+	failed := -1
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
+		for i, item := range items {
+			res.Results[i] = u.register(ctx, item)
+			if res.Results[i].Err != nil {
+				failed = i
+				return res.Results[i].Err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		return res, nil
+	}
+	if failed < 0 {
+		// コミットに失敗した
+		return nil, handle(err)
+	}
+	for i := range res.Results {
+		if i == failed {
+			continue
+		}
+		res.Results[i] = BatchRegisterBookResult{
+			Err: newError(BadRequest, BatchRolledBackError, "not registered because another book in the batch failed"),
+		}
+	}
+	return res, nil
+}
+
+func (u *BatchRegisterBooksUseCaseImpl) register(ctx context.Context, item RegisterBookRequest) BatchRegisterBookResult {
+	book, err := u.registerUseCase.RegisterBook(ctx, item)
+	if err != nil {
+		// handleを通したエラーは必ず*Errorになる
+		var e *Error
+		errors.As(handle(err), &e)
+		return BatchRegisterBookResult{Err: e}
+	}
+	return BatchRegisterBookResult{Book: book}
+}
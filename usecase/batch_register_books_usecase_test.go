@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+)
+
+func TestBatchRegisterBooks(t *testing.T) {
+	batchUseCase := newTestBatchRegisterBooksUseCase(t)
+	getLibraryUseCase := newTestGetLibraryUseCase(t)
+
+	newItems := func() []RegisterBookRequest {
+		return []RegisterBookRequest{
+			{Title: testdata.RandomString(10), Status: entity.Unread},
+			{Title: testdata.RandomString(10), Status: entity.Reading, Priority: 9},
+			{Title: testdata.RandomString(10), Status: entity.Done},
+		}
+	}
+	libraryCount := func(t *testing.T, userID int64) int {
+		books, err := getLibraryUseCase.GetLibrary(context.Background(), GetLibraryRequest{UserID: userID})
+		require.NoError(t, err)
+		return len(books)
+	}
+
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T) BatchRegisterBooksRequest
+		check func(t *testing.T, req BatchRegisterBooksRequest, res *BatchRegisterBooksResponse, err error)
+	}{
+		{
+			name: "Batch register books registers each item independently",
+			setup: func(t *testing.T) BatchRegisterBooksRequest {
+				return BatchRegisterBooksRequest{UserID: signUpTestUser(t).UserID, Items: newItems()}
+			},
+			check: func(t *testing.T, req BatchRegisterBooksRequest, res *BatchRegisterBooksResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Results, 3)
+				require.Nil(t, res.Results[0].Err)
+				require.Equal(t, req.Items[0].Title, res.Results[0].Book.Title)
+				require.Nil(t, res.Results[1].Book)
+				require.Equal(t, InvalidPriorityError, res.Results[1].Err.ErrorCode)
+				require.Nil(t, res.Results[2].Err)
+				require.Equal(t, entity.Done, res.Results[2].Book.Status)
+				require.Equal(t, 2, libraryCount(t, req.UserID))
+			},
+		},
+		{
+			name: "Batch register books rolls back all items in atomic mode",
+			setup: func(t *testing.T) BatchRegisterBooksRequest {
+				return BatchRegisterBooksRequest{UserID: signUpTestUser(t).UserID, Items: newItems(), Atomic: true}
+			},
+			check: func(t *testing.T, req BatchRegisterBooksRequest, res *BatchRegisterBooksResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Results, 3)
+				require.Equal(t, BatchRolledBackError, res.Results[0].Err.ErrorCode)
+				require.Equal(t, InvalidPriorityError, res.Results[1].Err.ErrorCode)
+				require.Equal(t, BatchRolledBackError, res.Results[2].Err.ErrorCode)
+				for _, r := range res.Results {
+					require.Nil(t, r.Book)
+				}
+				require.Zero(t, libraryCount(t, req.UserID))
+			},
+		},
+		{
+			name: "Batch register books commits all items in atomic mode",
+			setup: func(t *testing.T) BatchRegisterBooksRequest {
+				items := newItems()
+				items[1].Priority = 1
+				return BatchRegisterBooksRequest{UserID: signUpTestUser(t).UserID, Items: items, Atomic: true}
+			},
+			check: func(t *testing.T, req BatchRegisterBooksRequest, res *BatchRegisterBooksResponse, err error) {
+				require.NoError(t, err)
+				for _, r := range res.Results {
+					require.Nil(t, r.Err)
+					require.NotNil(t, r.Book)
+				}
+				require.Equal(t, 3, libraryCount(t, req.UserID))
+			},
+		},
+		{
+			name: "Batch register books failure when items are empty",
+			setup: func(t *testing.T) BatchRegisterBooksRequest {
+				return BatchRegisterBooksRequest{UserID: signUpTestUser(t).UserID}
+			},
+			check: func(t *testing.T, req BatchRegisterBooksRequest, res *BatchRegisterBooksResponse, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidBatchError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := tc.setup(t)
+			res, err := batchUseCase.BatchRegisterBooks(context.Background(), req)
+			tc.check(t, req, res, err)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestBookClub(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	createClubUseCase := newTestCreateClubUseCase(t)
+	getClubUseCase := newTestGetClubUseCase(t)
+	inviteUseCase := newTestInviteToClubUseCase(t)
+	joinUseCase := newTestJoinClubUseCase(t)
+	leaveUseCase := newTestLeaveClubUseCase(t)
+	scheduleUseCase := newTestSetClubScheduleUseCase(t)
+	progressUseCase := newTestUpdateClubProgressUseCase(t)
+	postUseCase := newTestPostClubDiscussionUseCase(t)
+	listUseCase := newTestListClubDiscussionUseCase(t)
+
+	owner := signUpTestUser(t)
+	member := signUpTestUser(t)
+	outsider := signUpTestUser(t)
+
+	pageCount := int32(300)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID:    owner.UserID,
+		Title:     testdata.RandomString(10),
+		PageCount: &pageCount,
+		Status:    entity.Reading,
+	})
+	require.NoError(t, err)
+
+	club, err := createClubUseCase.CreateClub(context.Background(), CreateClubRequest{
+		UserID: owner.UserID,
+		Name:   testdata.RandomString(10),
+	})
+	require.NoError(t, err)
+	require.Len(t, club.Members, 1)
+	require.Equal(t, entity.ClubOwner, club.Members[0].Role)
+
+	err = joinUseCase.JoinClub(context.Background(), JoinClubRequest{ClubID: club.ID, UserID: member.UserID})
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, NotInvitedError, e.ErrorCode)
+
+	err = inviteUseCase.InviteToClub(context.Background(), InviteToClubRequest{ClubID: club.ID, InviterID: owner.UserID, InviteeID: member.UserID})
+	require.NoError(t, err)
+	err = joinUseCase.JoinClub(context.Background(), JoinClubRequest{ClubID: club.ID, UserID: member.UserID})
+	require.NoError(t, err)
+
+	chapter := "第1章"
+	targetPage := int32(150)
+	sections, err := scheduleUseCase.SetClubSchedule(context.Background(), SetClubScheduleRequest{
+		ClubID: club.ID,
+		UserID: owner.UserID,
+		BookID: book.ID,
+		Sections: []ClubSectionRequest{
+			{Title: testdata.RandomString(10), Chapter: &chapter, DueDate: time.Now().Add(7 * 24 * time.Hour)},
+			{Title: testdata.RandomString(10), TargetPage: &targetPage, DueDate: time.Now().Add(14 * 24 * time.Hour)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, sections, 2)
+
+	_, err = scheduleUseCase.SetClubSchedule(context.Background(), SetClubScheduleRequest{
+		ClubID: club.ID,
+		UserID: member.UserID,
+		BookID: book.ID,
+	})
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, InsufficientClubRoleError, e.ErrorCode)
+
+	err = progressUseCase.UpdateClubProgress(context.Background(), UpdateClubProgressRequest{ClubID: club.ID, UserID: member.UserID, CurrentPage: 120})
+	require.NoError(t, err)
+	err = progressUseCase.UpdateClubProgress(context.Background(), UpdateClubProgressRequest{ClubID: club.ID, UserID: member.UserID, CurrentPage: 301})
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, InvalidProgressError, e.ErrorCode)
+
+	body := testdata.RandomString(20)
+	post, err := postUseCase.PostClubDiscussion(context.Background(), PostClubDiscussionRequest{
+		ClubID:    club.ID,
+		SectionID: sections[0].ID,
+		UserID:    member.UserID,
+		Body:      body,
+	})
+	require.NoError(t, err)
+	require.Equal(t, member.Name, post.UserName)
+
+	_, err = listUseCase.ListClubDiscussion(context.Background(), ListClubDiscussionRequest{
+		ClubID:    club.ID,
+		SectionID: sections[0].ID,
+		UserID:    outsider.UserID,
+	})
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, NotClubMemberError, e.ErrorCode)
+
+	posts, err := listUseCase.ListClubDiscussion(context.Background(), ListClubDiscussionRequest{
+		ClubID:    club.ID,
+		SectionID: sections[0].ID,
+		UserID:    owner.UserID,
+	})
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	require.Equal(t, body, posts[0].Body)
+
+	res, err := getClubUseCase.GetClub(context.Background(), GetClubRequest{ClubID: club.ID, UserID: owner.UserID})
+	require.NoError(t, err)
+	require.NotNil(t, res.CurrentBook)
+	require.Equal(t, book.ID, res.CurrentBook.ID)
+	require.Len(t, res.Sections, 2)
+	require.Len(t, res.Members, 2)
+	for _, m := range res.Members {
+		if m.UserID == member.UserID {
+			require.Equal(t, int32(120), m.CurrentPage)
+			require.NotNil(t, m.ProgressUpdatedAt)
+		}
+	}
+
+	err = leaveUseCase.LeaveClub(context.Background(), LeaveClubRequest{ClubID: club.ID, UserID: owner.UserID})
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, OwnerCannotLeaveError, e.ErrorCode)
+
+	err = leaveUseCase.LeaveClub(context.Background(), LeaveClubRequest{ClubID: club.ID, UserID: member.UserID})
+	require.NoError(t, err)
+	res, err = getClubUseCase.GetClub(context.Background(), GetClubRequest{ClubID: club.ID, UserID: owner.UserID})
+	require.NoError(t, err)
+	require.Len(t, res.Members, 1)
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type CreateClubUseCase interface {
+	CreateClub(ctx context.Context, req CreateClubRequest) (*entity.BookClub, error)
+}
+
+type CreateClubUseCaseImpl struct {
+	transactor repository.Transactor
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+}
+
+func NewCreateClubUseCase(
+	transactor repository.Transactor,
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+) CreateClubUseCase {
+	return &CreateClubUseCaseImpl{
+		transactor: transactor,
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+type CreateClubRequest struct {
+	UserID      int64
+	Name        string
+	Description *string
+}
+
+// CreateClub 作成したユーザーをオーナーとして参加させる
+func (u *CreateClubUseCaseImpl) CreateClub(ctx context.Context, req CreateClubRequest) (*entity.BookClub, error) {
+	var res *entity.BookClub
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
+		club, err := u.clubRepo.Create(ctx, repository.CreateBookClubRequest{
+			Name:        req.Name,
+			Description: req.Description,
+		})
+		if err != nil {
+			return err
+		}
+		owner, err := u.memberRepo.Create(ctx, repository.CreateClubMemberRequest{
+			ClubID: club.ID,
+			UserID: req.UserID,
+			Role:   repository.ClubOwner,
+		})
+		if err != nil {
+			return err
+		}
+		res = &entity.BookClub{
+			ID:          club.ID,
+			Name:        club.Name,
+			Description: club.Description,
+			Sections:    []entity.ClubSection{},
+			Members: []entity.BookClubMember{
+				{
+					UserID:   owner.UserID,
+					Role:     owner.Role.ToEntity(),
+					JoinedAt: owner.JoinedAt,
+				},
+			},
+			CreatedAt: club.CreatedAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, handle(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"readly/entity"
+	"readly/repository"
+	"readly/service/netguard"
+	"strings"
+)
+
+// 1ユーザーが登録できるエンドポイントの上限
+const maxWebhooksPerUser = 10
+
+type CreateWebhookUseCase interface {
+	CreateWebhook(ctx context.Context, req CreateWebhookRequest) (*CreateWebhookResponse, error)
+}
+
+type CreateWebhookUseCaseImpl struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewCreateWebhookUseCase(
+	webhookRepo repository.WebhookRepository,
+) CreateWebhookUseCase {
+	return &CreateWebhookUseCaseImpl{
+		webhookRepo: webhookRepo,
+	}
+}
+
+type CreateWebhookRequest struct {
+	UserID     int64
+	URL        string
+	EventTypes []entity.DomainEventType
+}
+
+type CreateWebhookResponse struct {
+	Webhook entity.Webhook
+	// 署名の検証に使う鍵。作成時にのみ返す
+	Secret string
+}
+
+func (u *CreateWebhookUseCaseImpl) CreateWebhook(ctx context.Context, req CreateWebhookRequest) (res *CreateWebhookResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = validateWebhookURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := validateWebhookEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := u.webhookRepo.GetEndpointsByUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) >= maxWebhooksPerUser {
+		return nil, newError(BadRequest, TooManyWebhooksError, "too many webhooks")
+	}
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := u.webhookRepo.CreateEndpoint(ctx, repository.CreateWebhookEndpointRequest{
+		UserID:     req.UserID,
+		URL:        req.URL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateWebhookResponse{
+		Webhook: newWebhook(*e),
+		Secret:  e.Secret,
+	}, nil
+}
+
+// validateWebhookURL 明らかにサーバー内部を指すURLを登録時に弾く。
+// ホスト名から解決されるアドレスとリダイレクトは netguard.NewClient のクライアントが接続時に検査する
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" {
+		return newError(BadRequest, InvalidWebhookURLError, "url must be an absolute http or https url")
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return newError(BadRequest, InvalidWebhookURLError, "url must not point to a local address")
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if !netguard.IsPublicIP(ip) {
+			return newError(BadRequest, InvalidWebhookURLError, "url must not point to a local address")
+		}
+	}
+	return nil
+}
+
+func validateWebhookEventTypes(eventTypes []entity.DomainEventType) ([]entity.DomainEventType, error) {
+	if len(eventTypes) == 0 {
+		return nil, newError(BadRequest, InvalidWebhookEventError, "at least one event type is required")
+	}
+	seen := make(map[entity.DomainEventType]bool, len(eventTypes))
+	res := make([]entity.DomainEventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		switch t {
+		case entity.BookRegistered, entity.BookUpdated, entity.BookDeleted, entity.StatusChanged, entity.UserSignedUp:
+		default:
+			return nil, newError(BadRequest, InvalidWebhookEventError, "invalid event type")
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func newWebhook(e repository.WebhookEndpointResponse) entity.Webhook {
+	return entity.Webhook{
+		ID:         e.ID,
+		URL:        e.URL,
+		EventTypes: e.EventTypes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"readly/entity"
 	"readly/repository"
 )
 
@@ -15,6 +16,8 @@ type DeleteBookUseCaseImpl struct {
 	bookRepo           repository.BookRepository
 	readingHistoryRepo repository.ReadingHistoryRepository
 	userRepo           repository.UserRepository
+	outboxRepo         repository.OutboxRepository
+	tombstoneRepo      repository.SyncTombstoneRepository
 }
 
 func NewDeleteBookUseCase(
@@ -22,12 +25,16 @@ func NewDeleteBookUseCase(
 	bookRepo repository.BookRepository,
 	readingHistoryRepo repository.ReadingHistoryRepository,
 	userRepo repository.UserRepository,
+	outboxRepo repository.OutboxRepository,
+	tombstoneRepo repository.SyncTombstoneRepository,
 ) DeleteBookUseCase {
 	return &DeleteBookUseCaseImpl{
 		transactor:         transactor,
 		bookRepo:           bookRepo,
 		readingHistoryRepo: readingHistoryRepo,
 		userRepo:           userRepo,
+		outboxRepo:         outboxRepo,
+		tombstoneRepo:      tombstoneRepo,
 	}
 }
 
@@ -37,7 +44,7 @@ type DeleteBookRequest struct {
 }
 
 func (u *DeleteBookUseCaseImpl) DeleteBook(ctx context.Context, req DeleteBookRequest) error {
-	err := u.transactor.Exec(ctx, func() error {
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
 		deleteHistoryArgs := repository.DeleteReadingHistoryRequest{
 			UserID: req.UserID,
 			BookID: req.BookID,
@@ -49,27 +56,60 @@ func (u *DeleteBookUseCaseImpl) DeleteBook(ctx context.Context, req DeleteBookRe
 			}
 			return err
 		}
-		err = u.deleteBookGenres(ctx, req.BookID)
+		err = u.deleteBookIfUnused(ctx, req.BookID)
 		if err != nil {
-			if errors.Is(err, repository.ErrNoRowsDeleted) {
-				return newError(BadRequest, NotFoundBookError, "genre not found")
-			}
 			return err
 		}
-		err = u.bookRepo.DeleteBook(ctx, req.BookID)
+		// オフラインのクライアントが同期で削除を知るために残す
+		tombstoneArgs := repository.SyncTombstoneRequest{
+			UserID: req.UserID,
+			Type:   repository.SyncBook,
+			Key:    entity.BookSyncKey(req.BookID),
+		}
+		err = u.tombstoneRepo.Create(ctx, tombstoneArgs)
 		if err != nil {
-			if errors.Is(err, repository.ErrNoRowsDeleted) {
-				return newError(BadRequest, NotFoundBookError, "book not found")
-			}
 			return err
 		}
-		return nil
+		eventArgs := repository.CreateOutboxEventRequest{
+			Type: entity.BookDeleted,
+			Payload: entity.BookDeletedPayload{
+				UserID: req.UserID,
+				BookID: req.BookID,
+			},
+		}
+		return u.outboxRepo.Create(ctx, eventArgs)
 	})
 	return handle(err)
 }
 
-func (u *DeleteBookUseCaseImpl) deleteBookGenres(ctx context.Context, bookID int64) error {
-	genres, err := u.bookRepo.GetGenresByBookID(ctx, bookID)
+// deleteBookIfUnused 書籍は共有しているため、他のユーザーの本棚にない場合だけ削除する
+func (u *DeleteBookUseCaseImpl) deleteBookIfUnused(ctx context.Context, bookID int64) error {
+	count, err := u.readingHistoryRepo.CountByBook(ctx, bookID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	err = deleteBookGenres(ctx, u.bookRepo, bookID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRowsDeleted) {
+			return newError(BadRequest, NotFoundBookError, "genre not found")
+		}
+		return err
+	}
+	err = u.bookRepo.DeleteBook(ctx, bookID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRowsDeleted) {
+			return newError(BadRequest, NotFoundBookError, "book not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func deleteBookGenres(ctx context.Context, bookRepo repository.BookRepository, bookID int64) error {
+	genres, err := bookRepo.GetGenresByBookID(ctx, bookID)
 	if err != nil {
 		return err
 	}
@@ -78,7 +118,7 @@ func (u *DeleteBookUseCaseImpl) deleteBookGenres(ctx context.Context, bookID int
 			BookID:    bookID,
 			GenreName: g,
 		}
-		err := u.bookRepo.DeleteBookGenre(ctx, args)
+		err := bookRepo.DeleteBookGenre(ctx, args)
 		if err != nil {
 			return err
 		}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"github.com/stretchr/testify/require"
+	sqlc "readly/db/sqlc"
 	"readly/testdata"
 	"testing"
 	"time"
@@ -21,6 +22,7 @@ func TestDeleteBook(t *testing.T) {
 	signUpRes, err := signUpUseCase.SignUp(context.Background(), signUpReq)
 	require.NoError(t, err)
 
+	var sharedBookID int64
 	testCases := []struct {
 		name  string
 		setup func(t *testing.T) DeleteBookRequest
@@ -68,6 +70,43 @@ func TestDeleteBook(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "Delete book in other user's library keeps the book",
+			setup: func(t *testing.T) DeleteBookRequest {
+				registerReq := RegisterBookRequest{
+					UserID: signUpRes.UserID,
+					Title:  testdata.RandomString(10),
+					Genres: []string{testdata.RandomString(6)},
+				}
+				book, err := registerBookUseCase.RegisterBook(context.Background(), registerReq)
+				require.NoError(t, err)
+
+				otherReq := SignUpRequest{
+					Name:     testdata.RandomString(10),
+					Email:    testdata.RandomEmail(),
+					Password: testdata.RandomString(16),
+				}
+				other, err := signUpUseCase.SignUp(context.Background(), otherReq)
+				require.NoError(t, err)
+				_, err = querier.CreateReadingHistory(context.Background(), sqlc.CreateReadingHistoryParams{
+					UserID: other.UserID,
+					BookID: book.ID,
+					Status: sqlc.ReadingStatusUnread,
+				})
+				require.NoError(t, err)
+
+				sharedBookID = book.ID
+				return DeleteBookRequest{
+					UserID: signUpRes.UserID,
+					BookID: book.ID,
+				}
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+				_, err = querier.GetBooksByID(context.Background(), sharedBookID)
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "Delete not exist book failed",
 			setup: func(t *testing.T) DeleteBookRequest {
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+type DeleteCatalogBookUseCase interface {
+	DeleteCatalogBook(ctx context.Context, req DeleteCatalogBookRequest) error
+}
+
+type DeleteCatalogBookUseCaseImpl struct {
+	transactor         repository.Transactor
+	bookRepo           repository.BookRepository
+	readingHistoryRepo repository.ReadingHistoryRepository
+	outboxRepo         repository.OutboxRepository
+	tombstoneRepo      repository.SyncTombstoneRepository
+	auditLogRepo       repository.AuditLogRepository
+}
+
+func NewDeleteCatalogBookUseCase(
+	transactor repository.Transactor,
+	bookRepo repository.BookRepository,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+	outboxRepo repository.OutboxRepository,
+	tombstoneRepo repository.SyncTombstoneRepository,
+	auditLogRepo repository.AuditLogRepository,
+) DeleteCatalogBookUseCase {
+	return &DeleteCatalogBookUseCaseImpl{
+		transactor:         transactor,
+		bookRepo:           bookRepo,
+		readingHistoryRepo: readingHistoryRepo,
+		outboxRepo:         outboxRepo,
+		tombstoneRepo:      tombstoneRepo,
+		auditLogRepo:       auditLogRepo,
+	}
+}
+
+type DeleteCatalogBookRequest struct {
+	ActorID int64
+	Role    entity.UserRole
+	BookID  int64
+	// 監査ログに残す削除の理由
+	Reason string
+}
+
+// DeleteCatalogBook 不適切な書籍を登録しているすべてのユーザーの本棚から削除する
+func (u *DeleteCatalogBookUseCaseImpl) DeleteCatalogBook(ctx context.Context, req DeleteCatalogBookRequest) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = authorize(req.Role, ModerateCatalog)
+	if err != nil {
+		return err
+	}
+
+	return u.transactor.Exec(ctx, func(ctx context.Context) error {
+		book, err := u.bookRepo.GetBookByID(ctx, req.BookID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return newError(NotFound, NotFoundBookError, "book not found")
+			}
+			return err
+		}
+		userIDs, err := u.readingHistoryRepo.DeleteByBook(ctx, req.BookID)
+		if err != nil {
+			return err
+		}
+		for _, userID := range userIDs {
+			err := u.notifyDeleted(ctx, userID, req.BookID)
+			if err != nil {
+				return err
+			}
+		}
+		err = deleteBookGenres(ctx, u.bookRepo, req.BookID)
+		if err != nil {
+			return err
+		}
+		err = u.bookRepo.DeleteBook(ctx, req.BookID)
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepo.Create(ctx, repository.CreateAuditLogRequest{
+			ActorID:    req.ActorID,
+			Action:     entity.AuditDeleteBook,
+			TargetType: entity.AuditTargetBook,
+			TargetID:   &req.BookID,
+			Detail: map[string]any{
+				"title":          book.Title,
+				"reason":         req.Reason,
+				"affected_users": len(userIDs),
+			},
+		})
+	})
+}
+
+// notifyDeleted 本棚から消えたことを同期とイベントでユーザーに伝える
+func (u *DeleteCatalogBookUseCaseImpl) notifyDeleted(ctx context.Context, userID int64, bookID int64) error {
+	err := u.tombstoneRepo.Create(ctx, repository.SyncTombstoneRequest{
+		UserID: userID,
+		Type:   repository.SyncBook,
+		Key:    entity.BookSyncKey(bookID),
+	})
+	if err != nil {
+		return err
+	}
+	return u.outboxRepo.Create(ctx, repository.CreateOutboxEventRequest{
+		Type: entity.BookDeleted,
+		Payload: entity.BookDeletedPayload{
+			UserID: userID,
+			BookID: bookID,
+		},
+	})
+}
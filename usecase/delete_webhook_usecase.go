@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"readly/repository"
+)
+
+type DeleteWebhookUseCase interface {
+	DeleteWebhook(ctx context.Context, req DeleteWebhookRequest) error
+}
+
+type DeleteWebhookUseCaseImpl struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewDeleteWebhookUseCase(
+	webhookRepo repository.WebhookRepository,
+) DeleteWebhookUseCase {
+	return &DeleteWebhookUseCaseImpl{
+		webhookRepo: webhookRepo,
+	}
+}
+
+type DeleteWebhookRequest struct {
+	UserID    int64
+	WebhookID int64
+}
+
+// DeleteWebhook 未配信の配信も合わせて削除される
+func (u *DeleteWebhookUseCaseImpl) DeleteWebhook(ctx context.Context, req DeleteWebhookRequest) error {
+	err := u.webhookRepo.DeleteEndpoint(ctx, repository.WebhookEndpointRequest{
+		ID:     req.WebhookID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRowsDeleted) {
+			return newError(NotFound, NotFoundWebhookError, "webhook not found")
+		}
+		return handle(err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"readly/repository"
+	"readly/service/webhook"
+	"time"
+)
+
+const (
+	// この回数失敗した配信は諦める
+	webhookMaxAttempts = 8
+	// 1回目の失敗後の待ち時間。以降は失敗するごとに倍にする
+	webhookBaseBackoff = 30 * time.Second
+	// 配信中に他のプロセスが同じ配信を取得しないようにする時間。送信のタイムアウトより長くする
+	webhookDeliveryLease = 5 * time.Minute
+	// 記録するエラーメッセージの最大長
+	maxWebhookErrorLength = 1000
+)
+
+type DeliverWebhooksUseCase interface {
+	DeliverWebhooks(ctx context.Context, req DeliverWebhooksRequest) (*DeliverWebhooksResponse, error)
+}
+
+type DeliverWebhooksUseCaseImpl struct {
+	webhookRepo repository.WebhookRepository
+	client      webhook.Client
+}
+
+func NewDeliverWebhooksUseCase(
+	webhookRepo repository.WebhookRepository,
+	client webhook.Client,
+) DeliverWebhooksUseCase {
+	return &DeliverWebhooksUseCaseImpl{
+		webhookRepo: webhookRepo,
+		client:      client,
+	}
+}
+
+type DeliverWebhooksRequest struct {
+	Limit int32
+}
+
+type DeliverWebhooksResponse struct {
+	Succeeded int
+	// 再試行される配信の数
+	Failed int
+	// 再試行の上限に達した配信の数
+	Dead int
+}
+
+// DeliverWebhooks 配信時刻を過ぎた配信を送信する。失敗した配信は指数関数的に間隔を空けて再試行する
+func (u *DeliverWebhooksUseCaseImpl) DeliverWebhooks(ctx context.Context, req DeliverWebhooksRequest) (*DeliverWebhooksResponse, error) {
+	now := time.Now()
+	deliveries, err := u.webhookRepo.ClaimDueDeliveries(ctx, repository.ClaimWebhookDeliveriesRequest{
+		Limit:      req.Limit,
+		LeaseUntil: now.Add(webhookDeliveryLease),
+	})
+	if err != nil {
+		return nil, handle(err)
+	}
+
+	res := &DeliverWebhooksResponse{}
+	for _, d := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return res, handle(err)
+		}
+		result := u.send(ctx, d)
+		// 記録に失敗しても、リースが切れた後に再度配信されるため続行する
+		if err := u.webhookRepo.UpdateDeliveryResult(ctx, result); err != nil {
+			slog.Error("failed to record webhook delivery result", "delivery_id", d.ID, "error", err)
+			continue
+		}
+		switch result.Status {
+		case repository.DeliverySucceeded:
+			res.Succeeded++
+		case repository.DeliveryDead:
+			res.Dead++
+		default:
+			res.Failed++
+		}
+	}
+	return res, nil
+}
+
+func (u *DeliverWebhooksUseCaseImpl) send(ctx context.Context, d repository.ClaimedWebhookDeliveryResponse) repository.UpdateWebhookDeliveryResultRequest {
+	status, err := u.client.Send(ctx, webhook.Request{
+		URL:        d.URL,
+		Secret:     d.Secret,
+		DeliveryID: d.ID,
+		EventType:  string(d.EventType),
+		Body:       d.Body,
+	})
+	now := time.Now()
+	result := repository.UpdateWebhookDeliveryResultRequest{
+		ID:            d.ID,
+		NextAttemptAt: now,
+	}
+	if err == nil {
+		code := int32(status)
+		result.ResponseStatus = &code
+		if status >= 200 && status < 300 {
+			result.Status = repository.DeliverySucceeded
+			result.DeliveredAt = &now
+			return result
+		}
+	} else {
+		msg := err.Error()
+		if len(msg) > maxWebhookErrorLength {
+			msg = msg[:maxWebhookErrorLength]
+		}
+		result.Error = &msg
+	}
+
+	attempts := d.Attempts + 1
+	if attempts >= webhookMaxAttempts {
+		result.Status = repository.DeliveryDead
+		return result
+	}
+	result.Status = repository.DeliveryPending
+	result.NextAttemptAt = now.Add(webhookBackoff(attempts))
+	return result
+}
+
+// webhookBackoff attempts回失敗した後に次の配信までに空ける時間
+func webhookBackoff(attempts int32) time.Duration {
+	return webhookBaseBackoff << (attempts - 1)
+}
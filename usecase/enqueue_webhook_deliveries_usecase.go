@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"readly/entity"
+	"readly/repository"
+)
+
+type EnqueueWebhookDeliveriesUseCase interface {
+	EnqueueWebhookDeliveries(ctx context.Context, e entity.DomainEvent) error
+}
+
+type EnqueueWebhookDeliveriesUseCaseImpl struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewEnqueueWebhookDeliveriesUseCase(
+	webhookRepo repository.WebhookRepository,
+) EnqueueWebhookDeliveriesUseCase {
+	return &EnqueueWebhookDeliveriesUseCaseImpl{
+		webhookRepo: webhookRepo,
+	}
+}
+
+// EnqueueWebhookDeliveries イベントを起こしたユーザーのエンドポイントのうち、イベントを購読しているものへの配信を作成する。
+// 同じイベントで複数回呼ばれても配信は1件だけ作成されるため、outboxのSinkとして使える
+func (u *EnqueueWebhookDeliveriesUseCaseImpl) EnqueueWebhookDeliveries(ctx context.Context, e entity.DomainEvent) error {
+	userID, err := e.UserID()
+	if err != nil {
+		return handle(err)
+	}
+	if userID == 0 {
+		return nil
+	}
+
+	endpoints, err := u.webhookRepo.GetEndpointsByEvent(ctx, repository.GetWebhookEndpointsByEventRequest{
+		UserID:    userID,
+		EventType: e.Type,
+	})
+	if err != nil {
+		return handle(err)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(e)
+	if err != nil {
+		return handle(err)
+	}
+	for _, endpoint := range endpoints {
+		err = u.webhookRepo.CreateDelivery(ctx, repository.CreateWebhookDeliveryRequest{
+			EndpointID: endpoint.ID,
+			EventID:    e.ID,
+			EventType:  e.Type,
+			Body:       body,
+		})
+		if err != nil {
+			return handle(err)
+		}
+	}
+	return nil
+}
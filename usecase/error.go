@@ -14,29 +14,88 @@ type Error struct {
 type StatusCode int16
 
 const (
-	BadRequest   StatusCode = 400
-	UnAuthorized StatusCode = 401
-	Forbidden    StatusCode = 403
-	NotFound     StatusCode = 404
-	Conflict     StatusCode = 409
-	Internal     StatusCode = 500
-	Maintenance  StatusCode = 503
+	BadRequest         StatusCode = 400
+	UnAuthorized       StatusCode = 401
+	Forbidden          StatusCode = 403
+	NotFound           StatusCode = 404
+	Conflict           StatusCode = 409
+	PreconditionFailed StatusCode = 412
+	Internal           StatusCode = 500
+	Maintenance        StatusCode = 503
 )
 
 type ErrorCode int
 
 const (
 	// common
-	InternalServerError ErrorCode = 1000
-	InvalidTokenError   ErrorCode = 1001
+	InternalServerError           ErrorCode = 1000
+	InvalidTokenError             ErrorCode = 1001
+	InvalidCursorError            ErrorCode = 1002
+	StreamLaggedError             ErrorCode = 1003
+	InvalidIdempotencyKeyError    ErrorCode = 1004
+	IdempotencyKeyMismatchError   ErrorCode = 1005
+	IdempotencyKeyInProgressError ErrorCode = 1006
+	PermissionDeniedError         ErrorCode = 1007
 
 	// user
 	EmailAlreadyRegisteredError ErrorCode = 2000
 	NotFoundUserError           ErrorCode = 2001
 	InvalidPasswordError        ErrorCode = 2002
+	InvalidTimezoneError        ErrorCode = 2003
+	InvalidVisibilityError      ErrorCode = 2004
+	UserDisabledError           ErrorCode = 2005
+	CannotDisableSelfError      ErrorCode = 2006
 
 	// book
-	NotFoundBookError ErrorCode = 3000
+	NotFoundBookError       ErrorCode = 3000
+	InvalidPurchaseError    ErrorCode = 3001
+	InvalidDurationError    ErrorCode = 3002
+	InvalidSyncError        ErrorCode = 3003
+	InvalidBatchError       ErrorCode = 3004
+	BatchRolledBackError    ErrorCode = 3005
+	VersionMismatchError    ErrorCode = 3006
+	NotFoundCatalogError    ErrorCode = 3007
+	InvalidCatalogKindError ErrorCode = 3008
+	InvalidMergeError       ErrorCode = 3009
+
+	// reading
+	InvalidDateRangeError ErrorCode = 4000
+	InvalidYearError      ErrorCode = 4001
+	InvalidPriorityError  ErrorCode = 4002
+	InvalidQueueError     ErrorCode = 4003
+	EmptyQueueError       ErrorCode = 4004
+
+	// loan
+	NotFoundLoanError        ErrorCode = 5000
+	InvalidBorrowerError     ErrorCode = 5001
+	BookNotOwnedError        ErrorCode = 5002
+	BookAlreadyLentError     ErrorCode = 5003
+	LoanAlreadyReturnedError ErrorCode = 5004
+
+	// follow
+	CannotFollowSelfError ErrorCode = 6000
+	AlreadyFollowingError ErrorCode = 6001
+	NotFollowingError     ErrorCode = 6002
+
+	// club
+	NotFoundClubError         ErrorCode = 7000
+	NotClubMemberError        ErrorCode = 7001
+	InsufficientClubRoleError ErrorCode = 7002
+	AlreadyClubMemberError    ErrorCode = 7003
+	AlreadyInvitedError       ErrorCode = 7004
+	NotInvitedError           ErrorCode = 7005
+	OwnerCannotLeaveError     ErrorCode = 7006
+	InvalidClubRoleError      ErrorCode = 7007
+	InvalidScheduleError      ErrorCode = 7008
+	NotFoundSectionError      ErrorCode = 7009
+	NoCurrentBookError        ErrorCode = 7010
+	InvalidProgressError      ErrorCode = 7011
+
+	// webhook
+	NotFoundWebhookError     ErrorCode = 8000
+	InvalidWebhookURLError   ErrorCode = 8001
+	InvalidWebhookEventError ErrorCode = 8002
+	TooManyWebhooksError     ErrorCode = 8003
 )
 
 func newError(statusCode StatusCode, errorCode ErrorCode, message string) *Error {
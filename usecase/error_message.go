@@ -0,0 +1,248 @@
+package usecase
+
+import "readly/util"
+
+// errorMessages 利用者に表示するErrorCodeごとのメッセージ
+// Error.Messageは開発者向けの詳細なメッセージとしてそのまま残す
+var errorMessages = map[ErrorCode]util.Message{
+	// common
+	InternalServerError: {
+		util.Japanese: "サーバーでエラーが発生しました。時間をおいて再度お試しください",
+		util.English:  "An internal server error occurred. Please try again later",
+	},
+	InvalidTokenError: {
+		util.Japanese: "認証の有効期限が切れているか、認証情報が正しくありません。再度サインインしてください",
+		util.English:  "Your session is invalid or has expired. Please sign in again",
+	},
+	InvalidCursorError: {
+		util.Japanese: "ページ送りや同期のトークンが正しくありません",
+		util.English:  "The cursor or token is invalid",
+	},
+	StreamLaggedError: {
+		util.Japanese: "更新の受信が遅れたため接続を終了しました。再接続してください",
+		util.English:  "The stream fell behind. Please reconnect",
+	},
+	InvalidIdempotencyKeyError: {
+		util.Japanese: "Idempotency-Keyは1文字以上255文字以下で指定してください",
+		util.English:  "Idempotency-Key must be between 1 and 255 characters",
+	},
+	IdempotencyKeyMismatchError: {
+		util.Japanese: "このIdempotency-Keyは別のリクエストで使用されています",
+		util.English:  "This Idempotency-Key was already used with a different request",
+	},
+	IdempotencyKeyInProgressError: {
+		util.Japanese: "同じIdempotency-Keyのリクエストを処理中です。しばらくしてから再度お試しください",
+		util.English:  "A request with the same Idempotency-Key is being processed. Please retry later",
+	},
+	PermissionDeniedError: {
+		util.Japanese: "この操作を行う権限がありません",
+		util.English:  "You do not have permission to perform this operation",
+	},
+
+	// user
+	EmailAlreadyRegisteredError: {
+		util.Japanese: "このメールアドレスはすでに登録されています",
+		util.English:  "This email address is already registered",
+	},
+	NotFoundUserError: {
+		util.Japanese: "ユーザーが見つかりません",
+		util.English:  "User not found",
+	},
+	InvalidPasswordError: {
+		util.Japanese: "メールアドレスまたはパスワードが正しくありません",
+		util.English:  "The email address or password is incorrect",
+	},
+	InvalidTimezoneError: {
+		util.Japanese: "タイムゾーンが正しくありません",
+		util.English:  "The timezone is invalid",
+	},
+	InvalidVisibilityError: {
+		util.Japanese: "公開範囲の設定が正しくありません",
+		util.English:  "The visibility setting is invalid",
+	},
+	UserDisabledError: {
+		util.Japanese: "このアカウントは無効化されています",
+		util.English:  "This account has been disabled",
+	},
+	CannotDisableSelfError: {
+		util.Japanese: "自分のアカウントは無効化できません",
+		util.English:  "You cannot disable your own account",
+	},
+
+	// book
+	NotFoundBookError: {
+		util.Japanese: "本が見つかりません",
+		util.English:  "Book not found",
+	},
+	InvalidPurchaseError: {
+		util.Japanese: "購入価格と通貨の指定が正しくありません",
+		util.English:  "The purchase price or currency is invalid",
+	},
+	InvalidDurationError: {
+		util.Japanese: "再生時間はオーディオブックにのみ1分以上で指定できます",
+		util.English:  "Duration can only be set to a positive value for audiobooks",
+	},
+	InvalidSyncError: {
+		util.Japanese: "同期する変更の内容が正しくありません",
+		util.English:  "The sync changes are invalid",
+	},
+	InvalidBatchError: {
+		util.Japanese: "一度に登録できる本は1冊以上100冊以下です",
+		util.English:  "A batch must contain between 1 and 100 books",
+	},
+	BatchRolledBackError: {
+		util.Japanese: "ほかの本の登録に失敗したため登録されませんでした",
+		util.English:  "Not registered because another book in the batch failed",
+	},
+	VersionMismatchError: {
+		util.Japanese: "ほかの操作で更新されています。最新の内容を取得してから再度お試しください",
+		util.English:  "This was modified by another request. Please reload and try again",
+	},
+	NotFoundCatalogError: {
+		util.Japanese: "著者・出版社・ジャンルが見つかりません",
+		util.English:  "Author, publisher or genre not found",
+	},
+	InvalidCatalogKindError: {
+		util.Japanese: "著者・出版社・ジャンルのいずれかを指定してください",
+		util.English:  "Specify author, publisher or genre",
+	},
+	InvalidMergeError: {
+		util.Japanese: "統合元と統合先には異なる名前を指定してください",
+		util.English:  "Specify different names to merge",
+	},
+
+	// reading
+	InvalidDateRangeError: {
+		util.Japanese: "期間の指定が正しくありません",
+		util.English:  "The date range is invalid",
+	},
+	InvalidYearError: {
+		util.Japanese: "年の指定が正しくありません",
+		util.English:  "The year is invalid",
+	},
+	InvalidPriorityError: {
+		util.Japanese: "優先度は0以上5以下で指定してください",
+		util.English:  "Priority must be between 0 and 5",
+	},
+	InvalidQueueError: {
+		util.Japanese: "積読リストの本と一致しません",
+		util.English:  "The books do not match the reading queue",
+	},
+	EmptyQueueError: {
+		util.Japanese: "積読リストに本がありません",
+		util.English:  "The reading queue is empty",
+	},
+
+	// loan
+	NotFoundLoanError: {
+		util.Japanese: "貸し出し記録が見つかりません",
+		util.English:  "Loan not found",
+	},
+	InvalidBorrowerError: {
+		util.Japanese: "貸し出し先の指定が正しくありません",
+		util.English:  "The borrower is invalid",
+	},
+	BookNotOwnedError: {
+		util.Japanese: "所有していない本は貸し出せません",
+		util.English:  "You cannot lend a book you do not own",
+	},
+	BookAlreadyLentError: {
+		util.Japanese: "この本はすでに貸し出し中です",
+		util.English:  "This book is already lent",
+	},
+	LoanAlreadyReturnedError: {
+		util.Japanese: "この本はすでに返却されています",
+		util.English:  "This book has already been returned",
+	},
+
+	// follow
+	CannotFollowSelfError: {
+		util.Japanese: "自分自身はフォローできません",
+		util.English:  "You cannot follow yourself",
+	},
+	AlreadyFollowingError: {
+		util.Japanese: "すでにフォローしています",
+		util.English:  "You are already following this user",
+	},
+	NotFollowingError: {
+		util.Japanese: "フォローしていません",
+		util.English:  "You are not following this user",
+	},
+
+	// club
+	NotFoundClubError: {
+		util.Japanese: "読書会が見つかりません",
+		util.English:  "Book club not found",
+	},
+	NotClubMemberError: {
+		util.Japanese: "読書会のメンバーではありません",
+		util.English:  "Not a member of the book club",
+	},
+	InsufficientClubRoleError: {
+		util.Japanese: "この操作を行う権限がありません",
+		util.English:  "You do not have permission to do this",
+	},
+	AlreadyClubMemberError: {
+		util.Japanese: "すでに読書会のメンバーです",
+		util.English:  "Already a member of the book club",
+	},
+	AlreadyInvitedError: {
+		util.Japanese: "すでに招待されています",
+		util.English:  "The user is already invited",
+	},
+	NotInvitedError: {
+		util.Japanese: "読書会に招待されていません",
+		util.English:  "You are not invited to the book club",
+	},
+	OwnerCannotLeaveError: {
+		util.Japanese: "オーナーは権限を譲渡してから退会してください",
+		util.English:  "The owner must transfer ownership before leaving",
+	},
+	InvalidClubRoleError: {
+		util.Japanese: "ロールの指定が正しくありません",
+		util.English:  "The role is invalid",
+	},
+	InvalidScheduleError: {
+		util.Japanese: "スケジュールの内容が正しくありません",
+		util.English:  "The schedule is invalid",
+	},
+	NotFoundSectionError: {
+		util.Japanese: "スケジュールの区切りが見つかりません",
+		util.English:  "Section not found",
+	},
+	NoCurrentBookError: {
+		util.Japanese: "読書会で読んでいる本がありません",
+		util.English:  "The book club has no current book",
+	},
+	InvalidProgressError: {
+		util.Japanese: "進捗のページ数が正しくありません",
+		util.English:  "The current page is invalid",
+	},
+
+	// webhook
+	NotFoundWebhookError: {
+		util.Japanese: "Webhookが見つかりません",
+		util.English:  "Webhook not found",
+	},
+	InvalidWebhookURLError: {
+		util.Japanese: "WebhookのURLが正しくありません",
+		util.English:  "The webhook URL is invalid",
+	},
+	InvalidWebhookEventError: {
+		util.Japanese: "Webhookのイベントの種類が正しくありません",
+		util.English:  "The webhook event type is invalid",
+	},
+	TooManyWebhooksError: {
+		util.Japanese: "登録できるWebhookの上限に達しています",
+		util.English:  "You have reached the maximum number of webhooks",
+	},
+}
+
+// LocalizedMessage カタログにないErrorCodeの場合はMessageを返す
+func (err *Error) LocalizedMessage(locale util.Locale) string {
+	message, ok := errorMessages[err.ErrorCode]
+	if !ok {
+		return err.Message
+	}
+	return message.Format(locale)
+}
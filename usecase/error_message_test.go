@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"github.com/stretchr/testify/require"
+	"readly/util"
+	"testing"
+)
+
+func TestErrorLocalizedMessage(t *testing.T) {
+	err := newError(NotFound, NotFoundUserError, "user not found")
+	require.Equal(t, "ユーザーが見つかりません", err.LocalizedMessage(util.Japanese))
+	require.Equal(t, "User not found", err.LocalizedMessage(util.English))
+
+	// カタログにない場合はMessageを使う
+	err = newError(BadRequest, ErrorCode(9999), "unknown")
+	require.Equal(t, "unknown", err.LocalizedMessage(util.Japanese))
+
+	for code, message := range errorMessages {
+		require.NotEmpty(t, message[util.Japanese], code)
+		require.NotEmpty(t, message[util.English], code)
+	}
+}
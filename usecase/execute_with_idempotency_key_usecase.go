@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/repository"
+	"time"
+)
+
+const maxIdempotencyKeyLength = 255
+
+type ExecuteWithIdempotencyKeyUseCase interface {
+	ExecuteWithIdempotencyKey(ctx context.Context, req ExecuteWithIdempotencyKeyRequest, fn func(ctx context.Context) ([]byte, error)) ([]byte, error)
+}
+
+type ExecuteWithIdempotencyKeyUseCaseImpl struct {
+	transactor         repository.Transactor
+	idempotencyKeyRepo repository.IdempotencyKeyRepository
+	ttl                time.Duration
+}
+
+func NewExecuteWithIdempotencyKeyUseCase(
+	transactor repository.Transactor,
+	idempotencyKeyRepo repository.IdempotencyKeyRepository,
+	ttl time.Duration,
+) ExecuteWithIdempotencyKeyUseCase {
+	return &ExecuteWithIdempotencyKeyUseCaseImpl{
+		transactor:         transactor,
+		idempotencyKeyRepo: idempotencyKeyRepo,
+		ttl:                ttl,
+	}
+}
+
+type ExecuteWithIdempotencyKeyRequest struct {
+	UserID int64
+	Key    string
+	// 別のRPCで同じキーが使われた場合も不一致として扱う
+	Method      string
+	RequestHash string
+}
+
+// ExecuteWithIdempotencyKey 初めてのキーであればfnを実行してその結果を保存し、保存済みのキーであればfnを実行せずに保存した結果を返す。
+// キーの登録、fn、結果の保存は1つのトランザクションで実行するため、fnの変更と保存した結果は必ず一緒にコミットされる。
+// 失敗した場合はキーも残らないため、同じキーで再試行できる
+func (u *ExecuteWithIdempotencyKeyUseCaseImpl) ExecuteWithIdempotencyKey(
+	ctx context.Context,
+	req ExecuteWithIdempotencyKeyRequest,
+	fn func(ctx context.Context) ([]byte, error),
+) (res []byte, err error) {
+	if len(req.Key) == 0 || len(req.Key) > maxIdempotencyKeyLength {
+		return nil, newError(BadRequest, InvalidIdempotencyKeyError, "idempotency key must be between 1 and 255 characters")
+	}
+
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		// 有効期限の切れたキーは同じキーで再び使えるように先に削除する
+		if err := u.idempotencyKeyRepo.DeleteExpired(ctx, req.UserID); err != nil {
+			return handle(err)
+		}
+		// 同じキーの処理中のリクエストがあれば、そのトランザクションが終わるまで待つ
+		err := u.idempotencyKeyRepo.Create(ctx, repository.CreateIdempotencyKeyRequest{
+			UserID:      req.UserID,
+			Key:         req.Key,
+			Method:      req.Method,
+			RequestHash: req.RequestHash,
+			ExpiresAt:   time.Now().Add(u.ttl),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			res, err = u.savedResponse(ctx, req)
+			return err
+		}
+		if err != nil {
+			return handle(err)
+		}
+
+		res, err = fn(ctx)
+		if err != nil {
+			return err
+		}
+		if res == nil {
+			// nilは処理中を表すため空のレスポンスと区別する
+			res = []byte{}
+		}
+		err = u.idempotencyKeyRepo.SaveResponse(ctx, repository.SaveIdempotencyKeyResponseRequest{
+			UserID:   req.UserID,
+			Key:      req.Key,
+			Response: res,
+		})
+		return handle(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (u *ExecuteWithIdempotencyKeyUseCaseImpl) savedResponse(ctx context.Context, req ExecuteWithIdempotencyKeyRequest) ([]byte, error) {
+	saved, err := u.idempotencyKeyRepo.Get(ctx, req.UserID, req.Key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// 最初のリクエストが失敗してキーが削除された
+			return nil, newError(Conflict, IdempotencyKeyInProgressError, "request with the same idempotency key was just processed, retry the request")
+		}
+		return nil, handle(err)
+	}
+	if saved.Method != req.Method || saved.RequestHash != req.RequestHash {
+		return nil, newError(BadRequest, IdempotencyKeyMismatchError, "idempotency key was already used with a different request")
+	}
+	if saved.Response == nil {
+		return nil, newError(Conflict, IdempotencyKeyInProgressError, "request with the same idempotency key is still being processed")
+	}
+	return saved.Response, nil
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"strings"
+	"testing"
+)
+
+func TestExecuteWithIdempotencyKey(t *testing.T) {
+	useCase := newTestExecuteWithIdempotencyKeyUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	libraryUseCase := newTestGetLibraryUseCase(t)
+
+	type call struct {
+		calls int
+	}
+	succeed := func(c *call, res string) func(ctx context.Context) ([]byte, error) {
+		return func(ctx context.Context) ([]byte, error) {
+			c.calls++
+			return []byte(res), nil
+		}
+	}
+	newRequest := func(t *testing.T) ExecuteWithIdempotencyKeyRequest {
+		return ExecuteWithIdempotencyKeyRequest{
+			UserID:      signUpTestUser(t).UserID,
+			Key:         testdata.RandomString(20),
+			Method:      "RegisterBook",
+			RequestHash: testdata.RandomString(64),
+		}
+	}
+
+	testCases := []struct {
+		name string
+		test func(t *testing.T)
+	}{
+		{
+			name: "Execute with idempotency key returns saved response for the same key",
+			test: func(t *testing.T) {
+				req := newRequest(t)
+				c := &call{}
+				res, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(c, "first"))
+				require.NoError(t, err)
+				require.Equal(t, "first", string(res))
+
+				res, err = useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(c, "second"))
+				require.NoError(t, err)
+				require.Equal(t, "first", string(res))
+				require.Equal(t, 1, c.calls)
+			},
+		},
+		{
+			name: "Execute with idempotency key does not share keys between users",
+			test: func(t *testing.T) {
+				req := newRequest(t)
+				c := &call{}
+				_, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(c, "first"))
+				require.NoError(t, err)
+
+				req.UserID = signUpTestUser(t).UserID
+				res, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(c, "second"))
+				require.NoError(t, err)
+				require.Equal(t, "second", string(res))
+				require.Equal(t, 2, c.calls)
+			},
+		},
+		{
+			name: "Execute with idempotency key failure when payload is different",
+			test: func(t *testing.T) {
+				req := newRequest(t)
+				c := &call{}
+				_, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(c, "first"))
+				require.NoError(t, err)
+
+				req.RequestHash = testdata.RandomString(64)
+				_, err = useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(c, "second"))
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, IdempotencyKeyMismatchError, e.ErrorCode)
+				require.Equal(t, 1, c.calls)
+			},
+		},
+		{
+			name: "Execute with idempotency key failure when the first request is in progress",
+			test: func(t *testing.T) {
+				req := newRequest(t)
+				c := &call{}
+				_, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, func(ctx context.Context) ([]byte, error) {
+					_, err := useCase.ExecuteWithIdempotencyKey(ctx, req, succeed(c, "second"))
+					return nil, err
+				})
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, Conflict, e.StatusCode)
+				require.Equal(t, IdempotencyKeyInProgressError, e.ErrorCode)
+				require.Zero(t, c.calls)
+			},
+		},
+		{
+			name: "Execute with idempotency key allows retry after failure",
+			test: func(t *testing.T) {
+				req := newRequest(t)
+				_, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, func(ctx context.Context) ([]byte, error) {
+					return nil, errors.New("failed")
+				})
+				require.Error(t, err)
+
+				c := &call{}
+				res, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(c, "retried"))
+				require.NoError(t, err)
+				require.Equal(t, "retried", string(res))
+				require.Equal(t, 1, c.calls)
+			},
+		},
+		{
+			name: "Execute with idempotency key rolls back changes with the key on failure",
+			test: func(t *testing.T) {
+				req := newRequest(t)
+				register := func(ctx context.Context) error {
+					_, err := registerBookUseCase.RegisterBook(ctx, RegisterBookRequest{
+						UserID: req.UserID,
+						Title:  testdata.RandomString(10),
+						Status: entity.Unread,
+					})
+					return err
+				}
+				_, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, func(ctx context.Context) ([]byte, error) {
+					if err := register(ctx); err != nil {
+						return nil, err
+					}
+					return nil, errors.New("failed")
+				})
+				require.Error(t, err)
+
+				res, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, func(ctx context.Context) ([]byte, error) {
+					return []byte("retried"), register(ctx)
+				})
+				require.NoError(t, err)
+				require.Equal(t, "retried", string(res))
+
+				books, err := libraryUseCase.GetLibrary(context.Background(), GetLibraryRequest{UserID: req.UserID, Limit: 10})
+				require.NoError(t, err)
+				require.Len(t, books, 1)
+			},
+		},
+		{
+			name: "Execute with idempotency key failure when key is too long",
+			test: func(t *testing.T) {
+				req := newRequest(t)
+				req.Key = strings.Repeat("a", maxIdempotencyKeyLength+1)
+				_, err := useCase.ExecuteWithIdempotencyKey(context.Background(), req, succeed(&call{}, "first"))
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, InvalidIdempotencyKeyError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, tc.test)
+	}
+}
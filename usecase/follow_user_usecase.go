@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/lib/pq"
+	"readly/repository"
+)
+
+type FollowUserUseCase interface {
+	FollowUser(ctx context.Context, req FollowUserRequest) error
+}
+
+type FollowUserUseCaseImpl struct {
+	followRepo repository.FollowRepository
+	userRepo   repository.UserRepository
+}
+
+func NewFollowUserUseCase(
+	followRepo repository.FollowRepository,
+	userRepo repository.UserRepository,
+) FollowUserUseCase {
+	return &FollowUserUseCaseImpl{
+		followRepo: followRepo,
+		userRepo:   userRepo,
+	}
+}
+
+type FollowUserRequest struct {
+	FollowerID int64
+	FolloweeID int64
+}
+
+func (u *FollowUserUseCaseImpl) FollowUser(ctx context.Context, req FollowUserRequest) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	if req.FollowerID == req.FolloweeID {
+		return newError(BadRequest, CannotFollowSelfError, "cannot follow yourself")
+	}
+	_, err = u.userRepo.GetUserByID(ctx, req.FolloweeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return newError(NotFound, NotFoundUserError, "user not found")
+		}
+		return err
+	}
+
+	err = u.followRepo.Create(ctx, repository.FollowRequest{
+		FollowerID: req.FollowerID,
+		FolloweeID: req.FolloweeID,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return newError(Conflict, AlreadyFollowingError, "already following")
+		}
+		return err
+	}
+	return nil
+}
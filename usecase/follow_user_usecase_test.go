@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFollowUser(t *testing.T) {
+	followUseCase := newTestFollowUserUseCase(t)
+	unfollowUseCase := newTestUnfollowUserUseCase(t)
+	followersUseCase := newTestListFollowersUseCase(t)
+	followingUseCase := newTestListFollowingUseCase(t)
+
+	follower := signUpTestUser(t)
+	followee := signUpTestUser(t)
+
+	testCases := []struct {
+		name  string
+		req   FollowUserRequest
+		check func(t *testing.T, err error)
+	}{
+		{
+			name: "Follow user success",
+			req:  FollowUserRequest{FollowerID: follower.UserID, FolloweeID: followee.UserID},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+
+				followers, err := followersUseCase.ListFollowers(context.Background(), ListFollowsRequest{UserID: followee.UserID})
+				require.NoError(t, err)
+				require.Len(t, followers, 1)
+				require.Equal(t, follower.UserID, followers[0].ID)
+				require.Equal(t, follower.Name, followers[0].Name)
+
+				following, err := followingUseCase.ListFollowing(context.Background(), ListFollowsRequest{UserID: follower.UserID})
+				require.NoError(t, err)
+				require.Len(t, following, 1)
+				require.Equal(t, followee.UserID, following[0].ID)
+			},
+		},
+		{
+			name: "Follow user failure when already following",
+			req:  FollowUserRequest{FollowerID: follower.UserID, FolloweeID: followee.UserID},
+			check: func(t *testing.T, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, Conflict, e.StatusCode)
+				require.Equal(t, AlreadyFollowingError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Follow user failure when following yourself",
+			req:  FollowUserRequest{FollowerID: follower.UserID, FolloweeID: follower.UserID},
+			check: func(t *testing.T, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, CannotFollowSelfError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Follow user failure when user does not exist",
+			req:  FollowUserRequest{FollowerID: follower.UserID, FolloweeID: -1},
+			check: func(t *testing.T, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, NotFound, e.StatusCode)
+				require.Equal(t, NotFoundUserError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := followUseCase.FollowUser(context.Background(), tc.req)
+			tc.check(t, err)
+		})
+	}
+
+	err := unfollowUseCase.UnfollowUser(context.Background(), UnfollowUserRequest{FollowerID: follower.UserID, FolloweeID: followee.UserID})
+	require.NoError(t, err)
+	followers, err := followersUseCase.ListFollowers(context.Background(), ListFollowsRequest{UserID: followee.UserID})
+	require.NoError(t, err)
+	require.Empty(t, followers)
+
+	err = unfollowUseCase.UnfollowUser(context.Background(), UnfollowUserRequest{FollowerID: follower.UserID, FolloweeID: followee.UserID})
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, NotFound, e.StatusCode)
+	require.Equal(t, NotFollowingError, e.ErrorCode)
+}
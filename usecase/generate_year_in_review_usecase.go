@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+	"readly/service/report"
+	"time"
+)
+
+const favoriteGenreLimit = 3
+
+type GenerateYearInReviewUseCase interface {
+	GenerateYearInReview(ctx context.Context, req GenerateYearInReviewRequest) (*GenerateYearInReviewResponse, error)
+}
+
+type GenerateYearInReviewUseCaseImpl struct {
+	readingStatsRepo repository.ReadingStatsRepository
+	renderer         report.Renderer
+}
+
+func NewGenerateYearInReviewUseCase(
+	readingStatsRepo repository.ReadingStatsRepository,
+	renderer report.Renderer,
+) GenerateYearInReviewUseCase {
+	return &GenerateYearInReviewUseCaseImpl{
+		readingStatsRepo: readingStatsRepo,
+		renderer:         renderer,
+	}
+}
+
+type GenerateYearInReviewRequest struct {
+	UserID int64
+	Year   int
+}
+
+type GenerateYearInReviewResponse struct {
+	Review entity.YearInReview
+	HTML   string
+}
+
+func (u *GenerateYearInReviewUseCaseImpl) GenerateYearInReview(ctx context.Context, req GenerateYearInReviewRequest) (res *GenerateYearInReviewResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	if req.Year < 1 || req.Year > 9999 {
+		return nil, newError(BadRequest, InvalidYearError, "invalid year")
+	}
+
+	statsReq := repository.ReadingStatsRequest{
+		UserID: req.UserID,
+		From:   time.Date(req.Year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(req.Year, time.December, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	books, err := u.readingStatsRepo.GetFinishedBooks(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+	genres, err := u.readingStatsRepo.GetFinishedGenreCounts(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+	months, err := u.readingStatsRepo.GetFinishedBookCountsByMonth(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	review := entity.YearInReview{
+		Year:           req.Year,
+		FinishedBooks:  int64(len(books)),
+		FavoriteGenres: make([]entity.GenreCount, 0, favoriteGenreLimit),
+		Books:          make([]entity.ReviewedBook, len(books)),
+	}
+	for i, b := range books {
+		review.Books[i] = entity.ReviewedBook{
+			ID:            b.BookID,
+			Title:         b.Title,
+			AuthorName:    b.AuthorName,
+			CoverImageURL: b.CoverImageURL,
+			PageCount:     b.PageCount,
+			StartDate:     b.StartDate,
+			EndDate:       b.EndDate,
+		}
+		// ページ数が未登録の本は最長・最短の対象外とする
+		if b.PageCount == nil {
+			continue
+		}
+		review.TotalPages += int64(*b.PageCount)
+		if review.LongestBook == nil || *b.PageCount > *review.LongestBook.PageCount {
+			review.LongestBook = &review.Books[i]
+		}
+		if review.ShortestBook == nil || *b.PageCount < *review.ShortestBook.PageCount {
+			review.ShortestBook = &review.Books[i]
+		}
+	}
+	for i, g := range genres {
+		if i >= favoriteGenreLimit {
+			break
+		}
+		review.FavoriteGenres = append(review.FavoriteGenres, entity.GenreCount{
+			Name:  g.Name,
+			Count: g.Count,
+		})
+	}
+	// 読了数が同じ月がある場合は早い月を優先する
+	for _, m := range months {
+		if review.TopMonth == nil || m.Count > review.TopMonth.Count {
+			review.TopMonth = &entity.MonthCount{
+				Month: m.Month.Month(),
+				Count: m.Count,
+			}
+		}
+	}
+
+	html, err := u.renderer.RenderYearInReview(ctx, review)
+	if err != nil {
+		return nil, err
+	}
+	return &GenerateYearInReviewResponse{
+		Review: review,
+		HTML:   html,
+	}, nil
+}
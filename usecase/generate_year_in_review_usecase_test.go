@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"html/template"
+	"readly/entity"
+	"readly/service/report"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+// fakeCoverFetcher テストから外部に接続しないよう、表紙は取得せずにプレースホルダーを表示させる
+type fakeCoverFetcher struct{}
+
+func (fakeCoverFetcher) Fetch(context.Context, string) (template.URL, error) {
+	return "", report.ErrInvalidCover
+}
+
+func TestGenerateYearInReview(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	yearInReviewUseCase := newTestGenerateYearInReviewUseCase(t)
+
+	signUpReq := SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	}
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), signUpReq)
+	require.NoError(t, err)
+
+	genre := testdata.RandomString(6)
+	pageCounts := []int32{120, 480, 300}
+	endMonths := []time.Month{time.March, time.March, time.June}
+	titles := make([]string, len(pageCounts))
+	for i, pageCount := range pageCounts {
+		titles[i] = testdata.RandomString(10)
+		startDate := time.Date(2024, endMonths[i], 1, 0, 0, 0, 0, time.UTC)
+		endDate := startDate.AddDate(0, 0, 7)
+		_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID:    signUpRes.UserID,
+			Title:     titles[i],
+			Genres:    []string{genre},
+			PageCount: &pageCount,
+			Status:    entity.Done,
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		})
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name  string
+		req   GenerateYearInReviewRequest
+		check func(t *testing.T, res *GenerateYearInReviewResponse, err error)
+	}{
+		{
+			name: "Generate year in review success",
+			req: GenerateYearInReviewRequest{
+				UserID: signUpRes.UserID,
+				Year:   2024,
+			},
+			check: func(t *testing.T, res *GenerateYearInReviewResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2024, res.Review.Year)
+				require.Equal(t, int64(3), res.Review.FinishedBooks)
+				require.Equal(t, int64(900), res.Review.TotalPages)
+				require.Equal(t, titles[1], res.Review.LongestBook.Title)
+				require.Equal(t, titles[0], res.Review.ShortestBook.Title)
+				require.Len(t, res.Review.FavoriteGenres, 1)
+				require.Equal(t, genre, res.Review.FavoriteGenres[0].Name)
+				require.Equal(t, time.March, res.Review.TopMonth.Month)
+				require.Equal(t, int64(2), res.Review.TopMonth.Count)
+				require.Len(t, res.Review.Books, 3)
+				require.Contains(t, res.HTML, titles[0])
+			},
+		},
+		{
+			name: "Generate year in review success with no finished books",
+			req: GenerateYearInReviewRequest{
+				UserID: signUpRes.UserID,
+				Year:   2000,
+			},
+			check: func(t *testing.T, res *GenerateYearInReviewResponse, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.Review.FinishedBooks)
+				require.Nil(t, res.Review.LongestBook)
+				require.Nil(t, res.Review.TopMonth)
+				require.NotEmpty(t, res.HTML)
+			},
+		},
+		{
+			name: "Generate year in review failure if year is invalid",
+			req: GenerateYearInReviewRequest{
+				UserID: signUpRes.UserID,
+				Year:   0,
+			},
+			check: func(t *testing.T, res *GenerateYearInReviewResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidYearError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := yearInReviewUseCase.GenerateYearInReview(context.Background(), tc.req)
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"readly/repository"
+	"time"
+)
+
+// maxCalendarDays ヒートマップは最大1年分まで
+const maxCalendarDays = 366
+
+type GetActivityCalendarUseCase interface {
+	GetActivityCalendar(ctx context.Context, req GetActivityCalendarRequest) (*GetActivityCalendarResponse, error)
+}
+
+type GetActivityCalendarUseCaseImpl struct {
+	userRepo     repository.UserRepository
+	activityRepo repository.ReadingActivityRepository
+}
+
+func NewGetActivityCalendarUseCase(
+	userRepo repository.UserRepository,
+	activityRepo repository.ReadingActivityRepository,
+) GetActivityCalendarUseCase {
+	return &GetActivityCalendarUseCaseImpl{
+		userRepo:     userRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+type GetActivityCalendarRequest struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+}
+
+type DailyActivity struct {
+	Date  time.Time
+	Count int64
+}
+
+type GetActivityCalendarResponse struct {
+	From     time.Time
+	To       time.Time
+	Timezone string
+	Days     []DailyActivity
+}
+
+func (u *GetActivityCalendarUseCaseImpl) GetActivityCalendar(ctx context.Context, req GetActivityCalendarRequest) (res *GetActivityCalendarResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	if req.From.After(req.To) {
+		return nil, newError(BadRequest, InvalidDateRangeError, "from must be before to")
+	}
+	if req.To.Sub(req.From) > maxCalendarDays*24*time.Hour {
+		return nil, newError(BadRequest, InvalidDateRangeError, "date range is too long")
+	}
+
+	user, err := u.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, newError(BadRequest, NotFoundUserError, "user not found")
+	}
+
+	counts, err := u.activityRepo.GetDailyActivityCounts(ctx, repository.GetDailyActivityCountsRequest{
+		UserID:   req.UserID,
+		Timezone: user.Timezone,
+		From:     req.From,
+		To:       req.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &GetActivityCalendarResponse{
+		From:     req.From,
+		To:       req.To,
+		Timezone: user.Timezone,
+		Days:     make([]DailyActivity, len(counts)),
+	}
+	for i, c := range counts {
+		res.Days[i] = DailyActivity{
+			Date:  c.Date,
+			Count: c.Count,
+		}
+	}
+	return res, nil
+}
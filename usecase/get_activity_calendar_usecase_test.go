@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestGetActivityCalendar(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	calendarUseCase := newTestGetActivityCalendarUseCase(t)
+
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID: signUpRes.UserID,
+			Title:  testdata.RandomString(10),
+			Status: entity.Reading,
+		})
+		require.NoError(t, err)
+	}
+
+	now := time.Now().UTC()
+	testCases := []struct {
+		name  string
+		req   GetActivityCalendarRequest
+		check func(t *testing.T, res *GetActivityCalendarResponse, err error)
+	}{
+		{
+			name: "Get activity calendar success",
+			req: GetActivityCalendarRequest{
+				UserID: signUpRes.UserID,
+				From:   now.AddDate(0, 0, -7),
+				To:     now.AddDate(0, 0, 1),
+			},
+			check: func(t *testing.T, res *GetActivityCalendarResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Days, 1)
+				require.Equal(t, int64(2), res.Days[0].Count)
+				require.Equal(t, "UTC", res.Timezone)
+			},
+		},
+		{
+			name: "Get activity calendar failure if from is after to",
+			req: GetActivityCalendarRequest{
+				UserID: signUpRes.UserID,
+				From:   now,
+				To:     now.AddDate(0, 0, -1),
+			},
+			check: func(t *testing.T, res *GetActivityCalendarResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, InvalidDateRangeError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Get activity calendar failure if range is too long",
+			req: GetActivityCalendarRequest{
+				UserID: signUpRes.UserID,
+				From:   now.AddDate(-2, 0, 0),
+				To:     now,
+			},
+			check: func(t *testing.T, res *GetActivityCalendarResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, InvalidDateRangeError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := calendarUseCase.GetActivityCalendar(context.Background(), tc.req)
+			tc.check(t, res, err)
+		})
+	}
+}
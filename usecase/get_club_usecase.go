@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+	"slices"
+)
+
+type GetClubUseCase interface {
+	GetClub(ctx context.Context, req GetClubRequest) (*entity.BookClub, error)
+}
+
+type GetClubUseCaseImpl struct {
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+	bookRepo   repository.BookRepository
+}
+
+func NewGetClubUseCase(
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+	bookRepo repository.BookRepository,
+) GetClubUseCase {
+	return &GetClubUseCaseImpl{
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+		bookRepo:   bookRepo,
+	}
+}
+
+type GetClubRequest struct {
+	ClubID int64
+	UserID int64
+}
+
+// GetClub メンバーのみ閲覧でき、メンバー全員の進捗を含めて返す
+func (u *GetClubUseCaseImpl) GetClub(ctx context.Context, req GetClubRequest) (res *entity.BookClub, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	club, _, err := authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &entity.BookClub{
+		ID:          club.ID,
+		Name:        club.Name,
+		Description: club.Description,
+		Sections:    []entity.ClubSection{},
+		CreatedAt:   club.CreatedAt,
+	}
+	if club.CurrentBookID != nil {
+		book, err := u.bookRepo.GetBookByID(ctx, *club.CurrentBookID)
+		if err != nil {
+			return nil, err
+		}
+		res.CurrentBook = &entity.Book{
+			ID:            book.ID,
+			Title:         book.Title,
+			Genres:        book.Genres,
+			Description:   book.Description,
+			CoverImageURL: book.CoverImageURL,
+			URL:           book.URL,
+			AuthorName:    book.AuthorName,
+			PublisherName: book.PublisherName,
+			PublishDate:   book.PublishDate,
+			ISBN:          book.ISBN,
+			PageCount:     book.PageCount,
+		}
+		sections, err := u.clubRepo.GetSections(ctx, repository.GetClubSectionsRequest{
+			ClubID: club.ID,
+			BookID: book.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		res.Sections = newClubSections(sections)
+	}
+
+	members, err := u.memberRepo.GetByClub(ctx, club.ID)
+	if err != nil {
+		return nil, err
+	}
+	res.Members = make([]entity.BookClubMember, len(members))
+	for i, m := range members {
+		res.Members[i] = entity.BookClubMember{
+			UserID:            m.UserID,
+			Name:              m.Name,
+			Role:              m.Role.ToEntity(),
+			CurrentPage:       m.CurrentPage,
+			ProgressUpdatedAt: m.ProgressUpdatedAt,
+			JoinedAt:          m.JoinedAt,
+		}
+	}
+	return res, nil
+}
+
+// authorizeClubMember クラブのメンバーであることを確認する。rolesを指定した場合はいずれかの役割を持つ必要がある
+func authorizeClubMember(
+	ctx context.Context,
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+	clubID int64,
+	userID int64,
+	roles ...repository.ClubRole,
+) (*repository.BookClubResponse, *repository.ClubMemberResponse, error) {
+	club, err := clubRepo.GetByID(ctx, clubID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, newError(NotFound, NotFoundClubError, "club not found")
+		}
+		return nil, nil, err
+	}
+	member, err := memberRepo.Get(ctx, repository.GetClubMemberRequest{
+		ClubID: clubID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, newError(Forbidden, NotClubMemberError, "not a member of the club")
+		}
+		return nil, nil, err
+	}
+	if len(roles) > 0 && !slices.Contains(roles, member.Role) {
+		return nil, nil, newError(Forbidden, InsufficientClubRoleError, "insufficient club role")
+	}
+	return club, member, nil
+}
+
+func newClubSections(src []repository.ClubSectionResponse) []entity.ClubSection {
+	res := make([]entity.ClubSection, len(src))
+	for i, s := range src {
+		res[i] = entity.ClubSection{
+			ID:         s.ID,
+			Title:      s.Title,
+			Chapter:    s.Chapter,
+			TargetPage: s.TargetPage,
+			DueDate:    s.DueDate,
+		}
+	}
+	return res
+}
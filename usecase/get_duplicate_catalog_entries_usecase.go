@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+	"slices"
+)
+
+type GetDuplicateCatalogEntriesUseCase interface {
+	GetDuplicateCatalogEntries(ctx context.Context, req GetDuplicateCatalogEntriesRequest) ([]entity.DuplicateCandidate, error)
+}
+
+type GetDuplicateCatalogEntriesUseCaseImpl struct {
+	catalogRepo repository.CatalogRepository
+}
+
+func NewGetDuplicateCatalogEntriesUseCase(
+	catalogRepo repository.CatalogRepository,
+) GetDuplicateCatalogEntriesUseCase {
+	return &GetDuplicateCatalogEntriesUseCaseImpl{
+		catalogRepo: catalogRepo,
+	}
+}
+
+type GetDuplicateCatalogEntriesRequest struct {
+	Role entity.UserRole
+	// nilの場合は著者・出版社・ジャンルのすべてを対象にする
+	Kind *entity.CatalogKind
+}
+
+// GetDuplicateCatalogEntries 全角・半角、大文字・小文字、空白の違いを除くと同じになる名前を統合の候補として返す
+func (u *GetDuplicateCatalogEntriesUseCaseImpl) GetDuplicateCatalogEntries(ctx context.Context, req GetDuplicateCatalogEntriesRequest) (res []entity.DuplicateCandidate, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = authorize(req.Role, EditCatalog)
+	if err != nil {
+		return nil, err
+	}
+	kinds := catalogKinds
+	if req.Kind != nil {
+		if !slices.Contains(catalogKinds, *req.Kind) {
+			return nil, newError(BadRequest, InvalidCatalogKindError, "invalid catalog kind")
+		}
+		kinds = []entity.CatalogKind{*req.Kind}
+	}
+
+	res = []entity.DuplicateCandidate{}
+	for _, kind := range kinds {
+		duplicates, err := u.catalogRepo.GetDuplicates(ctx, kind)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range duplicates {
+			res = append(res, entity.DuplicateCandidate{
+				Kind:           kind,
+				NormalizedName: d.NormalizedName,
+				Names:          d.Names,
+			})
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"readly/entity"
+	"readly/repository"
+	"strconv"
+)
+
+const (
+	defaultFeedLimit int32 = 20
+	maxFeedLimit     int32 = 100
+)
+
+type GetFeedUseCase interface {
+	GetFeed(ctx context.Context, req GetFeedRequest) (*GetFeedResponse, error)
+}
+
+type GetFeedUseCaseImpl struct {
+	feedRepo repository.FeedRepository
+}
+
+func NewGetFeedUseCase(
+	feedRepo repository.FeedRepository,
+) GetFeedUseCase {
+	return &GetFeedUseCaseImpl{
+		feedRepo: feedRepo,
+	}
+}
+
+type GetFeedRequest struct {
+	UserID int64
+	// 前回のレスポンスのNextCursor。空の場合は最新から取得する
+	Cursor string
+	Limit  int32
+}
+
+type GetFeedResponse struct {
+	Events []entity.FeedEvent
+	// 続きがない場合は空
+	NextCursor string
+}
+
+func (u *GetFeedUseCaseImpl) GetFeed(ctx context.Context, req GetFeedRequest) (res *GetFeedResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultFeedLimit
+	}
+	if limit > maxFeedLimit {
+		limit = maxFeedLimit
+	}
+	var beforeID *int64
+	if req.Cursor != "" {
+		id, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return nil, newError(BadRequest, InvalidCursorError, "invalid cursor")
+		}
+		beforeID = &id
+	}
+
+	// 続きの有無を判定するため1件多く取得する
+	events, err := u.feedRepo.GetFeed(ctx, repository.GetFeedRequest{
+		UserID:   req.UserID,
+		BeforeID: beforeID,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &GetFeedResponse{}
+	if len(events) > int(limit) {
+		events = events[:limit]
+		res.NextCursor = encodeCursor(events[len(events)-1].ID)
+	}
+	res.Events = make([]entity.FeedEvent, len(events))
+	for i, e := range events {
+		res.Events[i] = entity.FeedEvent{
+			ID:       e.ID,
+			Type:     e.Type.ToEntity(),
+			UserID:   e.UserID,
+			UserName: e.UserName,
+			Book: entity.Book{
+				ID:            e.BookID,
+				Title:         e.Title,
+				AuthorName:    e.AuthorName,
+				CoverImageURL: e.CoverImageURL,
+			},
+			CreatedAt: e.CreatedAt,
+		}
+	}
+	return res, nil
+}
+
+// encodeCursor クライアントが内部のIDに依存しないように不透明な文字列にする
+func encodeCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(b), 10, 64)
+}
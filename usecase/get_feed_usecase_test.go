@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+)
+
+func TestGetFeed(t *testing.T) {
+	followUseCase := newTestFollowUserUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	getFeedUseCase := newTestGetFeedUseCase(t)
+	privacyUseCase := newTestUpdatePrivacySettingsUseCase(t)
+
+	follower := signUpTestUser(t)
+	followee := signUpTestUser(t)
+	err := followUseCase.FollowUser(context.Background(), FollowUserRequest{FollowerID: follower.UserID, FolloweeID: followee.UserID})
+	require.NoError(t, err)
+
+	statuses := []entity.ReadingStatus{entity.Unread, entity.Reading, entity.Done}
+	for _, s := range statuses {
+		_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID: followee.UserID,
+			Title:  testdata.RandomString(10),
+			Status: s,
+		})
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T) GetFeedRequest
+		check func(t *testing.T, res *GetFeedResponse, err error)
+	}{
+		{
+			name: "Get feed success",
+			setup: func(t *testing.T) GetFeedRequest {
+				return GetFeedRequest{UserID: follower.UserID}
+			},
+			check: func(t *testing.T, res *GetFeedResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Events, 3)
+				require.Empty(t, res.NextCursor)
+				require.Equal(t, entity.FinishedReading, res.Events[0].Type)
+				require.Equal(t, entity.StartedReading, res.Events[1].Type)
+				require.Equal(t, entity.AddedBook, res.Events[2].Type)
+				for _, e := range res.Events {
+					require.Equal(t, followee.UserID, e.UserID)
+					require.Equal(t, followee.Name, e.UserName)
+				}
+			},
+		},
+		{
+			name: "Get feed success with cursor",
+			setup: func(t *testing.T) GetFeedRequest {
+				res, err := getFeedUseCase.GetFeed(context.Background(), GetFeedRequest{UserID: follower.UserID, Limit: 2})
+				require.NoError(t, err)
+				require.Len(t, res.Events, 2)
+				require.NotEmpty(t, res.NextCursor)
+				return GetFeedRequest{UserID: follower.UserID, Cursor: res.NextCursor, Limit: 2}
+			},
+			check: func(t *testing.T, res *GetFeedResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Events, 1)
+				require.Equal(t, entity.AddedBook, res.Events[0].Type)
+				require.Empty(t, res.NextCursor)
+			},
+		},
+		{
+			name: "Get feed failure when cursor is invalid",
+			setup: func(t *testing.T) GetFeedRequest {
+				return GetFeedRequest{UserID: follower.UserID, Cursor: "invalid"}
+			},
+			check: func(t *testing.T, res *GetFeedResponse, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidCursorError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Get feed hides activity of private user",
+			setup: func(t *testing.T) GetFeedRequest {
+				private := entity.Private
+				_, err := privacyUseCase.UpdatePrivacySettings(context.Background(), UpdatePrivacySettingsRequest{
+					UserID:             followee.UserID,
+					ActivityVisibility: &private,
+				})
+				require.NoError(t, err)
+				return GetFeedRequest{UserID: follower.UserID}
+			},
+			check: func(t *testing.T, res *GetFeedResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Events)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := tc.setup(t)
+			res, err := getFeedUseCase.GetFeed(context.Background(), req)
+			tc.check(t, res, err)
+		})
+	}
+}
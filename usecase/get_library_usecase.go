@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	defaultLibraryLimit int32 = 20
+	maxLibraryLimit     int32 = 100
+)
+
+type GetLibraryUseCase interface {
+	GetLibrary(ctx context.Context, req GetLibraryRequest) ([]entity.Book, error)
+}
+
+type GetLibraryUseCaseImpl struct {
+	readingHistoryRepo repository.ReadingHistoryRepository
+}
+
+func NewGetLibraryUseCase(
+	readingHistoryRepo repository.ReadingHistoryRepository,
+) GetLibraryUseCase {
+	return &GetLibraryUseCaseImpl{
+		readingHistoryRepo: readingHistoryRepo,
+	}
+}
+
+type GetLibraryRequest struct {
+	UserID int64
+	Limit  int32
+	Offset int32
+}
+
+// GetLibrary 登録した本を登録順に返す
+func (u *GetLibraryUseCaseImpl) GetLibrary(ctx context.Context, req GetLibraryRequest) ([]entity.Book, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLibraryLimit
+	}
+	if limit > maxLibraryLimit {
+		limit = maxLibraryLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	histories, err := u.readingHistoryRepo.GetByUser(ctx, repository.GetReadingHistoryByUserRequest{
+		UserID: req.UserID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, handle(err)
+	}
+	return newLibraryBooks(histories), nil
+}
+
+func newLibraryBooks(histories []repository.GetReadingHistoryByUserResponse) []entity.Book {
+	books := make([]entity.Book, len(histories))
+	for i, h := range histories {
+		books[i] = entity.Book{
+			ID:               h.BookID,
+			Title:            h.Title,
+			Genres:           h.Genres,
+			Description:      h.Description,
+			CoverImageURL:    h.CoverImageURL,
+			URL:              h.URL,
+			AuthorName:       h.AuthorName,
+			PublisherName:    h.PublisherName,
+			PublishDate:      h.PublishDate,
+			ISBN:             h.ISBN,
+			PageCount:        h.PageCount,
+			Status:           h.Status.ToEntity(),
+			StartDate:        h.StartDate,
+			EndDate:          h.EndDate,
+			Priority:         h.Priority,
+			QueuePosition:    h.QueuePosition,
+			Owned:            h.Owned,
+			Overdue:          h.Overdue,
+			Format:           h.Format.ToEntity(),
+			PurchasePrice:    h.PurchasePrice,
+			PurchaseCurrency: h.PurchaseCurrency,
+			PurchaseStore:    h.PurchaseStore,
+			PurchaseDate:     h.PurchaseDate,
+			DurationMinutes:  h.DurationMinutes,
+			Version:          h.Version,
+			CatalogVersion:   h.BookVersion,
+		}
+	}
+	return books
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	defaultPublicBookLimit int32 = 20
+	maxPublicBookLimit     int32 = 100
+)
+
+type GetPublicProfileUseCase interface {
+	GetPublicProfile(ctx context.Context, req GetPublicProfileRequest) (*entity.PublicProfile, error)
+}
+
+type GetPublicProfileUseCaseImpl struct {
+	userRepo           repository.UserRepository
+	readingHistoryRepo repository.ReadingHistoryRepository
+}
+
+func NewGetPublicProfileUseCase(
+	userRepo repository.UserRepository,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+) GetPublicProfileUseCase {
+	return &GetPublicProfileUseCaseImpl{
+		userRepo:           userRepo,
+		readingHistoryRepo: readingHistoryRepo,
+	}
+}
+
+type GetPublicProfileRequest struct {
+	UserID int64
+	Limit  int32
+	Offset int32
+}
+
+// GetPublicProfile 未ログインのユーザーにも見せるため、プロフィールと本棚の公開範囲がpublicのもののみ返す
+func (u *GetPublicProfileUseCaseImpl) GetPublicProfile(ctx context.Context, req GetPublicProfileRequest) (res *entity.PublicProfile, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	user, err := u.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, newError(NotFound, NotFoundUserError, "user not found")
+		}
+		return nil, err
+	}
+	// 非公開のユーザーの存在を知られないように存在しない場合と同じエラーにする
+	if user.ProfileVisibility != repository.Public {
+		return nil, newError(NotFound, NotFoundUserError, "user not found")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultPublicBookLimit
+	}
+	if limit > maxPublicBookLimit {
+		limit = maxPublicBookLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	histories, err := u.readingHistoryRepo.GetPublicByUser(ctx, repository.GetPublicReadingHistoryByUserRequest{
+		UserID: req.UserID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &entity.PublicProfile{
+		UserID: user.ID,
+		Name:   user.Name,
+		Books:  make([]entity.Book, len(histories)),
+	}
+	for i, h := range histories {
+		res.Books[i] = entity.Book{
+			ID:            h.BookID,
+			Title:         h.Title,
+			Genres:        h.Genres,
+			Description:   h.Description,
+			CoverImageURL: h.CoverImageURL,
+			URL:           h.URL,
+			AuthorName:    h.AuthorName,
+			PublisherName: h.PublisherName,
+			PublishDate:   h.PublishDate,
+			ISBN:          h.ISBN,
+			PageCount:     h.PageCount,
+			Status:        h.Status.ToEntity(),
+			StartDate:     h.StartDate,
+			EndDate:       h.EndDate,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+)
+
+func TestGetPublicProfile(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	privacyUseCase := newTestUpdatePrivacySettingsUseCase(t)
+	getPublicProfileUseCase := newTestGetPublicProfileUseCase(t)
+
+	user := signUpTestUser(t)
+	var doneBook *entity.Book
+	for _, s := range []entity.ReadingStatus{entity.Unread, entity.Done} {
+		book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID: user.UserID,
+			Title:  testdata.RandomString(10),
+			Status: s,
+		})
+		require.NoError(t, err)
+		if s == entity.Done {
+			doneBook = book
+		}
+	}
+
+	public := entity.Public
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T) GetPublicProfileRequest
+		check func(t *testing.T, res *entity.PublicProfile, err error)
+	}{
+		{
+			name: "Get public profile failure when profile is private",
+			setup: func(t *testing.T) GetPublicProfileRequest {
+				return GetPublicProfileRequest{UserID: user.UserID}
+			},
+			check: func(t *testing.T, res *entity.PublicProfile, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, NotFound, e.StatusCode)
+				require.Equal(t, NotFoundUserError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Get public profile success without shared books",
+			setup: func(t *testing.T) GetPublicProfileRequest {
+				_, err := privacyUseCase.UpdatePrivacySettings(context.Background(), UpdatePrivacySettingsRequest{
+					UserID:            user.UserID,
+					ProfileVisibility: &public,
+				})
+				require.NoError(t, err)
+				return GetPublicProfileRequest{UserID: user.UserID}
+			},
+			check: func(t *testing.T, res *entity.PublicProfile, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.UserID, res.UserID)
+				require.Equal(t, user.Name, res.Name)
+				require.Empty(t, res.Books)
+			},
+		},
+		{
+			name: "Get public profile success with public shelf",
+			setup: func(t *testing.T) GetPublicProfileRequest {
+				res, err := privacyUseCase.UpdatePrivacySettings(context.Background(), UpdatePrivacySettingsRequest{
+					UserID:  user.UserID,
+					Shelves: []entity.ShelfVisibility{{Status: entity.Done, Visibility: entity.Public}},
+				})
+				require.NoError(t, err)
+				require.Equal(t, entity.Private, res.LibraryVisibility)
+				require.Len(t, res.Shelves, 1)
+				return GetPublicProfileRequest{UserID: user.UserID}
+			},
+			check: func(t *testing.T, res *entity.PublicProfile, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Books, 1)
+				require.Equal(t, doneBook.ID, res.Books[0].ID)
+				require.Equal(t, entity.Done, res.Books[0].Status)
+			},
+		},
+		{
+			name: "Get public profile success with public library",
+			setup: func(t *testing.T) GetPublicProfileRequest {
+				_, err := privacyUseCase.UpdatePrivacySettings(context.Background(), UpdatePrivacySettingsRequest{
+					UserID:            user.UserID,
+					LibraryVisibility: &public,
+				})
+				require.NoError(t, err)
+				return GetPublicProfileRequest{UserID: user.UserID}
+			},
+			check: func(t *testing.T, res *entity.PublicProfile, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Books, 2)
+			},
+		},
+		{
+			name: "Get public profile failure when user does not exist",
+			setup: func(t *testing.T) GetPublicProfileRequest {
+				return GetPublicProfileRequest{UserID: -1}
+			},
+			check: func(t *testing.T, res *entity.PublicProfile, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, NotFound, e.StatusCode)
+				require.Equal(t, NotFoundUserError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := tc.setup(t)
+			res, err := getPublicProfileUseCase.GetPublicProfile(context.Background(), req)
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type GetReadingQueueUseCase interface {
+	GetReadingQueue(ctx context.Context, req GetReadingQueueRequest) ([]entity.Book, error)
+}
+
+type GetReadingQueueUseCaseImpl struct {
+	readingHistoryRepo repository.ReadingHistoryRepository
+}
+
+func NewGetReadingQueueUseCase(
+	readingHistoryRepo repository.ReadingHistoryRepository,
+) GetReadingQueueUseCase {
+	return &GetReadingQueueUseCaseImpl{
+		readingHistoryRepo: readingHistoryRepo,
+	}
+}
+
+type GetReadingQueueRequest struct {
+	UserID int64
+}
+
+func (u *GetReadingQueueUseCaseImpl) GetReadingQueue(ctx context.Context, req GetReadingQueueRequest) ([]entity.Book, error) {
+	queue, err := u.readingHistoryRepo.GetQueue(ctx, req.UserID)
+	if err != nil {
+		return nil, handle(err)
+	}
+	return newQueuedBooks(queue), nil
+}
+
+func newQueuedBook(q repository.GetReadingQueueResponse) entity.Book {
+	position := q.QueuePosition
+	return entity.Book{
+		ID:            q.BookID,
+		Title:         q.Title,
+		Genres:        q.Genres,
+		Description:   q.Description,
+		CoverImageURL: q.CoverImageURL,
+		URL:           q.URL,
+		AuthorName:    q.AuthorName,
+		PublisherName: q.PublisherName,
+		PublishDate:   q.PublishDate,
+		ISBN:          q.ISBN,
+		Status:        q.Status.ToEntity(),
+		Priority:      q.Priority,
+		QueuePosition: &position,
+		Owned:         q.Owned,
+		Overdue:       q.Overdue,
+		Version:       q.Version,
+	}
+}
+
+func newQueuedBooks(queue []repository.GetReadingQueueResponse) []entity.Book {
+	books := make([]entity.Book, len(queue))
+	for i, q := range queue {
+		books[i] = newQueuedBook(q)
+	}
+	return books
+}
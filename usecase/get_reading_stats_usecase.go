@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"readly/repository"
+	"time"
+)
+
+const topRankingLimit = 5
+
+type GetReadingStatsUseCase interface {
+	GetReadingStats(ctx context.Context, req GetReadingStatsRequest) (*GetReadingStatsResponse, error)
+}
+
+type GetReadingStatsUseCaseImpl struct {
+	readingStatsRepo repository.ReadingStatsRepository
+}
+
+func NewGetReadingStatsUseCase(
+	readingStatsRepo repository.ReadingStatsRepository,
+) GetReadingStatsUseCase {
+	return &GetReadingStatsUseCaseImpl{
+		readingStatsRepo: readingStatsRepo,
+	}
+}
+
+type GetReadingStatsRequest struct {
+	UserID int64
+	From   time.Time
+	To     time.Time
+}
+
+type MonthlyCount struct {
+	Month time.Time
+	Count int64
+}
+
+type NameCount struct {
+	Name  string
+	Count int64
+}
+
+type GetReadingStatsResponse struct {
+	From                time.Time
+	To                  time.Time
+	FinishedBooks       int64
+	FinishedByMonth     []MonthlyCount
+	Genres              []NameCount
+	TopAuthors          []NameCount
+	TopPublishers       []NameCount
+	AverageDaysToFinish float64
+}
+
+func (u *GetReadingStatsUseCaseImpl) GetReadingStats(ctx context.Context, req GetReadingStatsRequest) (res *GetReadingStatsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	if req.From.After(req.To) {
+		return nil, newError(BadRequest, InvalidDateRangeError, "from must be before to")
+	}
+
+	statsReq := repository.ReadingStatsRequest{
+		UserID: req.UserID,
+		From:   req.From,
+		To:     req.To,
+	}
+	rankingReq := repository.ReadingRankingRequest{
+		UserID: req.UserID,
+		From:   req.From,
+		To:     req.To,
+		Limit:  topRankingLimit,
+	}
+
+	months, err := u.readingStatsRepo.GetFinishedBookCountsByMonth(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+	genres, err := u.readingStatsRepo.GetFinishedGenreCounts(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+	authors, err := u.readingStatsRepo.GetFinishedAuthorCounts(ctx, rankingReq)
+	if err != nil {
+		return nil, err
+	}
+	publishers, err := u.readingStatsRepo.GetFinishedPublisherCounts(ctx, rankingReq)
+	if err != nil {
+		return nil, err
+	}
+	average, err := u.readingStatsRepo.GetAverageReadingDays(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &GetReadingStatsResponse{
+		From:                req.From,
+		To:                  req.To,
+		FinishedByMonth:     make([]MonthlyCount, len(months)),
+		Genres:              newNameCounts(genres),
+		TopAuthors:          newNameCounts(authors),
+		TopPublishers:       newNameCounts(publishers),
+		AverageDaysToFinish: average.AverageDays,
+	}
+	for i, m := range months {
+		res.FinishedByMonth[i] = MonthlyCount{
+			Month: m.Month,
+			Count: m.Count,
+		}
+		res.FinishedBooks += m.Count
+	}
+	return res, nil
+}
+
+func newNameCounts(src []repository.NameCountResponse) []NameCount {
+	res := make([]NameCount, len(src))
+	for i, s := range src {
+		res[i] = NameCount{
+			Name:  s.Name,
+			Count: s.Count,
+		}
+	}
+	return res
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestGetReadingStats(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	readingStatsUseCase := newTestGetReadingStatsUseCase(t)
+
+	signUpReq := SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	}
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), signUpReq)
+	require.NoError(t, err)
+
+	genre := testdata.RandomString(6)
+	author := testdata.RandomString(10)
+	for i := 0; i < 3; i++ {
+		startDate := time.Date(2024, time.Month(i+1), 1, 0, 0, 0, 0, time.UTC)
+		endDate := startDate.AddDate(0, 0, 10)
+		_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID:     signUpRes.UserID,
+			Title:      testdata.RandomString(10),
+			Genres:     []string{genre},
+			AuthorName: &author,
+			Status:     entity.Done,
+			StartDate:  &startDate,
+			EndDate:    &endDate,
+		})
+		require.NoError(t, err)
+	}
+	// 途中で読むのをやめた本は読了数に含めない
+	abandonedStart := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	abandonedEnd := abandonedStart.AddDate(0, 0, 3)
+	_, err = registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID:     signUpRes.UserID,
+		Title:      testdata.RandomString(10),
+		Genres:     []string{genre},
+		AuthorName: &author,
+		Status:     entity.Abandoned,
+		StartDate:  &abandonedStart,
+		EndDate:    &abandonedEnd,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		req   GetReadingStatsRequest
+		check func(t *testing.T, res *GetReadingStatsResponse, err error)
+	}{
+		{
+			name: "Get reading stats success",
+			req: GetReadingStatsRequest{
+				UserID: signUpRes.UserID,
+				From:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:     time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+			check: func(t *testing.T, res *GetReadingStatsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(3), res.FinishedBooks)
+				require.Len(t, res.FinishedByMonth, 3)
+				require.Len(t, res.Genres, 1)
+				require.Equal(t, genre, res.Genres[0].Name)
+				require.Equal(t, int64(3), res.Genres[0].Count)
+				require.Len(t, res.TopAuthors, 1)
+				require.Equal(t, author, res.TopAuthors[0].Name)
+				require.Empty(t, res.TopPublishers)
+				require.InDelta(t, 10.0, res.AverageDaysToFinish, 0.001)
+			},
+		},
+		{
+			name: "Get reading stats success with empty range",
+			req: GetReadingStatsRequest{
+				UserID: signUpRes.UserID,
+				From:   time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:     time.Date(2000, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+			check: func(t *testing.T, res *GetReadingStatsResponse, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.FinishedBooks)
+				require.Empty(t, res.FinishedByMonth)
+				require.Zero(t, res.AverageDaysToFinish)
+			},
+		},
+		{
+			name: "Get reading stats failure if from is after to",
+			req: GetReadingStatsRequest{
+				UserID: signUpRes.UserID,
+				From:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+				To:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			check: func(t *testing.T, res *GetReadingStatsResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidDateRangeError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := readingStatsUseCase.GetReadingStats(context.Background(), tc.req)
+			tc.check(t, res, err)
+		})
+	}
+}
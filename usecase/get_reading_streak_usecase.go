@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"readly/repository"
+	"time"
+)
+
+type GetReadingStreakUseCase interface {
+	GetReadingStreak(ctx context.Context, req GetReadingStreakRequest) (*GetReadingStreakResponse, error)
+}
+
+type GetReadingStreakUseCaseImpl struct {
+	userRepo     repository.UserRepository
+	activityRepo repository.ReadingActivityRepository
+}
+
+func NewGetReadingStreakUseCase(
+	userRepo repository.UserRepository,
+	activityRepo repository.ReadingActivityRepository,
+) GetReadingStreakUseCase {
+	return &GetReadingStreakUseCaseImpl{
+		userRepo:     userRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+type GetReadingStreakRequest struct {
+	UserID int64
+}
+
+type GetReadingStreakResponse struct {
+	CurrentStreak    int32
+	LongestStreak    int32
+	LastActivityDate *time.Time
+	Timezone         string
+}
+
+func (u *GetReadingStreakUseCaseImpl) GetReadingStreak(ctx context.Context, req GetReadingStreakRequest) (res *GetReadingStreakResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	user, err := u.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, newError(BadRequest, NotFoundUserError, "user not found")
+	}
+	loc, err := time.LoadLocation(user.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	dates, err := u.activityRepo.GetActivityDates(ctx, repository.GetActivityDatesRequest{
+		UserID:   req.UserID,
+		Timezone: user.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &GetReadingStreakResponse{
+		Timezone: user.Timezone,
+	}
+	if len(dates) == 0 {
+		return res, nil
+	}
+	res.LastActivityDate = &dates[0]
+	res.CurrentStreak, res.LongestStreak = calcStreaks(dates, toDate(time.Now(), loc))
+	return res, nil
+}
+
+// calcStreaks 新しい順に並んだ活動日から現在と最長の連続日数を求める
+// 当日にまだ活動がない場合でも前日まで続いていれば現在の連続日数として扱う
+func calcStreaks(dates []time.Time, today time.Time) (current int32, longest int32) {
+	var streak int32
+	for i, d := range dates {
+		if i > 0 && dates[i-1].AddDate(0, 0, -1).Equal(d) {
+			streak++
+		} else {
+			streak = 1
+		}
+		if streak > longest {
+			longest = streak
+		}
+		if i == int(streak)-1 {
+			current = streak
+		}
+	}
+	if today.Sub(dates[0]) > 24*time.Hour {
+		current = 0
+	}
+	return current, longest
+}
+
+// toDate タイムゾーン上の日付をDBのdate型と同じくUTCの0時で表す
+func toDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
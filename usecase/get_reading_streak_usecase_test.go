@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestGetReadingStreak(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	syncUseCase := newTestSyncUseCase(t)
+	popNextUseCase := newTestPopNextBookUseCase(t)
+	streakUseCase := newTestGetReadingStreakUseCase(t)
+
+	signUp := func(t *testing.T) int64 {
+		res, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+			Name:     testdata.RandomString(10),
+			Email:    testdata.RandomEmail(),
+			Password: testdata.RandomString(16),
+		})
+		require.NoError(t, err)
+		return res.UserID
+	}
+
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T) int64
+		check func(t *testing.T, res *GetReadingStreakResponse, err error)
+	}{
+		{
+			name: "Get reading streak success with today's activity",
+			setup: func(t *testing.T) int64 {
+				userID := signUp(t)
+				_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+					UserID: userID,
+					Title:  testdata.RandomString(10),
+					Status: entity.Reading,
+				})
+				require.NoError(t, err)
+				return userID
+			},
+			check: func(t *testing.T, res *GetReadingStreakResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(1), res.CurrentStreak)
+				require.Equal(t, int32(1), res.LongestStreak)
+				require.NotNil(t, res.LastActivityDate)
+				require.Equal(t, "UTC", res.Timezone)
+			},
+		},
+		{
+			name: "Get reading streak success without activity",
+			setup: func(t *testing.T) int64 {
+				userID := signUp(t)
+				_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+					UserID: userID,
+					Title:  testdata.RandomString(10),
+					Status: entity.Unread,
+				})
+				require.NoError(t, err)
+				return userID
+			},
+			check: func(t *testing.T, res *GetReadingStreakResponse, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.CurrentStreak)
+				require.Zero(t, res.LongestStreak)
+				require.Nil(t, res.LastActivityDate)
+			},
+		},
+		{
+			name: "Get reading streak success with status change by sync",
+			setup: func(t *testing.T) int64 {
+				userID := signUp(t)
+				book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+					UserID: userID,
+					Title:  testdata.RandomString(10),
+					Status: entity.Unread,
+				})
+				require.NoError(t, err)
+				res, err := syncUseCase.Sync(context.Background(), SyncRequest{
+					UserID: userID,
+					Histories: []SyncReadingHistoryChange{{
+						BookID:    book.ID,
+						Status:    entity.Done,
+						UpdatedAt: time.Now(),
+					}},
+				})
+				require.NoError(t, err)
+				require.Empty(t, res.Conflicts)
+				return userID
+			},
+			check: func(t *testing.T, res *GetReadingStreakResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(1), res.CurrentStreak)
+				require.NotNil(t, res.LastActivityDate)
+			},
+		},
+		{
+			name: "Get reading streak success with next book popped from queue",
+			setup: func(t *testing.T) int64 {
+				userID := signUp(t)
+				_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+					UserID: userID,
+					Title:  testdata.RandomString(10),
+					Status: entity.Unread,
+				})
+				require.NoError(t, err)
+				_, err = popNextUseCase.PopNextBook(context.Background(), PopNextBookRequest{UserID: userID})
+				require.NoError(t, err)
+				return userID
+			},
+			check: func(t *testing.T, res *GetReadingStreakResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(1), res.CurrentStreak)
+				require.NotNil(t, res.LastActivityDate)
+			},
+		},
+		{
+			name: "Get reading streak failure if user not found",
+			setup: func(t *testing.T) int64 {
+				return -1
+			},
+			check: func(t *testing.T, res *GetReadingStreakResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, NotFoundUserError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userID := tc.setup(t)
+			res, err := streakUseCase.GetReadingStreak(context.Background(), GetReadingStreakRequest{UserID: userID})
+			tc.check(t, res, err)
+		})
+	}
+}
+
+func TestCalcStreaks(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name    string
+		dates   []time.Time
+		today   time.Time
+		current int32
+		longest int32
+	}{
+		{
+			name:    "Continue until today",
+			dates:   []time.Time{day(10), day(9), day(8), day(5), day(4)},
+			today:   day(10),
+			current: 3,
+			longest: 3,
+		},
+		{
+			name:    "Continue until yesterday",
+			dates:   []time.Time{day(9), day(8)},
+			today:   day(10),
+			current: 2,
+			longest: 2,
+		},
+		{
+			name:    "Streak is broken",
+			dates:   []time.Time{day(7), day(3), day(2), day(1)},
+			today:   day(10),
+			current: 0,
+			longest: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			current, longest := calcStreaks(tc.dates, tc.today)
+			require.Equal(t, tc.current, current)
+			require.Equal(t, tc.longest, longest)
+		})
+	}
+}
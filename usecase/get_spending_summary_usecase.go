@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+	"sort"
+	"time"
+)
+
+type GetSpendingSummaryUseCase interface {
+	GetSpendingSummary(ctx context.Context, req GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error)
+}
+
+type GetSpendingSummaryUseCaseImpl struct {
+	readingStatsRepo repository.ReadingStatsRepository
+}
+
+func NewGetSpendingSummaryUseCase(
+	readingStatsRepo repository.ReadingStatsRepository,
+) GetSpendingSummaryUseCase {
+	return &GetSpendingSummaryUseCaseImpl{
+		readingStatsRepo: readingStatsRepo,
+	}
+}
+
+type GetSpendingSummaryRequest struct {
+	UserID int64
+	Year   int
+}
+
+// 金額は通貨ごとに集計し、異なる通貨間での換算は行わない
+type GetSpendingSummaryResponse struct {
+	Year    int
+	Totals  []CurrencyAmount
+	Months  []MonthlySpending
+	Formats []FormatSpending
+}
+
+type CurrencyAmount struct {
+	Currency  string
+	Amount    int64
+	BookCount int64
+}
+
+type MonthlySpending struct {
+	Month time.Time
+	CurrencyAmount
+}
+
+type FormatSpending struct {
+	Format entity.BookFormat
+	CurrencyAmount
+}
+
+func (u *GetSpendingSummaryUseCaseImpl) GetSpendingSummary(ctx context.Context, req GetSpendingSummaryRequest) (res *GetSpendingSummaryResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	if req.Year < 1 || req.Year > 9999 {
+		return nil, newError(BadRequest, InvalidYearError, "invalid year")
+	}
+
+	statsReq := repository.ReadingStatsRequest{
+		UserID: req.UserID,
+		From:   time.Date(req.Year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(req.Year, time.December, 31, 0, 0, 0, 0, time.UTC),
+	}
+	months, err := u.readingStatsRepo.GetMonthlySpending(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+	formats, err := u.readingStatsRepo.GetSpendingByFormat(ctx, statsReq)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &GetSpendingSummaryResponse{
+		Year:    req.Year,
+		Months:  make([]MonthlySpending, len(months)),
+		Formats: make([]FormatSpending, len(formats)),
+	}
+	totals := make(map[string]*CurrencyAmount)
+	for i, m := range months {
+		res.Months[i] = MonthlySpending{
+			Month: m.Month,
+			CurrencyAmount: CurrencyAmount{
+				Currency:  m.Currency,
+				Amount:    m.Amount,
+				BookCount: m.BookCount,
+			},
+		}
+		t, ok := totals[m.Currency]
+		if !ok {
+			t = &CurrencyAmount{Currency: m.Currency}
+			totals[m.Currency] = t
+		}
+		t.Amount += m.Amount
+		t.BookCount += m.BookCount
+	}
+	for i, f := range formats {
+		res.Formats[i] = FormatSpending{
+			Format: f.Format.ToEntity(),
+			CurrencyAmount: CurrencyAmount{
+				Currency:  f.Currency,
+				Amount:    f.Amount,
+				BookCount: f.BookCount,
+			},
+		}
+	}
+	res.Totals = make([]CurrencyAmount, 0, len(totals))
+	for _, t := range totals {
+		res.Totals = append(res.Totals, *t)
+	}
+	sort.Slice(res.Totals, func(i, j int) bool {
+		return res.Totals[i].Currency < res.Totals[j].Currency
+	})
+	return res, nil
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestGetSpendingSummary(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	spendingUseCase := newTestGetSpendingSummaryUseCase(t)
+	libraryUseCase := newTestGetLibraryUseCase(t)
+
+	signUpReq := SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	}
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), signUpReq)
+	require.NoError(t, err)
+
+	type purchase struct {
+		format   entity.BookFormat
+		price    int64
+		currency string
+		date     time.Time
+	}
+	purchases := []purchase{
+		{entity.Paperback, 1200, "JPY", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{entity.Hardcover, 2500, "JPY", time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)},
+		{entity.Ebook, 999, "usd", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		// 対象年外の購入は集計に含めない
+		{entity.Paperback, 800, "JPY", time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, p := range purchases {
+		_, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID:           signUpRes.UserID,
+			Title:            testdata.RandomString(10),
+			Status:           entity.Unread,
+			Format:           p.format,
+			PurchasePrice:    &p.price,
+			PurchaseCurrency: &p.currency,
+			PurchaseDate:     &p.date,
+		})
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name  string
+		req   GetSpendingSummaryRequest
+		check func(t *testing.T, res *GetSpendingSummaryResponse, err error)
+	}{
+		{
+			name: "Get spending summary success",
+			req: GetSpendingSummaryRequest{
+				UserID: signUpRes.UserID,
+				Year:   2024,
+			},
+			check: func(t *testing.T, res *GetSpendingSummaryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2024, res.Year)
+				require.Equal(t, []CurrencyAmount{
+					{Currency: "JPY", Amount: 3700, BookCount: 2},
+					{Currency: "USD", Amount: 999, BookCount: 1},
+				}, res.Totals)
+				require.Len(t, res.Months, 2)
+				require.Equal(t, time.January, res.Months[0].Month.Month())
+				require.Equal(t, int64(3700), res.Months[0].Amount)
+				require.Len(t, res.Formats, 3)
+			},
+		},
+		{
+			name: "Get spending summary success with no purchases",
+			req: GetSpendingSummaryRequest{
+				UserID: signUpRes.UserID,
+				Year:   2000,
+			},
+			check: func(t *testing.T, res *GetSpendingSummaryResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Totals)
+				require.Empty(t, res.Months)
+				require.Empty(t, res.Formats)
+			},
+		},
+		{
+			name: "Get spending summary failure if year is invalid",
+			req: GetSpendingSummaryRequest{
+				UserID: signUpRes.UserID,
+				Year:   0,
+			},
+			check: func(t *testing.T, res *GetSpendingSummaryResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidYearError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := spendingUseCase.GetSpendingSummary(context.Background(), tc.req)
+			tc.check(t, res, err)
+		})
+	}
+
+	books, err := libraryUseCase.GetLibrary(context.Background(), GetLibraryRequest{UserID: signUpRes.UserID})
+	require.NoError(t, err)
+	require.Len(t, books, len(purchases))
+	for _, b := range books {
+		require.NotNil(t, b.PurchasePrice)
+		require.NotNil(t, b.PurchaseCurrency)
+		require.NotEqual(t, entity.FormatUnspecified, b.Format)
+	}
+}
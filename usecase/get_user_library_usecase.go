@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+type GetUserLibraryUseCase interface {
+	GetUserLibrary(ctx context.Context, req GetUserLibraryRequest) ([]entity.Book, error)
+}
+
+type GetUserLibraryUseCaseImpl struct {
+	transactor         repository.Transactor
+	userRepo           repository.UserRepository
+	readingHistoryRepo repository.ReadingHistoryRepository
+	auditLogRepo       repository.AuditLogRepository
+}
+
+func NewGetUserLibraryUseCase(
+	transactor repository.Transactor,
+	userRepo repository.UserRepository,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+	auditLogRepo repository.AuditLogRepository,
+) GetUserLibraryUseCase {
+	return &GetUserLibraryUseCaseImpl{
+		transactor:         transactor,
+		userRepo:           userRepo,
+		readingHistoryRepo: readingHistoryRepo,
+		auditLogRepo:       auditLogRepo,
+	}
+}
+
+type GetUserLibraryRequest struct {
+	ActorID int64
+	Role    entity.UserRole
+	UserID  int64
+	Limit   int32
+	Offset  int32
+}
+
+// GetUserLibrary 公開設定に関わらず他のユーザーの本棚を返す。管理者だけが実行できる
+func (u *GetUserLibraryUseCaseImpl) GetUserLibrary(ctx context.Context, req GetUserLibraryRequest) (res []entity.Book, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = authorize(req.Role, ManageUsers)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLibraryLimit
+	}
+	if limit > maxLibraryLimit {
+		limit = maxLibraryLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		_, err := u.userRepo.GetUserByID(ctx, req.UserID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return newError(NotFound, NotFoundUserError, "user not found")
+			}
+			return err
+		}
+		histories, err := u.readingHistoryRepo.GetByUser(ctx, repository.GetReadingHistoryByUserRequest{
+			UserID: req.UserID,
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		err = u.auditLogRepo.Create(ctx, repository.CreateAuditLogRequest{
+			ActorID:    req.ActorID,
+			Action:     entity.AuditViewLibrary,
+			TargetType: entity.AuditTargetUser,
+			TargetID:   &req.UserID,
+			Detail: map[string]any{
+				"limit":  limit,
+				"offset": offset,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		res = newLibraryBooks(histories)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
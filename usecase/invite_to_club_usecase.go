@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/lib/pq"
+	"readly/repository"
+)
+
+type InviteToClubUseCase interface {
+	InviteToClub(ctx context.Context, req InviteToClubRequest) error
+}
+
+type InviteToClubUseCaseImpl struct {
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+	userRepo   repository.UserRepository
+}
+
+func NewInviteToClubUseCase(
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+	userRepo repository.UserRepository,
+) InviteToClubUseCase {
+	return &InviteToClubUseCaseImpl{
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+		userRepo:   userRepo,
+	}
+}
+
+type InviteToClubRequest struct {
+	ClubID    int64
+	InviterID int64
+	InviteeID int64
+}
+
+// InviteToClub オーナーとモデレーターのみ招待できる
+func (u *InviteToClubUseCaseImpl) InviteToClub(ctx context.Context, req InviteToClubRequest) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	_, _, err = authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.InviterID, repository.ClubOwner, repository.ClubModerator)
+	if err != nil {
+		return err
+	}
+	_, err = u.userRepo.GetUserByID(ctx, req.InviteeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return newError(NotFound, NotFoundUserError, "user not found")
+		}
+		return err
+	}
+	_, err = u.memberRepo.Get(ctx, repository.GetClubMemberRequest{
+		ClubID: req.ClubID,
+		UserID: req.InviteeID,
+	})
+	if err == nil {
+		return newError(Conflict, AlreadyClubMemberError, "user is already a member of the club")
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	err = u.memberRepo.CreateInvitation(ctx, repository.ClubInvitationRequest{
+		ClubID:    req.ClubID,
+		InviteeID: req.InviteeID,
+		InviterID: req.InviterID,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return newError(Conflict, AlreadyInvitedError, "user is already invited")
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/repository"
+)
+
+type JoinClubUseCase interface {
+	JoinClub(ctx context.Context, req JoinClubRequest) error
+}
+
+type JoinClubUseCaseImpl struct {
+	transactor repository.Transactor
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+}
+
+func NewJoinClubUseCase(
+	transactor repository.Transactor,
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+) JoinClubUseCase {
+	return &JoinClubUseCaseImpl{
+		transactor: transactor,
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+type JoinClubRequest struct {
+	ClubID int64
+	UserID int64
+}
+
+// JoinClub 招待されているクラブのみ参加でき、参加すると招待は削除される
+func (u *JoinClubUseCaseImpl) JoinClub(ctx context.Context, req JoinClubRequest) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	_, err = u.clubRepo.GetByID(ctx, req.ClubID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return newError(NotFound, NotFoundClubError, "club not found")
+		}
+		return err
+	}
+	_, err = u.memberRepo.Get(ctx, repository.GetClubMemberRequest{
+		ClubID: req.ClubID,
+		UserID: req.UserID,
+	})
+	if err == nil {
+		return newError(Conflict, AlreadyClubMemberError, "already a member of the club")
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return u.transactor.Exec(ctx, func(ctx context.Context) error {
+		err := u.memberRepo.DeleteInvitation(ctx, repository.ClubInvitationRequest{
+			ClubID:    req.ClubID,
+			InviteeID: req.UserID,
+		})
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsDeleted) {
+				return newError(Forbidden, NotInvitedError, "not invited to the club")
+			}
+			return err
+		}
+		_, err = u.memberRepo.Create(ctx, repository.CreateClubMemberRequest{
+			ClubID: req.ClubID,
+			UserID: req.UserID,
+			Role:   repository.ClubMember,
+		})
+		return err
+	})
+}
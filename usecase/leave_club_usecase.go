@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"readly/repository"
+)
+
+type LeaveClubUseCase interface {
+	LeaveClub(ctx context.Context, req LeaveClubRequest) error
+}
+
+type LeaveClubUseCaseImpl struct {
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+}
+
+func NewLeaveClubUseCase(
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+) LeaveClubUseCase {
+	return &LeaveClubUseCaseImpl{
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+type LeaveClubRequest struct {
+	ClubID int64
+	UserID int64
+}
+
+// LeaveClub オーナーがいないクラブにならないように、オーナーは他のメンバーに譲ってから退会する
+func (u *LeaveClubUseCaseImpl) LeaveClub(ctx context.Context, req LeaveClubRequest) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	_, member, err := authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if member.Role == repository.ClubOwner {
+		return newError(BadRequest, OwnerCannotLeaveError, "owner must transfer ownership before leaving")
+	}
+	return u.memberRepo.Delete(ctx, repository.GetClubMemberRequest{
+		ClubID: req.ClubID,
+		UserID: req.UserID,
+	})
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+	"strings"
+	"time"
+)
+
+type LendBookUseCase interface {
+	LendBook(ctx context.Context, req LendBookRequest) (*entity.Loan, error)
+}
+
+type LendBookUseCaseImpl struct {
+	transactor         repository.Transactor
+	readingHistoryRepo repository.ReadingHistoryRepository
+	loanRepo           repository.LoanRepository
+	userRepo           repository.UserRepository
+}
+
+func NewLendBookUseCase(
+	transactor repository.Transactor,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+	loanRepo repository.LoanRepository,
+	userRepo repository.UserRepository,
+) LendBookUseCase {
+	return &LendBookUseCaseImpl{
+		transactor:         transactor,
+		readingHistoryRepo: readingHistoryRepo,
+		loanRepo:           loanRepo,
+		userRepo:           userRepo,
+	}
+}
+
+type LendBookRequest struct {
+	UserID int64
+	BookID int64
+	// 登録ユーザーに貸す場合は省略でき、その場合はユーザー名を借り手の名前とする
+	BorrowerName   *string
+	BorrowerUserID *int64
+	// nilの場合はユーザーのタイムゾーンでの今日を貸出日とする
+	LoanDate *time.Time
+	DueDate  *time.Time
+}
+
+func (u *LendBookUseCaseImpl) LendBook(ctx context.Context, req LendBookRequest) (*entity.Loan, error) {
+	borrowerName := ""
+	if req.BorrowerName != nil {
+		borrowerName = strings.TrimSpace(*req.BorrowerName)
+	}
+	if borrowerName == "" && req.BorrowerUserID == nil {
+		return nil, newError(BadRequest, InvalidBorrowerError, "borrower name or user is required")
+	}
+	if req.BorrowerUserID != nil && *req.BorrowerUserID == req.UserID {
+		return nil, newError(BadRequest, InvalidBorrowerError, "cannot lend a book to yourself")
+	}
+
+	var res *entity.Loan
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
+		lender, err := u.userRepo.GetUserByID(ctx, req.UserID)
+		if err != nil {
+			return newError(BadRequest, NotFoundUserError, "user not found")
+		}
+		if req.BorrowerUserID != nil {
+			borrower, err := u.userRepo.GetUserByID(ctx, *req.BorrowerUserID)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return newError(NotFound, NotFoundUserError, "borrower not found")
+				}
+				return err
+			}
+			if borrowerName == "" {
+				borrowerName = borrower.Name
+			}
+		}
+
+		loanDate, err := u.loanDate(req.LoanDate, lender.Timezone)
+		if err != nil {
+			return err
+		}
+		var dueDate *time.Time
+		if req.DueDate != nil {
+			d := toDate(*req.DueDate, time.UTC)
+			if d.Before(loanDate) {
+				return newError(BadRequest, InvalidDateRangeError, "due date must be on or after loan date")
+			}
+			dueDate = &d
+		}
+
+		rh, err := u.readingHistoryRepo.GetByUserAndBook(ctx, repository.GetReadingHistoryByUserAndBookRequest{
+			UserID: req.UserID,
+			BookID: req.BookID,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return newError(NotFound, NotFoundBookError, "book not found")
+			}
+			return err
+		}
+		if !rh.Owned {
+			return newError(BadRequest, BookNotOwnedError, "cannot lend a book you do not own")
+		}
+
+		_, err = u.loanRepo.GetActiveByBook(ctx, repository.GetActiveLoanByBookRequest{
+			UserID: req.UserID,
+			BookID: req.BookID,
+		})
+		if err == nil {
+			return newError(Conflict, BookAlreadyLentError, "book is already lent")
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		created, err := u.loanRepo.Create(ctx, repository.CreateLoanRequest{
+			UserID:         req.UserID,
+			BookID:         req.BookID,
+			BorrowerName:   borrowerName,
+			BorrowerUserID: req.BorrowerUserID,
+			LoanDate:       loanDate,
+			DueDate:        dueDate,
+		})
+		if err != nil {
+			return err
+		}
+		loan, err := u.loanRepo.GetByID(ctx, repository.GetLoanByIDRequest{
+			ID:     created.ID,
+			UserID: req.UserID,
+		})
+		if err != nil {
+			return err
+		}
+		l := newLoan(*loan)
+		res = &l
+		return nil
+	})
+	return res, handle(err)
+}
+
+func (u *LendBookUseCaseImpl) loanDate(loanDate *time.Time, timezone string) (time.Time, error) {
+	if loanDate != nil {
+		return toDate(*loanDate, time.UTC), nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return toDate(time.Now(), loc), nil
+}
+
+func newLoan(l repository.LoanResponse) entity.Loan {
+	return entity.Loan{
+		ID: l.ID,
+		Book: entity.Book{
+			ID:            l.BookID,
+			Title:         l.Title,
+			Genres:        l.Genres,
+			Description:   l.Description,
+			CoverImageURL: l.CoverImageURL,
+			URL:           l.URL,
+			AuthorName:    l.AuthorName,
+			PublisherName: l.PublisherName,
+			PublishDate:   l.PublishDate,
+			ISBN:          l.ISBN,
+			Status:        l.Status.ToEntity(),
+			StartDate:     l.StartDate,
+			EndDate:       l.EndDate,
+			Priority:      l.Priority,
+			QueuePosition: l.QueuePosition,
+			Owned:         l.Owned,
+			Overdue:       l.Overdue,
+		},
+		BorrowerName:   l.BorrowerName,
+		BorrowerUserID: l.BorrowerUserID,
+		LoanDate:       l.LoanDate,
+		DueDate:        l.DueDate,
+		ReturnDate:     l.ReturnDate,
+		Overdue:        l.Overdue,
+	}
+}
+
+func newLoans(loans []repository.LoanResponse) []entity.Loan {
+	res := make([]entity.Loan, len(loans))
+	for i, l := range loans {
+		res[i] = newLoan(l)
+	}
+	return res
+}
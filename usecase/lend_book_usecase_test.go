@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestLendBook(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	lendBookUseCase := newTestLendBookUseCase(t)
+
+	signUp := func() *SignUpResponse {
+		res, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+			Name:     testdata.RandomString(10),
+			Email:    testdata.RandomEmail(),
+			Password: testdata.RandomString(16),
+		})
+		require.NoError(t, err)
+		return res
+	}
+	register := func(userID int64, owned bool) *entity.Book {
+		book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID: userID,
+			Title:  testdata.RandomString(10),
+			Status: entity.Done,
+			Owned:  &owned,
+		})
+		require.NoError(t, err)
+		return book
+	}
+
+	lender := signUp()
+	borrower := signUp()
+	lentBook := register(lender.UserID, true)
+	unownedBook := register(lender.UserID, false)
+
+	name := testdata.RandomString(10)
+	loanDate := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)
+	_, err := lendBookUseCase.LendBook(context.Background(), LendBookRequest{
+		UserID:       lender.UserID,
+		BookID:       lentBook.ID,
+		BorrowerName: &name,
+		LoanDate:     &loanDate,
+		DueDate:      &dueDate,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T) LendBookRequest
+		check func(t *testing.T, loan *entity.Loan, err error)
+	}{
+		{
+			name: "Lend book to registered user success",
+			setup: func(t *testing.T) LendBookRequest {
+				book := register(lender.UserID, true)
+				return LendBookRequest{
+					UserID:         lender.UserID,
+					BookID:         book.ID,
+					BorrowerUserID: &borrower.UserID,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.NoError(t, err)
+				require.NotZero(t, loan.ID)
+				require.Equal(t, borrower.Name, loan.BorrowerName)
+				require.Equal(t, borrower.UserID, *loan.BorrowerUserID)
+				require.Nil(t, loan.DueDate)
+				require.Nil(t, loan.ReturnDate)
+				require.False(t, loan.Overdue)
+				require.False(t, loan.Book.Overdue)
+			},
+		},
+		{
+			name: "Lend book with past due date is overdue",
+			setup: func(t *testing.T) LendBookRequest {
+				book := register(lender.UserID, true)
+				return LendBookRequest{
+					UserID:       lender.UserID,
+					BookID:       book.ID,
+					BorrowerName: &name,
+					LoanDate:     &loanDate,
+					DueDate:      &dueDate,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.NoError(t, err)
+				require.Equal(t, name, loan.BorrowerName)
+				require.Nil(t, loan.BorrowerUserID)
+				require.Equal(t, loanDate, loan.LoanDate.UTC())
+				require.Equal(t, dueDate, loan.DueDate.UTC())
+				require.True(t, loan.Overdue)
+				require.True(t, loan.Book.Overdue)
+			},
+		},
+		{
+			name: "Lend book failure if borrower is not specified",
+			setup: func(t *testing.T) LendBookRequest {
+				return LendBookRequest{
+					UserID: lender.UserID,
+					BookID: lentBook.ID,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.Nil(t, loan)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidBorrowerError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Lend book failure if borrower is the lender",
+			setup: func(t *testing.T) LendBookRequest {
+				return LendBookRequest{
+					UserID:         lender.UserID,
+					BookID:         lentBook.ID,
+					BorrowerUserID: &lender.UserID,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.Nil(t, loan)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, InvalidBorrowerError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Lend book failure if due date is before loan date",
+			setup: func(t *testing.T) LendBookRequest {
+				book := register(lender.UserID, true)
+				return LendBookRequest{
+					UserID:       lender.UserID,
+					BookID:       book.ID,
+					BorrowerName: &name,
+					LoanDate:     &dueDate,
+					DueDate:      &loanDate,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.Nil(t, loan)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidDateRangeError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Lend book failure if book is not owned",
+			setup: func(t *testing.T) LendBookRequest {
+				return LendBookRequest{
+					UserID:       lender.UserID,
+					BookID:       unownedBook.ID,
+					BorrowerName: &name,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.Nil(t, loan)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BookNotOwnedError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Lend book failure if book is not registered",
+			setup: func(t *testing.T) LendBookRequest {
+				return LendBookRequest{
+					UserID:       borrower.UserID,
+					BookID:       lentBook.ID,
+					BorrowerName: &name,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.Nil(t, loan)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, NotFound, e.StatusCode)
+				require.Equal(t, NotFoundBookError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Lend book failure if book is already lent",
+			setup: func(t *testing.T) LendBookRequest {
+				return LendBookRequest{
+					UserID:       lender.UserID,
+					BookID:       lentBook.ID,
+					BorrowerName: &name,
+				}
+			},
+			check: func(t *testing.T, loan *entity.Loan, err error) {
+				require.Nil(t, loan)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, Conflict, e.StatusCode)
+				require.Equal(t, BookAlreadyLentError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := tc.setup(t)
+			loan, err := lendBookUseCase.LendBook(context.Background(), req)
+			tc.check(t, loan, err)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type ListActiveLoansUseCase interface {
+	ListActiveLoans(ctx context.Context, req ListActiveLoansRequest) ([]entity.Loan, error)
+}
+
+type ListActiveLoansUseCaseImpl struct {
+	loanRepo repository.LoanRepository
+}
+
+func NewListActiveLoansUseCase(
+	loanRepo repository.LoanRepository,
+) ListActiveLoansUseCase {
+	return &ListActiveLoansUseCaseImpl{
+		loanRepo: loanRepo,
+	}
+}
+
+type ListActiveLoansRequest struct {
+	UserID int64
+}
+
+func (u *ListActiveLoansUseCaseImpl) ListActiveLoans(ctx context.Context, req ListActiveLoansRequest) ([]entity.Loan, error) {
+	loans, err := u.loanRepo.GetActive(ctx, req.UserID)
+	if err != nil {
+		return nil, handle(err)
+	}
+	return newLoans(loans), nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	defaultAuditLogsLimit int32 = 50
+	maxAuditLogsLimit     int32 = 200
+)
+
+type ListAuditLogsUseCase interface {
+	ListAuditLogs(ctx context.Context, req ListAuditLogsRequest) ([]entity.AuditLog, error)
+}
+
+type ListAuditLogsUseCaseImpl struct {
+	auditLogRepo repository.AuditLogRepository
+}
+
+func NewListAuditLogsUseCase(
+	auditLogRepo repository.AuditLogRepository,
+) ListAuditLogsUseCase {
+	return &ListAuditLogsUseCaseImpl{
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+type ListAuditLogsRequest struct {
+	Role entity.UserRole
+	// nilの場合は絞り込まない
+	TargetType *entity.AuditTargetType
+	TargetID   *int64
+	Limit      int32
+	Offset     int32
+}
+
+// ListAuditLogs 新しい順に返す
+func (u *ListAuditLogsUseCaseImpl) ListAuditLogs(ctx context.Context, req ListAuditLogsRequest) (res []entity.AuditLog, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = authorize(req.Role, ManageUsers)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultAuditLogsLimit
+	}
+	if limit > maxAuditLogsLimit {
+		limit = maxAuditLogsLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	logs, err := u.auditLogRepo.Get(ctx, repository.GetAuditLogsRequest{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = make([]entity.AuditLog, len(logs))
+	for i, l := range logs {
+		res[i] = entity.AuditLog{
+			ID:         l.ID,
+			ActorID:    l.ActorID,
+			Action:     l.Action,
+			TargetType: l.TargetType,
+			TargetID:   l.TargetID,
+			Detail:     l.Detail,
+			CreatedAt:  l.CreatedAt,
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	defaultClubPostLimit int32 = 50
+	maxClubPostLimit     int32 = 100
+)
+
+type ListClubDiscussionUseCase interface {
+	ListClubDiscussion(ctx context.Context, req ListClubDiscussionRequest) ([]entity.ClubPost, error)
+}
+
+type ListClubDiscussionUseCaseImpl struct {
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+	postRepo   repository.ClubPostRepository
+}
+
+func NewListClubDiscussionUseCase(
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+	postRepo repository.ClubPostRepository,
+) ListClubDiscussionUseCase {
+	return &ListClubDiscussionUseCaseImpl{
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+		postRepo:   postRepo,
+	}
+}
+
+type ListClubDiscussionRequest struct {
+	ClubID    int64
+	SectionID int64
+	UserID    int64
+	Limit     int32
+	Offset    int32
+}
+
+// ListClubDiscussion 投稿の古い順に返す
+func (u *ListClubDiscussionUseCaseImpl) ListClubDiscussion(ctx context.Context, req ListClubDiscussionRequest) (res []entity.ClubPost, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	_, _, err = authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	err = findClubSection(ctx, u.clubRepo, req.ClubID, req.SectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultClubPostLimit
+	}
+	if limit > maxClubPostLimit {
+		limit = maxClubPostLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	posts, err := u.postRepo.GetBySection(ctx, repository.GetClubPostsRequest{
+		SectionID: req.SectionID,
+		Limit:     limit,
+		Offset:    offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = make([]entity.ClubPost, len(posts))
+	for i, p := range posts {
+		res[i] = entity.ClubPost(p)
+	}
+	return res, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	defaultFollowLimit int32 = 20
+	maxFollowLimit     int32 = 100
+)
+
+type ListFollowersUseCase interface {
+	ListFollowers(ctx context.Context, req ListFollowsRequest) ([]entity.FollowUser, error)
+}
+
+type ListFollowersUseCaseImpl struct {
+	followRepo repository.FollowRepository
+}
+
+func NewListFollowersUseCase(
+	followRepo repository.FollowRepository,
+) ListFollowersUseCase {
+	return &ListFollowersUseCaseImpl{
+		followRepo: followRepo,
+	}
+}
+
+// ListFollowsRequest フォロワー・フォロー中の一覧で共通のリクエスト
+type ListFollowsRequest struct {
+	UserID int64
+	Limit  int32
+	Offset int32
+}
+
+func (r ListFollowsRequest) toRepository() repository.GetFollowsRequest {
+	limit := r.Limit
+	if limit <= 0 {
+		limit = defaultFollowLimit
+	}
+	if limit > maxFollowLimit {
+		limit = maxFollowLimit
+	}
+	offset := r.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	return repository.GetFollowsRequest{
+		UserID: r.UserID,
+		Limit:  limit,
+		Offset: offset,
+	}
+}
+
+func (u *ListFollowersUseCaseImpl) ListFollowers(ctx context.Context, req ListFollowsRequest) ([]entity.FollowUser, error) {
+	followers, err := u.followRepo.GetFollowers(ctx, req.toRepository())
+	if err != nil {
+		return nil, handle(err)
+	}
+	return newFollowUsers(followers), nil
+}
+
+func newFollowUsers(src []repository.FollowUserResponse) []entity.FollowUser {
+	res := make([]entity.FollowUser, len(src))
+	for i, f := range src {
+		res[i] = entity.FollowUser(f)
+	}
+	return res
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type ListFollowingUseCase interface {
+	ListFollowing(ctx context.Context, req ListFollowsRequest) ([]entity.FollowUser, error)
+}
+
+type ListFollowingUseCaseImpl struct {
+	followRepo repository.FollowRepository
+}
+
+func NewListFollowingUseCase(
+	followRepo repository.FollowRepository,
+) ListFollowingUseCase {
+	return &ListFollowingUseCaseImpl{
+		followRepo: followRepo,
+	}
+}
+
+func (u *ListFollowingUseCaseImpl) ListFollowing(ctx context.Context, req ListFollowsRequest) ([]entity.FollowUser, error) {
+	following, err := u.followRepo.GetFollowing(ctx, req.toRepository())
+	if err != nil {
+		return nil, handle(err)
+	}
+	return newFollowUsers(following), nil
+}
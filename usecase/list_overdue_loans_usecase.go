@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type ListOverdueLoansUseCase interface {
+	ListOverdueLoans(ctx context.Context, req ListOverdueLoansRequest) ([]entity.Loan, error)
+}
+
+type ListOverdueLoansUseCaseImpl struct {
+	loanRepo repository.LoanRepository
+}
+
+func NewListOverdueLoansUseCase(
+	loanRepo repository.LoanRepository,
+) ListOverdueLoansUseCase {
+	return &ListOverdueLoansUseCaseImpl{
+		loanRepo: loanRepo,
+	}
+}
+
+type ListOverdueLoansRequest struct {
+	UserID int64
+}
+
+func (u *ListOverdueLoansUseCaseImpl) ListOverdueLoans(ctx context.Context, req ListOverdueLoansRequest) ([]entity.Loan, error) {
+	loans, err := u.loanRepo.GetOverdue(ctx, req.UserID)
+	if err != nil {
+		return nil, handle(err)
+	}
+	return newLoans(loans), nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	defaultUsersLimit int32 = 20
+	maxUsersLimit     int32 = 100
+)
+
+type ListUsersUseCase interface {
+	ListUsers(ctx context.Context, req ListUsersRequest) ([]entity.User, error)
+}
+
+type ListUsersUseCaseImpl struct {
+	transactor   repository.Transactor
+	userRepo     repository.UserRepository
+	auditLogRepo repository.AuditLogRepository
+}
+
+func NewListUsersUseCase(
+	transactor repository.Transactor,
+	userRepo repository.UserRepository,
+	auditLogRepo repository.AuditLogRepository,
+) ListUsersUseCase {
+	return &ListUsersUseCaseImpl{
+		transactor:   transactor,
+		userRepo:     userRepo,
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+type ListUsersRequest struct {
+	ActorID int64
+	Role    entity.UserRole
+	// 名前かメールアドレスの部分一致で検索する。nilの場合はすべてのユーザーを返す
+	Query  *string
+	Limit  int32
+	Offset int32
+}
+
+// ListUsers 管理者だけが実行できる。個人情報を含むため検索した内容も監査ログに残す
+func (u *ListUsersUseCaseImpl) ListUsers(ctx context.Context, req ListUsersRequest) (res []entity.User, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = authorize(req.Role, ManageUsers)
+	if err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultUsersLimit
+	}
+	if limit > maxUsersLimit {
+		limit = maxUsersLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		users, err := u.userRepo.GetAllUsers(ctx, repository.GetAllUsersRequest{
+			Query:  req.Query,
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		err = u.auditLogRepo.Create(ctx, repository.CreateAuditLogRequest{
+			ActorID:    req.ActorID,
+			Action:     entity.AuditSearchUsers,
+			TargetType: entity.AuditTargetUser,
+			Detail: map[string]any{
+				"query":  req.Query,
+				"limit":  limit,
+				"offset": offset,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		res = make([]entity.User, len(users))
+		for i := range users {
+			res[i] = newUserEntity(&users[i])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func newUserEntity(u *repository.GetUserResponse) entity.User {
+	return entity.User{
+		ID:         u.ID,
+		Name:       u.Name,
+		Email:      u.Email,
+		Role:       u.Role.ToEntity(),
+		DisabledAt: u.DisabledAt,
+	}
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	defaultWebhookDeliveryLimit = 50
+	maxWebhookDeliveryLimit     = 100
+)
+
+type ListWebhookDeliveriesUseCase interface {
+	ListWebhookDeliveries(ctx context.Context, req ListWebhookDeliveriesRequest) ([]entity.WebhookDelivery, error)
+}
+
+type ListWebhookDeliveriesUseCaseImpl struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewListWebhookDeliveriesUseCase(
+	webhookRepo repository.WebhookRepository,
+) ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCaseImpl{
+		webhookRepo: webhookRepo,
+	}
+}
+
+type ListWebhookDeliveriesRequest struct {
+	UserID    int64
+	WebhookID int64
+	Limit     int32
+	Offset    int32
+}
+
+// ListWebhookDeliveries 新しい配信から順に返す
+func (u *ListWebhookDeliveriesUseCaseImpl) ListWebhookDeliveries(ctx context.Context, req ListWebhookDeliveriesRequest) (res []entity.WebhookDelivery, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	_, err = u.webhookRepo.GetEndpointByID(ctx, repository.WebhookEndpointRequest{
+		ID:     req.WebhookID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, newError(NotFound, NotFoundWebhookError, "webhook not found")
+		}
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultWebhookDeliveryLimit
+	}
+	if limit > maxWebhookDeliveryLimit {
+		limit = maxWebhookDeliveryLimit
+	}
+	offset := req.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	deliveries, err := u.webhookRepo.GetDeliveriesByEndpoint(ctx, repository.GetWebhookDeliveriesRequest{
+		EndpointID: req.WebhookID,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = make([]entity.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		res[i] = entity.WebhookDelivery{
+			ID:                 d.ID,
+			EventID:            d.EventID,
+			EventType:          d.EventType,
+			Status:             d.Status.ToEntity(),
+			Attempts:           d.Attempts,
+			LastResponseStatus: d.LastResponseStatus,
+			LastError:          d.LastError,
+			DeliveredAt:        d.DeliveredAt,
+			CreatedAt:          d.CreatedAt,
+		}
+		// 配信が終わった後の次回配信日時は意味を持たないため返さない
+		if d.Status == repository.DeliveryPending {
+			next := d.NextAttemptAt
+			res[i].NextAttemptAt = &next
+		}
+	}
+	return res, nil
+}
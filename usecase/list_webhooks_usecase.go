@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type ListWebhooksUseCase interface {
+	ListWebhooks(ctx context.Context, req ListWebhooksRequest) ([]entity.Webhook, error)
+}
+
+type ListWebhooksUseCaseImpl struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewListWebhooksUseCase(
+	webhookRepo repository.WebhookRepository,
+) ListWebhooksUseCase {
+	return &ListWebhooksUseCaseImpl{
+		webhookRepo: webhookRepo,
+	}
+}
+
+type ListWebhooksRequest struct {
+	UserID int64
+}
+
+func (u *ListWebhooksUseCaseImpl) ListWebhooks(ctx context.Context, req ListWebhooksRequest) ([]entity.Webhook, error) {
+	endpoints, err := u.webhookRepo.GetEndpointsByUser(ctx, req.UserID)
+	if err != nil {
+		return nil, handle(err)
+	}
+	res := make([]entity.Webhook, len(endpoints))
+	for i, e := range endpoints {
+		res[i] = newWebhook(e)
+	}
+	return res, nil
+}
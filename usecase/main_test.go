@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"context"
+	"github.com/stretchr/testify/require"
 	"log"
 	"math/rand"
 	"os"
@@ -9,6 +11,10 @@ import (
 	"readly/env"
 	"readly/repository"
 	"readly/service/auth"
+	"readly/service/event"
+	"readly/service/report"
+	"readly/service/webhook"
+	"readly/testdata"
 	"testing"
 	"time"
 )
@@ -52,27 +58,350 @@ func newTestSignInUseCase(t *testing.T) SignInUseCase {
 	return NewSignInUseCase(config, maker, tx, sessionRepo, userRepo)
 }
 
+func signUpTestUser(t *testing.T) *SignUpResponse {
+	res, err := newTestSignUpUseCase(t).SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	})
+	require.NoError(t, err)
+	return res
+}
+
 func newTestSignUpUseCase(t *testing.T) SignUpUseCase {
 	userRepo := repository.NewUserRepository(querier)
 	sessionRepo := repository.NewSessionRepository(querier)
-	return NewSignUpUseCase(config, maker, tx, sessionRepo, userRepo)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	return NewSignUpUseCase(config, maker, tx, sessionRepo, userRepo, outboxRepo)
 }
 
 func newTestRegisterBookUseCase(t *testing.T) RegisterBookUseCase {
 	userRepo := repository.NewUserRepository(querier)
 	bookRepo := repository.NewBookRepository(querier)
 	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
-	return NewRegisterBookUseCase(tx, bookRepo, readingHistoryRepo, userRepo)
+	readingActivityRepo := repository.NewReadingActivityRepository(querier)
+	feedRepo := repository.NewFeedRepository(querier)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	catalogRepo := repository.NewCatalogRepository(querier)
+	return NewRegisterBookUseCase(tx, bookRepo, readingHistoryRepo, readingActivityRepo, userRepo, feedRepo, outboxRepo, catalogRepo)
 }
 
 func newTestDeleteBookUseCase(t *testing.T) DeleteBookUseCase {
 	userRepo := repository.NewUserRepository(querier)
 	bookRepo := repository.NewBookRepository(querier)
 	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
-	return NewDeleteBookUseCase(tx, bookRepo, readingHistoryRepo, userRepo)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	tombstoneRepo := repository.NewSyncTombstoneRepository(querier)
+	return NewDeleteBookUseCase(tx, bookRepo, readingHistoryRepo, userRepo, outboxRepo, tombstoneRepo)
+}
+
+func newTestUpdateBookUseCase(t *testing.T) UpdateBookUseCase {
+	bookRepo := repository.NewBookRepository(querier)
+	catalogRepo := repository.NewCatalogRepository(querier)
+	return NewUpdateBookUseCase(tx, bookRepo, catalogRepo)
+}
+
+func newTestMergeCatalogEntryUseCase(t *testing.T) MergeCatalogEntryUseCase {
+	catalogRepo := repository.NewCatalogRepository(querier)
+	return NewMergeCatalogEntryUseCase(tx, catalogRepo)
+}
+
+func newTestGetDuplicateCatalogEntriesUseCase(t *testing.T) GetDuplicateCatalogEntriesUseCase {
+	catalogRepo := repository.NewCatalogRepository(querier)
+	return NewGetDuplicateCatalogEntriesUseCase(catalogRepo)
 }
 
 func newTestRefreshAccessTokenUseCase(t *testing.T) RefreshAccessTokenUseCase {
 	sessionRepo := repository.NewSessionRepository(querier)
-	return NewRefreshAccessTokenUseCase(config, maker, sessionRepo)
+	userRepo := repository.NewUserRepository(querier)
+	return NewRefreshAccessTokenUseCase(config, maker, sessionRepo, userRepo)
+}
+
+func newTestGetReadingStatsUseCase(t *testing.T) GetReadingStatsUseCase {
+	readingStatsRepo := repository.NewReadingStatsRepository(querier)
+	return NewGetReadingStatsUseCase(readingStatsRepo)
+}
+
+func newTestGenerateYearInReviewUseCase(t *testing.T) GenerateYearInReviewUseCase {
+	readingStatsRepo := repository.NewReadingStatsRepository(querier)
+	renderer, err := report.NewHTMLRenderer(fakeCoverFetcher{})
+	if err != nil {
+		t.Fatalf("cannot create renderer: %v", err)
+	}
+	return NewGenerateYearInReviewUseCase(readingStatsRepo, renderer)
+}
+
+func newTestGetReadingStreakUseCase(t *testing.T) GetReadingStreakUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	readingActivityRepo := repository.NewReadingActivityRepository(querier)
+	return NewGetReadingStreakUseCase(userRepo, readingActivityRepo)
+}
+
+func newTestGetActivityCalendarUseCase(t *testing.T) GetActivityCalendarUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	readingActivityRepo := repository.NewReadingActivityRepository(querier)
+	return NewGetActivityCalendarUseCase(userRepo, readingActivityRepo)
+}
+
+func newTestUpdateTimezoneUseCase(t *testing.T) UpdateTimezoneUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	return NewUpdateTimezoneUseCase(userRepo)
+}
+
+func newTestGetReadingQueueUseCase(t *testing.T) GetReadingQueueUseCase {
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	return NewGetReadingQueueUseCase(readingHistoryRepo)
+}
+
+func newTestReorderReadingQueueUseCase(t *testing.T) ReorderReadingQueueUseCase {
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	return NewReorderReadingQueueUseCase(tx, readingHistoryRepo)
+}
+
+func newTestPopNextBookUseCase(t *testing.T) PopNextBookUseCase {
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	readingActivityRepo := repository.NewReadingActivityRepository(querier)
+	feedRepo := repository.NewFeedRepository(querier)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	return NewPopNextBookUseCase(tx, readingHistoryRepo, readingActivityRepo, feedRepo, outboxRepo)
+}
+
+func newTestUpdateWishlistEntryUseCase(t *testing.T) UpdateWishlistEntryUseCase {
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	return NewUpdateWishlistEntryUseCase(tx, readingHistoryRepo, outboxRepo)
+}
+
+func newTestGetLibraryUseCase(t *testing.T) GetLibraryUseCase {
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	return NewGetLibraryUseCase(readingHistoryRepo)
+}
+
+func newTestGetSpendingSummaryUseCase(t *testing.T) GetSpendingSummaryUseCase {
+	readingStatsRepo := repository.NewReadingStatsRepository(querier)
+	return NewGetSpendingSummaryUseCase(readingStatsRepo)
+}
+
+func newTestRecommendBooksUseCase(t *testing.T) RecommendBooksUseCase {
+	recommendationRepo := repository.NewRecommendationRepository(querier)
+	return NewRecommendBooksUseCase(tx, recommendationRepo)
+}
+
+func newTestRefreshRecommendationsUseCase(t *testing.T) RefreshRecommendationsUseCase {
+	recommendationRepo := repository.NewRecommendationRepository(querier)
+	return NewRefreshRecommendationsUseCase(tx, recommendationRepo)
+}
+
+func newTestFollowUserUseCase(t *testing.T) FollowUserUseCase {
+	followRepo := repository.NewFollowRepository(querier)
+	userRepo := repository.NewUserRepository(querier)
+	return NewFollowUserUseCase(followRepo, userRepo)
+}
+
+func newTestUnfollowUserUseCase(t *testing.T) UnfollowUserUseCase {
+	followRepo := repository.NewFollowRepository(querier)
+	return NewUnfollowUserUseCase(followRepo)
+}
+
+func newTestListFollowersUseCase(t *testing.T) ListFollowersUseCase {
+	followRepo := repository.NewFollowRepository(querier)
+	return NewListFollowersUseCase(followRepo)
+}
+
+func newTestListFollowingUseCase(t *testing.T) ListFollowingUseCase {
+	followRepo := repository.NewFollowRepository(querier)
+	return NewListFollowingUseCase(followRepo)
+}
+
+func newTestGetFeedUseCase(t *testing.T) GetFeedUseCase {
+	feedRepo := repository.NewFeedRepository(querier)
+	return NewGetFeedUseCase(feedRepo)
+}
+
+func newTestUpdatePrivacySettingsUseCase(t *testing.T) UpdatePrivacySettingsUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	shelfVisibilityRepo := repository.NewShelfVisibilityRepository(querier)
+	return NewUpdatePrivacySettingsUseCase(tx, userRepo, shelfVisibilityRepo)
+}
+
+func newTestGetPublicProfileUseCase(t *testing.T) GetPublicProfileUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	return NewGetPublicProfileUseCase(userRepo, readingHistoryRepo)
+}
+
+func newTestLendBookUseCase(t *testing.T) LendBookUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	loanRepo := repository.NewLoanRepository(querier)
+	return NewLendBookUseCase(tx, readingHistoryRepo, loanRepo, userRepo)
+}
+
+func newTestReturnBookUseCase(t *testing.T) ReturnBookUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	loanRepo := repository.NewLoanRepository(querier)
+	return NewReturnBookUseCase(tx, loanRepo, userRepo)
+}
+
+func newTestListActiveLoansUseCase(t *testing.T) ListActiveLoansUseCase {
+	loanRepo := repository.NewLoanRepository(querier)
+	return NewListActiveLoansUseCase(loanRepo)
+}
+
+func newTestListOverdueLoansUseCase(t *testing.T) ListOverdueLoansUseCase {
+	loanRepo := repository.NewLoanRepository(querier)
+	return NewListOverdueLoansUseCase(loanRepo)
+}
+
+func newTestCreateClubUseCase(t *testing.T) CreateClubUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	return NewCreateClubUseCase(tx, clubRepo, memberRepo)
+}
+
+func newTestGetClubUseCase(t *testing.T) GetClubUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	bookRepo := repository.NewBookRepository(querier)
+	return NewGetClubUseCase(clubRepo, memberRepo, bookRepo)
+}
+
+func newTestInviteToClubUseCase(t *testing.T) InviteToClubUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	userRepo := repository.NewUserRepository(querier)
+	return NewInviteToClubUseCase(clubRepo, memberRepo, userRepo)
+}
+
+func newTestJoinClubUseCase(t *testing.T) JoinClubUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	return NewJoinClubUseCase(tx, clubRepo, memberRepo)
+}
+
+func newTestLeaveClubUseCase(t *testing.T) LeaveClubUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	return NewLeaveClubUseCase(clubRepo, memberRepo)
+}
+
+func newTestSetClubScheduleUseCase(t *testing.T) SetClubScheduleUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	bookRepo := repository.NewBookRepository(querier)
+	return NewSetClubScheduleUseCase(tx, clubRepo, memberRepo, bookRepo)
+}
+
+func newTestUpdateClubProgressUseCase(t *testing.T) UpdateClubProgressUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	bookRepo := repository.NewBookRepository(querier)
+	readingActivityRepo := repository.NewReadingActivityRepository(querier)
+	return NewUpdateClubProgressUseCase(tx, clubRepo, memberRepo, bookRepo, readingActivityRepo)
+}
+
+func newTestPostClubDiscussionUseCase(t *testing.T) PostClubDiscussionUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	postRepo := repository.NewClubPostRepository(querier)
+	userRepo := repository.NewUserRepository(querier)
+	return NewPostClubDiscussionUseCase(clubRepo, memberRepo, postRepo, userRepo)
+}
+
+func newTestListClubDiscussionUseCase(t *testing.T) ListClubDiscussionUseCase {
+	clubRepo := repository.NewBookClubRepository(querier)
+	memberRepo := repository.NewClubMemberRepository(querier)
+	postRepo := repository.NewClubPostRepository(querier)
+	return NewListClubDiscussionUseCase(clubRepo, memberRepo, postRepo)
+}
+
+func newTestRelayOutboxEventsUseCase(t *testing.T, sinks []event.Sink, notifiers ...event.Sink) RelayOutboxEventsUseCase {
+	outboxRepo := repository.NewOutboxRepository(querier)
+	return NewRelayOutboxEventsUseCase(tx, outboxRepo, sinks, notifiers)
+}
+
+func newTestCreateWebhookUseCase(t *testing.T) CreateWebhookUseCase {
+	webhookRepo := repository.NewWebhookRepository(querier)
+	return NewCreateWebhookUseCase(webhookRepo)
+}
+
+func newTestListWebhookDeliveriesUseCase(t *testing.T) ListWebhookDeliveriesUseCase {
+	webhookRepo := repository.NewWebhookRepository(querier)
+	return NewListWebhookDeliveriesUseCase(webhookRepo)
+}
+
+func newTestEnqueueWebhookDeliveriesUseCase(t *testing.T) EnqueueWebhookDeliveriesUseCase {
+	webhookRepo := repository.NewWebhookRepository(querier)
+	return NewEnqueueWebhookDeliveriesUseCase(webhookRepo)
+}
+
+func newTestDeliverWebhooksUseCase(t *testing.T, client webhook.Client) DeliverWebhooksUseCase {
+	webhookRepo := repository.NewWebhookRepository(querier)
+	return NewDeliverWebhooksUseCase(webhookRepo, client)
+}
+
+func newTestWatchLibraryUseCase(t *testing.T, bus *event.Bus) WatchLibraryUseCase {
+	outboxRepo := repository.NewOutboxRepository(querier)
+	return NewWatchLibraryUseCase(outboxRepo, bus)
+}
+
+func newTestSyncUseCase(t *testing.T) SyncUseCase {
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	readingActivityRepo := repository.NewReadingActivityRepository(querier)
+	feedRepo := repository.NewFeedRepository(querier)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	tagRepo := repository.NewBookTagRepository(querier)
+	noteRepo := repository.NewBookNoteRepository(querier)
+	tombstoneRepo := repository.NewSyncTombstoneRepository(querier)
+	return NewSyncUseCase(tx, readingHistoryRepo, readingActivityRepo, feedRepo, outboxRepo, tagRepo, noteRepo, tombstoneRepo)
+}
+
+func newTestBatchRegisterBooksUseCase(t *testing.T) BatchRegisterBooksUseCase {
+	return NewBatchRegisterBooksUseCase(tx, newTestRegisterBookUseCase(t))
+}
+
+func newTestExecuteWithIdempotencyKeyUseCase(t *testing.T) ExecuteWithIdempotencyKeyUseCase {
+	idempotencyKeyRepo := repository.NewIdempotencyKeyRepository(querier)
+	return NewExecuteWithIdempotencyKeyUseCase(tx, idempotencyKeyRepo, time.Hour)
+}
+
+func newTestListUsersUseCase(t *testing.T) ListUsersUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	auditLogRepo := repository.NewAuditLogRepository(querier)
+	return NewListUsersUseCase(tx, userRepo, auditLogRepo)
+}
+
+func newTestSetUserDisabledUseCase(t *testing.T) SetUserDisabledUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	sessionRepo := repository.NewSessionRepository(querier)
+	auditLogRepo := repository.NewAuditLogRepository(querier)
+	return NewSetUserDisabledUseCase(tx, userRepo, sessionRepo, auditLogRepo)
+}
+
+func newTestRevokeUserSessionsUseCase(t *testing.T) RevokeUserSessionsUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	sessionRepo := repository.NewSessionRepository(querier)
+	auditLogRepo := repository.NewAuditLogRepository(querier)
+	return NewRevokeUserSessionsUseCase(tx, userRepo, sessionRepo, auditLogRepo)
+}
+
+func newTestGetUserLibraryUseCase(t *testing.T) GetUserLibraryUseCase {
+	userRepo := repository.NewUserRepository(querier)
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	auditLogRepo := repository.NewAuditLogRepository(querier)
+	return NewGetUserLibraryUseCase(tx, userRepo, readingHistoryRepo, auditLogRepo)
+}
+
+func newTestDeleteCatalogBookUseCase(t *testing.T) DeleteCatalogBookUseCase {
+	bookRepo := repository.NewBookRepository(querier)
+	readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+	outboxRepo := repository.NewOutboxRepository(querier)
+	tombstoneRepo := repository.NewSyncTombstoneRepository(querier)
+	auditLogRepo := repository.NewAuditLogRepository(querier)
+	return NewDeleteCatalogBookUseCase(tx, bookRepo, readingHistoryRepo, outboxRepo, tombstoneRepo, auditLogRepo)
+}
+
+func newTestListAuditLogsUseCase(t *testing.T) ListAuditLogsUseCase {
+	auditLogRepo := repository.NewAuditLogRepository(querier)
+	return NewListAuditLogsUseCase(auditLogRepo)
 }
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+	"slices"
+)
+
+var catalogKinds = []entity.CatalogKind{entity.CatalogAuthor, entity.CatalogPublisher, entity.CatalogGenre}
+
+type MergeCatalogEntryUseCase interface {
+	MergeCatalogEntry(ctx context.Context, req MergeCatalogEntryRequest) (*MergeCatalogEntryResponse, error)
+}
+
+type MergeCatalogEntryUseCaseImpl struct {
+	transactor  repository.Transactor
+	catalogRepo repository.CatalogRepository
+}
+
+func NewMergeCatalogEntryUseCase(
+	transactor repository.Transactor,
+	catalogRepo repository.CatalogRepository,
+) MergeCatalogEntryUseCase {
+	return &MergeCatalogEntryUseCaseImpl{
+		transactor:  transactor,
+		catalogRepo: catalogRepo,
+	}
+}
+
+type MergeCatalogEntryRequest struct {
+	Role entity.UserRole
+	Kind entity.CatalogKind
+	// 統合後は別名として残し、以降の登録は統合先に置き換える
+	Source    string
+	Canonical string
+}
+
+type MergeCatalogEntryResponse struct {
+	Canonical string
+	// 参照を統合先に置き換えた書籍の数
+	MovedBooks int64
+}
+
+// MergeCatalogEntry Sourceを参照しているすべての書籍と別名をCanonicalに移し、Sourceを削除する
+func (u *MergeCatalogEntryUseCaseImpl) MergeCatalogEntry(ctx context.Context, req MergeCatalogEntryRequest) (res *MergeCatalogEntryResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = authorize(req.Role, EditCatalog)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(catalogKinds, req.Kind) {
+		return nil, newError(BadRequest, InvalidCatalogKindError, "invalid catalog kind")
+	}
+	if len(req.Source) == 0 || len(req.Canonical) == 0 {
+		return nil, newError(BadRequest, InvalidMergeError, "source and canonical must not be empty")
+	}
+
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		// 統合先が既に別名になっている場合はその統合先にまとめる
+		canonical, err := resolveCatalogName(ctx, u.catalogRepo, req.Kind, req.Canonical)
+		if err != nil {
+			return err
+		}
+		if canonical == req.Source {
+			return newError(BadRequest, InvalidMergeError, "cannot merge into itself")
+		}
+		for _, name := range []string{req.Source, canonical} {
+			exists, err := u.catalogRepo.Exists(ctx, req.Kind, name)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return newError(NotFound, NotFoundCatalogError, "catalog entry not found")
+			}
+		}
+
+		moveArgs := repository.MoveCatalogEntryRequest{
+			Kind: req.Kind,
+			From: req.Source,
+			To:   canonical,
+		}
+		moved, err := u.catalogRepo.MoveBooks(ctx, moveArgs)
+		if err != nil {
+			return err
+		}
+		err = u.catalogRepo.MoveAliases(ctx, moveArgs)
+		if err != nil {
+			return err
+		}
+		aliasArgs := repository.CreateAliasRequest{
+			Kind:          req.Kind,
+			Alias:         req.Source,
+			CanonicalName: canonical,
+		}
+		err = u.catalogRepo.CreateAlias(ctx, aliasArgs)
+		if err != nil {
+			return err
+		}
+		err = u.catalogRepo.Delete(ctx, req.Kind, req.Source)
+		if err != nil {
+			return err
+		}
+		res = &MergeCatalogEntryResponse{
+			Canonical:  canonical,
+			MovedBooks: moved,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// resolveCatalogName 統合済みの別名であれば統合先の名前を、そうでなければ名前をそのまま返す
+func resolveCatalogName(ctx context.Context, catalogRepo repository.CatalogRepository, kind entity.CatalogKind, name string) (string, error) {
+	canonical, err := catalogRepo.GetCanonicalName(ctx, kind, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return name, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return canonical, nil
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"strings"
+	"testing"
+)
+
+func TestMergeCatalogEntry(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	mergeUseCase := newTestMergeCatalogEntryUseCase(t)
+	duplicatesUseCase := newTestGetDuplicateCatalogEntriesUseCase(t)
+
+	user := signUpTestUser(t)
+	suffix := testdata.RandomString(6)
+	canonical := "村上春樹" + suffix
+	variant := "村上 春樹" + suffix
+	genre := testdata.RandomString(6)
+	genreVariant := testdata.RandomString(6)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID:     user.UserID,
+		Title:      testdata.RandomString(10),
+		AuthorName: &canonical,
+		Genres:     []string{genre, genreVariant},
+		Status:     entity.Unread,
+	})
+	require.NoError(t, err)
+	variantBook, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID:     user.UserID,
+		Title:      testdata.RandomString(10),
+		AuthorName: &variant,
+		Status:     entity.Unread,
+	})
+	require.NoError(t, err)
+
+	t.Run("Report variant spellings as duplicates", func(t *testing.T) {
+		kind := entity.CatalogAuthor
+		res, err := duplicatesUseCase.GetDuplicateCatalogEntries(context.Background(), GetDuplicateCatalogEntriesRequest{
+			Role: entity.RoleLibrarian,
+			Kind: &kind,
+		})
+		require.NoError(t, err)
+		var found *entity.DuplicateCandidate
+		for _, d := range res {
+			if d.NormalizedName == strings.ToLower(canonical) {
+				found = &d
+			}
+		}
+		require.NotNil(t, found)
+		require.Equal(t, entity.CatalogAuthor, found.Kind)
+		require.ElementsMatch(t, []string{canonical, variant}, found.Names)
+	})
+
+	t.Run("Merge by user is forbidden", func(t *testing.T) {
+		_, err := mergeUseCase.MergeCatalogEntry(context.Background(), MergeCatalogEntryRequest{
+			Role:      entity.RoleUser,
+			Kind:      entity.CatalogAuthor,
+			Source:    variant,
+			Canonical: canonical,
+		})
+		requireErrorCode(t, err, Forbidden, PermissionDeniedError)
+	})
+
+	t.Run("Merge into itself fails", func(t *testing.T) {
+		_, err := mergeUseCase.MergeCatalogEntry(context.Background(), MergeCatalogEntryRequest{
+			Role:      entity.RoleLibrarian,
+			Kind:      entity.CatalogAuthor,
+			Source:    canonical,
+			Canonical: canonical,
+		})
+		requireErrorCode(t, err, BadRequest, InvalidMergeError)
+	})
+
+	t.Run("Merge author and resolve the alias on registration", func(t *testing.T) {
+		res, err := mergeUseCase.MergeCatalogEntry(context.Background(), MergeCatalogEntryRequest{
+			Role:      entity.RoleLibrarian,
+			Kind:      entity.CatalogAuthor,
+			Source:    variant,
+			Canonical: canonical,
+		})
+		require.NoError(t, err)
+		require.Equal(t, canonical, res.Canonical)
+		require.Equal(t, int64(1), res.MovedBooks)
+
+		b, err := querier.GetBooksByID(context.Background(), variantBook.ID)
+		require.NoError(t, err)
+		require.Equal(t, canonical, b.AuthorName.String)
+
+		registered, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID:     user.UserID,
+			Title:      testdata.RandomString(10),
+			AuthorName: &variant,
+			Status:     entity.Unread,
+		})
+		require.NoError(t, err)
+		require.Equal(t, canonical, *registered.AuthorName)
+
+		_, err = mergeUseCase.MergeCatalogEntry(context.Background(), MergeCatalogEntryRequest{
+			Role:      entity.RoleLibrarian,
+			Kind:      entity.CatalogAuthor,
+			Source:    variant,
+			Canonical: canonical,
+		})
+		requireErrorCode(t, err, NotFound, NotFoundCatalogError)
+	})
+
+	t.Run("Merge genre of a book that has both genres", func(t *testing.T) {
+		res, err := mergeUseCase.MergeCatalogEntry(context.Background(), MergeCatalogEntryRequest{
+			Role:      entity.RoleLibrarian,
+			Kind:      entity.CatalogGenre,
+			Source:    genreVariant,
+			Canonical: genre,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), res.MovedBooks)
+
+		genres, err := querier.GetGenresByBookID(context.Background(), book.ID)
+		require.NoError(t, err)
+		require.Equal(t, []string{genre}, genres)
+
+		registered, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID: user.UserID,
+			Title:  testdata.RandomString(10),
+			Genres: []string{genre, genreVariant},
+			Status: entity.Unread,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{genre}, registered.Genres)
+	})
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import "readly/entity"
+
+type Permission int
+
+const (
+	// EditCatalog 共有している書籍・著者・出版社・ジャンルの編集
+	EditCatalog Permission = iota
+	// ManageUsers 他のユーザーの管理
+	ManageUsers
+	// ModerateCatalog 他のユーザーの本棚にある書籍も含めた共有カタログの削除
+	ModerateCatalog
+)
+
+var rolePermissions = map[entity.UserRole][]Permission{
+	// 自分の読書記録はユーザーIDで絞り込んで操作するため、一般ユーザーには権限を付与しない
+	entity.RoleUser:      {},
+	entity.RoleLibrarian: {EditCatalog},
+	entity.RoleAdmin:     {EditCatalog, ManageUsers, ModerateCatalog},
+}
+
+func hasPermission(role entity.UserRole, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// authorize ロールに権限がない場合はForbiddenを返す
+func authorize(role entity.UserRole, permission Permission) error {
+	if !hasPermission(role, permission) {
+		return newError(Forbidden, PermissionDeniedError, "permission denied")
+	}
+	return nil
+}
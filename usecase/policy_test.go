@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"testing"
+)
+
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		role    entity.UserRole
+		allowed []Permission
+		denied  []Permission
+	}{
+		{
+			role:   entity.RoleUser,
+			denied: []Permission{EditCatalog, ManageUsers, ModerateCatalog},
+		},
+		{
+			role:    entity.RoleLibrarian,
+			allowed: []Permission{EditCatalog},
+			denied:  []Permission{ManageUsers, ModerateCatalog},
+		},
+		{
+			role:    entity.RoleAdmin,
+			allowed: []Permission{EditCatalog, ManageUsers, ModerateCatalog},
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, p := range tc.allowed {
+			require.NoError(t, authorize(tc.role, p))
+		}
+		for _, p := range tc.denied {
+			err := authorize(tc.role, p)
+			var e *Error
+			require.True(t, errors.As(err, &e))
+			require.Equal(t, Forbidden, e.StatusCode)
+			require.Equal(t, PermissionDeniedError, e.ErrorCode)
+		}
+	}
+}
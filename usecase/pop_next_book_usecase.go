@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+	"time"
+)
+
+type PopNextBookUseCase interface {
+	PopNextBook(ctx context.Context, req PopNextBookRequest) (*entity.Book, error)
+}
+
+type PopNextBookUseCaseImpl struct {
+	transactor         repository.Transactor
+	readingHistoryRepo repository.ReadingHistoryRepository
+	activityRepo       repository.ReadingActivityRepository
+	feedRepo           repository.FeedRepository
+	outboxRepo         repository.OutboxRepository
+}
+
+func NewPopNextBookUseCase(
+	transactor repository.Transactor,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+	activityRepo repository.ReadingActivityRepository,
+	feedRepo repository.FeedRepository,
+	outboxRepo repository.OutboxRepository,
+) PopNextBookUseCase {
+	return &PopNextBookUseCaseImpl{
+		transactor:         transactor,
+		readingHistoryRepo: readingHistoryRepo,
+		activityRepo:       activityRepo,
+		feedRepo:           feedRepo,
+		outboxRepo:         outboxRepo,
+	}
+}
+
+type PopNextBookRequest struct {
+	UserID int64
+}
+
+// PopNextBook キューの先頭の本を読書中にする
+func (u *PopNextBookUseCaseImpl) PopNextBook(ctx context.Context, req PopNextBookRequest) (*entity.Book, error) {
+	var res *entity.Book
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
+		queue, err := u.readingHistoryRepo.GetQueue(ctx, req.UserID)
+		if err != nil {
+			return err
+		}
+		if len(queue) == 0 {
+			return newError(NotFound, EmptyQueueError, "reading queue is empty")
+		}
+		next := newQueuedBook(queue[0])
+
+		now := time.Now()
+		updateArgs := repository.UpdateReadingHistoryRequest{
+			UserID:    req.UserID,
+			BookID:    next.ID,
+			Status:    repository.Reading,
+			StartDate: &now,
+			EndDate:   nil,
+		}
+		rh, err := u.readingHistoryRepo.Update(ctx, updateArgs)
+		if err != nil {
+			return err
+		}
+		activityArgs := repository.CreateReadingActivityRequest{
+			UserID:     req.UserID,
+			BookID:     next.ID,
+			Type:       repository.StatusChanged,
+			OccurredAt: now,
+		}
+		err = u.activityRepo.Create(ctx, activityArgs)
+		if err != nil {
+			return err
+		}
+		feedArgs := repository.CreateFeedEventRequest{
+			UserID: req.UserID,
+			BookID: next.ID,
+			Type:   repository.StartedReadingEvent,
+		}
+		err = u.feedRepo.CreateEvent(ctx, feedArgs)
+		if err != nil {
+			return err
+		}
+		eventArgs := repository.CreateOutboxEventRequest{
+			Type: entity.StatusChanged,
+			Payload: entity.StatusChangedPayload{
+				UserID: req.UserID,
+				BookID: next.ID,
+				From:   next.Status.String(),
+				To:     rh.Status.ToEntity().String(),
+			},
+		}
+		err = u.outboxRepo.Create(ctx, eventArgs)
+		if err != nil {
+			return err
+		}
+
+		next.Status = rh.Status.ToEntity()
+		next.StartDate = rh.StartDate
+		next.EndDate = rh.EndDate
+		next.QueuePosition = rh.QueuePosition
+		next.Version = rh.Version
+		res = &next
+		return nil
+	})
+	return res, handle(err)
+}
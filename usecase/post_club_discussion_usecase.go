@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+type PostClubDiscussionUseCase interface {
+	PostClubDiscussion(ctx context.Context, req PostClubDiscussionRequest) (*entity.ClubPost, error)
+}
+
+type PostClubDiscussionUseCaseImpl struct {
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+	postRepo   repository.ClubPostRepository
+	userRepo   repository.UserRepository
+}
+
+func NewPostClubDiscussionUseCase(
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+	postRepo repository.ClubPostRepository,
+	userRepo repository.UserRepository,
+) PostClubDiscussionUseCase {
+	return &PostClubDiscussionUseCaseImpl{
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+		postRepo:   postRepo,
+		userRepo:   userRepo,
+	}
+}
+
+type PostClubDiscussionRequest struct {
+	ClubID    int64
+	SectionID int64
+	UserID    int64
+	Body      string
+}
+
+func (u *PostClubDiscussionUseCaseImpl) PostClubDiscussion(ctx context.Context, req PostClubDiscussionRequest) (res *entity.ClubPost, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	_, _, err = authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	err = findClubSection(ctx, u.clubRepo, req.ClubID, req.SectionID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := u.postRepo.Create(ctx, repository.CreateClubPostRequest{
+		SectionID: req.SectionID,
+		UserID:    req.UserID,
+		Body:      req.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &entity.ClubPost{
+		ID:        post.ID,
+		UserID:    post.UserID,
+		UserName:  user.Name,
+		Body:      post.Body,
+		CreatedAt: post.CreatedAt,
+	}, nil
+}
+
+// findClubSection 他のクラブの区切りを指定できないように、クラブに属していることも確認する
+func findClubSection(ctx context.Context, clubRepo repository.BookClubRepository, clubID int64, sectionID int64) error {
+	section, err := clubRepo.GetSectionByID(ctx, sectionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return newError(NotFound, NotFoundSectionError, "section not found")
+		}
+		return err
+	}
+	if section.ClubID != clubID {
+		return newError(NotFound, NotFoundSectionError, "section not found")
+	}
+	return nil
+}
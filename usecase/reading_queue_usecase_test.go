@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestReadingQueue(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	queueUseCase := newTestGetReadingQueueUseCase(t)
+	reorderUseCase := newTestReorderReadingQueueUseCase(t)
+	popNextUseCase := newTestPopNextBookUseCase(t)
+
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	})
+	require.NoError(t, err)
+
+	owned := false
+	bookIDs := make([]int64, 3)
+	for i := range bookIDs {
+		book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID:   signUpRes.UserID,
+			Title:    testdata.RandomString(10),
+			Status:   entity.Unread,
+			Priority: int16(i),
+			Owned:    &owned,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, book.QueuePosition)
+		require.Equal(t, int32(i+1), *book.QueuePosition)
+		require.False(t, book.Owned)
+		bookIDs[i] = book.ID
+	}
+	// 読書中の本はキューに入らない
+	reading, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: signUpRes.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Reading,
+	})
+	require.NoError(t, err)
+	require.Nil(t, reading.QueuePosition)
+	require.True(t, reading.Owned)
+
+	queue, err := queueUseCase.GetReadingQueue(context.Background(), GetReadingQueueRequest{UserID: signUpRes.UserID})
+	require.NoError(t, err)
+	require.Len(t, queue, 3)
+	for i, b := range queue {
+		require.Equal(t, bookIDs[i], b.ID)
+	}
+
+	t.Run("Reorder failure if book ids do not match the queue", func(t *testing.T) {
+		_, err := reorderUseCase.ReorderReadingQueue(context.Background(), ReorderReadingQueueRequest{
+			UserID:  signUpRes.UserID,
+			BookIDs: []int64{bookIDs[0], bookIDs[0], bookIDs[1]},
+		})
+		var e *Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, BadRequest, e.StatusCode)
+		require.Equal(t, InvalidQueueError, e.ErrorCode)
+	})
+
+	t.Run("Reorder success", func(t *testing.T) {
+		reordered := []int64{bookIDs[2], bookIDs[0], bookIDs[1]}
+		books, err := reorderUseCase.ReorderReadingQueue(context.Background(), ReorderReadingQueueRequest{
+			UserID:  signUpRes.UserID,
+			BookIDs: reordered,
+		})
+		require.NoError(t, err)
+		require.Len(t, books, 3)
+		for i, b := range books {
+			require.Equal(t, reordered[i], b.ID)
+			require.Equal(t, int32(i+1), *b.QueuePosition)
+		}
+	})
+
+	t.Run("Pop next book success", func(t *testing.T) {
+		book, err := popNextUseCase.PopNextBook(context.Background(), PopNextBookRequest{UserID: signUpRes.UserID})
+		require.NoError(t, err)
+		require.Equal(t, bookIDs[2], book.ID)
+		require.Equal(t, entity.Reading, book.Status)
+		require.NotNil(t, book.StartDate)
+		require.Nil(t, book.QueuePosition)
+
+		queue, err := queueUseCase.GetReadingQueue(context.Background(), GetReadingQueueRequest{UserID: signUpRes.UserID})
+		require.NoError(t, err)
+		require.Len(t, queue, 2)
+		require.Equal(t, bookIDs[0], queue[0].ID)
+	})
+
+	t.Run("Pop next book failure if queue is empty", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := popNextUseCase.PopNextBook(context.Background(), PopNextBookRequest{UserID: signUpRes.UserID})
+			require.NoError(t, err)
+		}
+		book, err := popNextUseCase.PopNextBook(context.Background(), PopNextBookRequest{UserID: signUpRes.UserID})
+		require.Nil(t, book)
+		var e *Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, NotFound, e.StatusCode)
+		require.Equal(t, EmptyQueueError, e.ErrorCode)
+	})
+}
+
+func TestReadingQueueAfterBackToUnread(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	syncUseCase := newTestSyncUseCase(t)
+	queueUseCase := newTestGetReadingQueueUseCase(t)
+	popNextUseCase := newTestPopNextBookUseCase(t)
+
+	user := signUpTestUser(t)
+	queued, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: user.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Unread,
+	})
+	require.NoError(t, err)
+	reading, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: user.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Reading,
+	})
+	require.NoError(t, err)
+	require.Nil(t, reading.QueuePosition)
+
+	// 読書中から未読に戻した本はキューの末尾に入る
+	res, err := syncUseCase.Sync(context.Background(), SyncRequest{
+		UserID: user.UserID,
+		Histories: []SyncReadingHistoryChange{{
+			BookID:    reading.ID,
+			Status:    entity.Unread,
+			UpdatedAt: time.Now(),
+		}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Conflicts)
+
+	queue, err := queueUseCase.GetReadingQueue(context.Background(), GetReadingQueueRequest{UserID: user.UserID})
+	require.NoError(t, err)
+	require.Len(t, queue, 2)
+	require.Equal(t, queued.ID, queue[0].ID)
+	require.Equal(t, reading.ID, queue[1].ID)
+	require.Equal(t, *queued.QueuePosition+1, *queue[1].QueuePosition)
+
+	for _, id := range []int64{queued.ID, reading.ID} {
+		book, err := popNextUseCase.PopNextBook(context.Background(), PopNextBookRequest{UserID: user.UserID})
+		require.NoError(t, err)
+		require.Equal(t, id, book.ID)
+	}
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"readly/entity"
+	"readly/repository"
+)
+
+type RecommendBooksUseCase interface {
+	RecommendBooks(ctx context.Context, req RecommendBooksRequest) ([]entity.Recommendation, error)
+}
+
+type RecommendBooksUseCaseImpl struct {
+	transactor         repository.Transactor
+	recommendationRepo repository.RecommendationRepository
+}
+
+func NewRecommendBooksUseCase(
+	transactor repository.Transactor,
+	recommendationRepo repository.RecommendationRepository,
+) RecommendBooksUseCase {
+	return &RecommendBooksUseCaseImpl{
+		transactor:         transactor,
+		recommendationRepo: recommendationRepo,
+	}
+}
+
+type RecommendBooksRequest struct {
+	UserID int64
+	Limit  int32
+}
+
+const defaultRecommendationLimit = 10
+
+func (u *RecommendBooksUseCaseImpl) RecommendBooks(ctx context.Context, req RecommendBooksRequest) (res []entity.Recommendation, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultRecommendationLimit
+	}
+	if limit > recommendationCacheSize {
+		limit = recommendationCacheSize
+	}
+
+	// 通常はバックグラウンドジョブが更新するが、まだ一度も作成されていない場合はその場で作成する
+	_, err = u.recommendationRepo.GetRefreshedAt(ctx, req.UserID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		err = rebuildRecommendations(ctx, u.transactor, u.recommendationRepo, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	recommendations, err := u.recommendationRepo.Get(ctx, repository.GetRecommendationsRequest{
+		UserID: req.UserID,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = make([]entity.Recommendation, len(recommendations))
+	for i, r := range recommendations {
+		res[i] = entity.Recommendation{
+			Book: entity.Book{
+				ID:            r.BookID,
+				Title:         r.Title,
+				Genres:        r.Genres,
+				Description:   r.Description,
+				CoverImageURL: r.CoverImageURL,
+				URL:           r.URL,
+				AuthorName:    r.AuthorName,
+				PublisherName: r.PublisherName,
+				PublishDate:   r.PublishDate,
+				ISBN:          r.ISBN,
+			},
+			Score:       r.Score,
+			Explanation: explainRecommendation(r.Reason, r.ReasonName, r.ReasonCount),
+			GeneratedAt: r.GeneratedAt,
+		}
+	}
+	return res, nil
+}
+
+func explainRecommendation(reason repository.RecommendationReason, name string, count int64) string {
+	books := "books"
+	if count == 1 {
+		books = "book"
+	}
+	switch reason {
+	case repository.GenreReason:
+		return fmt.Sprintf("because you read %d %s in %s", count, books, name)
+	default:
+		return fmt.Sprintf("because you read %d %s by %s", count, books, name)
+	}
+}
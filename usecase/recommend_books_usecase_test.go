@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/repository"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestRecommendBooks(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	recommendUseCase := newTestRecommendBooksUseCase(t)
+	refreshUseCase := newTestRefreshRecommendationsUseCase(t)
+
+	signUp := func() int64 {
+		res, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+			Name:     testdata.RandomString(10),
+			Email:    testdata.RandomEmail(),
+			Password: testdata.RandomString(16),
+		})
+		require.NoError(t, err)
+		return res.UserID
+	}
+	register := func(userID int64, author *string, genre string, status entity.ReadingStatus) *entity.Book {
+		req := RegisterBookRequest{
+			UserID:     userID,
+			Title:      testdata.RandomString(10),
+			Genres:     []string{genre},
+			AuthorName: author,
+			Status:     status,
+		}
+		if status == entity.Done {
+			startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			endDate := startDate.AddDate(0, 0, 7)
+			req.StartDate = &startDate
+			req.EndDate = &endDate
+		}
+		book, err := registerBookUseCase.RegisterBook(context.Background(), req)
+		require.NoError(t, err)
+		return book
+	}
+
+	reader := signUp()
+	other := signUp()
+	author := testdata.RandomString(10)
+	genre := testdata.RandomString(6)
+	otherAuthor := testdata.RandomString(10)
+	register(reader, &author, genre, entity.Done)
+	register(reader, &author, genre, entity.Done)
+
+	sameAuthor := register(other, &author, testdata.RandomString(6), entity.Unread)
+	sameGenre := register(other, &otherAuthor, genre, entity.Unread)
+	register(other, &otherAuthor, testdata.RandomString(6), entity.Unread)
+
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T) RecommendBooksRequest
+		check func(t *testing.T, res []entity.Recommendation, err error)
+	}{
+		{
+			name: "Recommend books by author and genre of finished books",
+			setup: func(t *testing.T) RecommendBooksRequest {
+				return RecommendBooksRequest{UserID: reader}
+			},
+			check: func(t *testing.T, res []entity.Recommendation, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 2)
+				require.Equal(t, sameAuthor.ID, res[0].Book.ID)
+				require.Equal(t, fmt.Sprintf("because you read 2 books by %s", author), res[0].Explanation)
+				require.Equal(t, sameGenre.ID, res[1].Book.ID)
+				require.Equal(t, fmt.Sprintf("because you read 2 books in %s", genre), res[1].Explanation)
+				require.Greater(t, res[0].Score, res[1].Score)
+			},
+		},
+		{
+			name: "Recommend books excludes books registered after caching",
+			setup: func(t *testing.T) RecommendBooksRequest {
+				readingHistoryRepo := repository.NewReadingHistoryRepository(querier)
+				_, err := readingHistoryRepo.Create(context.Background(), repository.CreateReadingHistoryRequest{
+					UserID: reader,
+					BookID: sameAuthor.ID,
+					Status: repository.NewReadingStatus[entity.ReadingStatus](entity.Unread),
+				})
+				require.NoError(t, err)
+				return RecommendBooksRequest{UserID: reader}
+			},
+			check: func(t *testing.T, res []entity.Recommendation, err error) {
+				require.NoError(t, err)
+				for _, r := range res {
+					require.NotEqual(t, sameAuthor.ID, r.Book.ID)
+				}
+			},
+		},
+		{
+			name: "Recommend nothing to user without finished books",
+			setup: func(t *testing.T) RecommendBooksRequest {
+				return RecommendBooksRequest{UserID: signUp()}
+			},
+			check: func(t *testing.T, res []entity.Recommendation, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := tc.setup(t)
+			res, err := recommendUseCase.RecommendBooks(context.Background(), req)
+			tc.check(t, res, err)
+		})
+	}
+
+	// 読書履歴が更新されたユーザーはバックグラウンドジョブの対象になる
+	res, err := refreshUseCase.RefreshRecommendations(context.Background(), RefreshRecommendationsRequest{
+		StaleBefore: time.Now().Add(-time.Hour),
+		Limit:       1000,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, res.RefreshedUsers)
+	require.Zero(t, res.FailedUsers)
+}
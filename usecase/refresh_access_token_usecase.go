@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"github.com/google/uuid"
 	"readly/env"
 	"readly/repository"
@@ -17,17 +19,20 @@ type RefreshAccessTokenUseCaseImpl struct {
 	config      env.Config
 	marker      auth.TokenMaker
 	sessionRepo repository.SessionRepository
+	userRepo    repository.UserRepository
 }
 
 func NewRefreshAccessTokenUseCase(
 	config env.Config,
 	maker auth.TokenMaker,
 	sessionRepo repository.SessionRepository,
+	userRepo repository.UserRepository,
 ) RefreshAccessTokenUseCase {
 	return &RefreshAccessTokenUseCaseImpl{
 		config:      config,
 		marker:      maker,
 		sessionRepo: sessionRepo,
+		userRepo:    userRepo,
 	}
 }
 
@@ -79,7 +84,19 @@ func (u *RefreshAccessTokenUseCaseImpl) Refresh(ctx context.Context, req Refresh
 		return nil, err
 	}
 
-	accessTokenPayload, err := u.marker.Generate(payload.UserID, u.config.AccessTokenDuration)
+	// 無効化やロールの変更をアクセストークンの再発行時に反映する
+	user, err := u.userRepo.GetUserByID(ctx, payload.UserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, newError(UnAuthorized, InvalidTokenError, "user not found")
+		}
+		return nil, err
+	}
+	if user.DisabledAt != nil {
+		return nil, newError(Forbidden, UserDisabledError, "user is disabled")
+	}
+
+	accessTokenPayload, err := u.marker.Generate(user.ID, user.Role.ToEntity(), u.config.AccessTokenDuration)
 	if err != nil {
 		return nil, err
 	}
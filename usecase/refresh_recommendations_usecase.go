@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"readly/repository"
+	"time"
+)
+
+// おすすめとしてキャッシュする最大件数
+const recommendationCacheSize = 50
+
+type RefreshRecommendationsUseCase interface {
+	RefreshRecommendations(ctx context.Context, req RefreshRecommendationsRequest) (*RefreshRecommendationsResponse, error)
+}
+
+type RefreshRecommendationsUseCaseImpl struct {
+	transactor         repository.Transactor
+	recommendationRepo repository.RecommendationRepository
+}
+
+func NewRefreshRecommendationsUseCase(
+	transactor repository.Transactor,
+	recommendationRepo repository.RecommendationRepository,
+) RefreshRecommendationsUseCase {
+	return &RefreshRecommendationsUseCaseImpl{
+		transactor:         transactor,
+		recommendationRepo: recommendationRepo,
+	}
+}
+
+type RefreshRecommendationsRequest struct {
+	// この時刻より前に作成されたおすすめを作り直す
+	StaleBefore time.Time
+	Limit       int32
+}
+
+type RefreshRecommendationsResponse struct {
+	RefreshedUsers int
+	FailedUsers    int
+}
+
+func (u *RefreshRecommendationsUseCaseImpl) RefreshRecommendations(ctx context.Context, req RefreshRecommendationsRequest) (*RefreshRecommendationsResponse, error) {
+	userIDs, err := u.recommendationRepo.GetStaleUsers(ctx, repository.GetStaleRecommendationUsersRequest{
+		StaleBefore: req.StaleBefore,
+		Limit:       req.Limit,
+	})
+	if err != nil {
+		return nil, handle(err)
+	}
+
+	res := &RefreshRecommendationsResponse{}
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return res, handle(err)
+		}
+		// 一部のユーザーの失敗で他のユーザーの更新が止まらないように続行する
+		if err := rebuildRecommendations(ctx, u.transactor, u.recommendationRepo, userID); err != nil {
+			slog.Error("failed to refresh recommendations", "user_id", userID, "error", err)
+			res.FailedUsers++
+			continue
+		}
+		res.RefreshedUsers++
+	}
+	return res, nil
+}
+
+func rebuildRecommendations(
+	ctx context.Context,
+	transactor repository.Transactor,
+	recommendationRepo repository.RecommendationRepository,
+	userID int64,
+) error {
+	candidates, err := recommendationRepo.GetCandidates(ctx, repository.GetRecommendationCandidatesRequest{
+		UserID: userID,
+		Limit:  recommendationCacheSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	return transactor.Exec(ctx, func(ctx context.Context) error {
+		err := recommendationRepo.DeleteByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for i, c := range candidates {
+			req := repository.CreateRecommendationRequest{
+				UserID: userID,
+				BookID: c.BookID,
+				Rank:   int32(i + 1),
+				Score:  c.Score,
+			}
+			// 著者の一致はスコアで2倍に重み付けしているため、説明でも同じ基準で優先する
+			if c.AuthorName != nil && c.AuthorCount > 0 && (c.GenreName == nil || c.AuthorCount*2 >= c.GenreCount) {
+				req.Reason = repository.AuthorReason
+				req.ReasonName = *c.AuthorName
+				req.ReasonCount = c.AuthorCount
+			} else {
+				req.Reason = repository.GenreReason
+				req.ReasonName = *c.GenreName
+				req.ReasonCount = c.GenreCount
+			}
+			err := recommendationRepo.Create(ctx, req)
+			if err != nil {
+				return err
+			}
+		}
+		return recommendationRepo.MarkRefreshed(ctx, userID)
+	})
+}
@@ -6,9 +6,14 @@ import (
 	"github.com/lib/pq"
 	"readly/entity"
 	"readly/repository"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 )
 
+var currencyCodeRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
 type RegisterBookUseCase interface {
 	RegisterBook(ctx context.Context, req RegisterBookRequest) (*entity.Book, error)
 }
@@ -17,20 +22,32 @@ type RegisterBookUseCaseImpl struct {
 	transactor         repository.Transactor
 	bookRepo           repository.BookRepository
 	readingHistoryRepo repository.ReadingHistoryRepository
+	activityRepo       repository.ReadingActivityRepository
 	userRepo           repository.UserRepository
+	feedRepo           repository.FeedRepository
+	outboxRepo         repository.OutboxRepository
+	catalogRepo        repository.CatalogRepository
 }
 
 func NewRegisterBookUseCase(
 	transactor repository.Transactor,
 	bookRepo repository.BookRepository,
 	readingHistoryRepo repository.ReadingHistoryRepository,
+	activityRepo repository.ReadingActivityRepository,
 	userRepo repository.UserRepository,
+	feedRepo repository.FeedRepository,
+	outboxRepo repository.OutboxRepository,
+	catalogRepo repository.CatalogRepository,
 ) RegisterBookUseCase {
 	return &RegisterBookUseCaseImpl{
 		transactor:         transactor,
 		bookRepo:           bookRepo,
 		readingHistoryRepo: readingHistoryRepo,
+		activityRepo:       activityRepo,
 		userRepo:           userRepo,
+		feedRepo:           feedRepo,
+		outboxRepo:         outboxRepo,
+		catalogRepo:        catalogRepo,
 	}
 }
 
@@ -45,20 +62,43 @@ type RegisterBookRequest struct {
 	PublisherName *string
 	PublishDate   *time.Time
 	ISBN          *string
+	PageCount     *int32
 	Status        entity.ReadingStatus
 	StartDate     *time.Time
 	EndDate       *time.Time
+	Priority      int16
+	// nilの場合は所有しているものとして扱う
+	Owned  *bool
+	Format entity.BookFormat
+	// 通貨の最小単位(円、セントなど)での金額
+	PurchasePrice    *int64
+	PurchaseCurrency *string
+	PurchaseStore    *string
+	PurchaseDate     *time.Time
+	DurationMinutes  *int32
 }
 
 func (u *RegisterBookUseCaseImpl) RegisterBook(ctx context.Context, req RegisterBookRequest) (*entity.Book, error) {
+	if req.Priority < minPriority || req.Priority > maxPriority {
+		return nil, newError(BadRequest, InvalidPriorityError, "priority must be between 0 and 5")
+	}
+	owned := true
+	if req.Owned != nil {
+		owned = *req.Owned
+	}
+	currency, err := u.validateEdition(req)
+	if err != nil {
+		return nil, err
+	}
+
 	var res *entity.Book
-	err := u.transactor.Exec(ctx, func() error {
-		err := u.createAuthorIfNeed(ctx, req.AuthorName)
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		author, err := u.createAuthorIfNeed(ctx, req.AuthorName)
 		if err != nil {
 			return err
 		}
 
-		err = u.createPublisherIfNeed(ctx, req.PublisherName)
+		publisher, err := u.createPublisherIfNeed(ctx, req.PublisherName)
 		if err != nil {
 			return err
 		}
@@ -68,20 +108,27 @@ func (u *RegisterBookUseCaseImpl) RegisterBook(ctx context.Context, req Register
 			Description:   req.Description,
 			CoverImageURL: req.CoverImageURL,
 			URL:           req.URL,
-			Author:        req.AuthorName,
-			Publisher:     req.PublisherName,
+			Author:        author,
+			Publisher:     publisher,
 			PublishDate:   req.PublishDate,
 			ISBN:          req.ISBN,
+			PageCount:     req.PageCount,
 		}
 		b, err := u.bookRepo.CreateBook(ctx, createArgs)
 		if err != nil {
 			return err
 		}
-		for _, genre := range req.Genres {
-			err := u.createGenreIfNeed(ctx, genre)
+		genres := make([]string, 0, len(req.Genres))
+		for _, g := range req.Genres {
+			genre, err := u.createGenreIfNeed(ctx, g)
 			if err != nil {
 				return err
 			}
+			// 別名を解決すると同じジャンルになる場合がある
+			if slices.Contains(genres, genre) {
+				continue
+			}
+			genres = append(genres, genre)
 			args := repository.CreateBookGenreRequest{
 				BookID:    b.ID,
 				GenreName: genre,
@@ -91,74 +138,191 @@ func (u *RegisterBookUseCaseImpl) RegisterBook(ctx context.Context, req Register
 				return err
 			}
 		}
+		// 未読の本は読みたい本のキューの末尾に追加する
+		var queuePosition *int32
+		if req.Status == entity.Unread {
+			next, err := u.readingHistoryRepo.GetNextQueuePosition(ctx, req.UserID)
+			if err != nil {
+				return err
+			}
+			queuePosition = &next
+		}
 		createHistoryArgs := repository.CreateReadingHistoryRequest{
-			UserID:    req.UserID,
-			BookID:    b.ID,
-			Status:    repository.NewReadingStatus[entity.ReadingStatus](req.Status),
-			StartDate: req.StartDate,
-			EndDate:   req.EndDate,
+			UserID:           req.UserID,
+			BookID:           b.ID,
+			Status:           repository.NewReadingStatus[entity.ReadingStatus](req.Status),
+			StartDate:        req.StartDate,
+			EndDate:          req.EndDate,
+			Priority:         req.Priority,
+			QueuePosition:    queuePosition,
+			Owned:            owned,
+			Format:           repository.NewBookFormat(req.Format),
+			PurchasePrice:    req.PurchasePrice,
+			PurchaseCurrency: currency,
+			PurchaseStore:    req.PurchaseStore,
+			PurchaseDate:     req.PurchaseDate,
+			DurationMinutes:  req.DurationMinutes,
 		}
 		rh, err := u.readingHistoryRepo.Create(ctx, createHistoryArgs)
 		if err != nil {
 			return err
 		}
+		// 未読での登録は読書の活動とみなさない
+		if req.Status == entity.Reading || req.Status == entity.Done {
+			activityArgs := repository.CreateReadingActivityRequest{
+				UserID:     req.UserID,
+				BookID:     b.ID,
+				Type:       repository.StatusChanged,
+				OccurredAt: time.Now(),
+			}
+			err = u.activityRepo.Create(ctx, activityArgs)
+			if err != nil {
+				return err
+			}
+		}
+		feedArgs := repository.CreateFeedEventRequest{
+			UserID: req.UserID,
+			BookID: b.ID,
+			Type:   newFeedEventType(req.Status),
+		}
+		err = u.feedRepo.CreateEvent(ctx, feedArgs)
+		if err != nil {
+			return err
+		}
+		eventArgs := repository.CreateOutboxEventRequest{
+			Type: entity.BookRegistered,
+			Payload: entity.BookRegisteredPayload{
+				UserID: req.UserID,
+				BookID: b.ID,
+				Title:  b.Title,
+				Status: rh.Status.ToEntity().String(),
+			},
+		}
+		err = u.outboxRepo.Create(ctx, eventArgs)
+		if err != nil {
+			return err
+		}
 		res = &entity.Book{
-			ID:            b.ID,
-			Title:         b.Title,
-			Genres:        req.Genres,
-			Description:   b.Description,
-			CoverImageURL: b.CoverImageURL,
-			URL:           b.URL,
-			AuthorName:    b.Author,
-			PublisherName: b.Publisher,
-			PublishDate:   b.PublishDate,
-			ISBN:          b.ISBN,
-			Status:        rh.Status.ToEntity(),
-			StartDate:     rh.StartDate,
-			EndDate:       rh.EndDate,
+			ID:               b.ID,
+			Title:            b.Title,
+			Genres:           genres,
+			Description:      b.Description,
+			CoverImageURL:    b.CoverImageURL,
+			URL:              b.URL,
+			AuthorName:       b.Author,
+			PublisherName:    b.Publisher,
+			PublishDate:      b.PublishDate,
+			ISBN:             b.ISBN,
+			PageCount:        b.PageCount,
+			Status:           rh.Status.ToEntity(),
+			StartDate:        rh.StartDate,
+			EndDate:          rh.EndDate,
+			Priority:         rh.Priority,
+			QueuePosition:    rh.QueuePosition,
+			Owned:            rh.Owned,
+			Format:           rh.Format.ToEntity(),
+			PurchasePrice:    rh.PurchasePrice,
+			PurchaseCurrency: rh.PurchaseCurrency,
+			PurchaseStore:    rh.PurchaseStore,
+			PurchaseDate:     rh.PurchaseDate,
+			DurationMinutes:  rh.DurationMinutes,
+			Version:          rh.Version,
+			CatalogVersion:   b.Version,
 		}
 		return nil
 	})
 	return res, handle(err)
 }
 
-func (u *RegisterBookUseCaseImpl) createAuthorIfNeed(ctx context.Context, author *string) error {
+// newFeedEventType 読書中・読了で登録した場合はその状態を、それ以外は本の追加をフィードに流す
+func newFeedEventType(status entity.ReadingStatus) repository.FeedEventType {
+	switch status {
+	case entity.Reading:
+		return repository.StartedReadingEvent
+	case entity.Done:
+		return repository.FinishedReadingEvent
+	default:
+		return repository.AddedBookEvent
+	}
+}
+
+// validateEdition 購入情報と再生時間を検証し、大文字に正規化した通貨コードを返す
+func (u *RegisterBookUseCaseImpl) validateEdition(req RegisterBookRequest) (*string, error) {
+	if (req.PurchasePrice == nil) != (req.PurchaseCurrency == nil) {
+		return nil, newError(BadRequest, InvalidPurchaseError, "purchase price and currency must be specified together")
+	}
+	var currency *string
+	if req.PurchasePrice != nil {
+		if *req.PurchasePrice < 0 {
+			return nil, newError(BadRequest, InvalidPurchaseError, "purchase price must not be negative")
+		}
+		c := strings.ToUpper(*req.PurchaseCurrency)
+		if !currencyCodeRegexp.MatchString(c) {
+			return nil, newError(BadRequest, InvalidPurchaseError, "currency must be an ISO 4217 code")
+		}
+		currency = &c
+	}
+	if req.DurationMinutes != nil {
+		if req.Format != entity.Audiobook {
+			return nil, newError(BadRequest, InvalidDurationError, "only audiobooks can have a duration")
+		}
+		if *req.DurationMinutes <= 0 {
+			return nil, newError(BadRequest, InvalidDurationError, "duration must be positive")
+		}
+	}
+	return currency, nil
+}
+
+// createAuthorIfNeed 統合済みの別名は統合先の著者に置き換え、登録した著者名を返す
+func (u *RegisterBookUseCaseImpl) createAuthorIfNeed(ctx context.Context, author *string) (*string, error) {
 	if author == nil {
-		return nil
+		return nil, nil
 	}
 	if len(*author) == 0 {
-		return nil
+		return author, nil
 	}
-	_, err := u.bookRepo.CreateAuthor(ctx, *author)
+	name, err := resolveCatalogName(ctx, u.catalogRepo, entity.CatalogAuthor, *author)
 	if err != nil {
-		return u.checkDuplicateKeyError(err)
+		return nil, err
 	}
-	return nil
+	_, err = u.bookRepo.CreateAuthor(ctx, name)
+	if err != nil {
+		return nil, u.checkDuplicateKeyError(err)
+	}
+	return &name, nil
 }
 
-func (u *RegisterBookUseCaseImpl) createPublisherIfNeed(ctx context.Context, publisher *string) error {
+func (u *RegisterBookUseCaseImpl) createPublisherIfNeed(ctx context.Context, publisher *string) (*string, error) {
 	if publisher == nil {
-		return nil
+		return nil, nil
 	}
 	if len(*publisher) == 0 {
-		return nil
+		return publisher, nil
 	}
-	_, err := u.bookRepo.CreatePublisher(ctx, *publisher)
+	name, err := resolveCatalogName(ctx, u.catalogRepo, entity.CatalogPublisher, *publisher)
 	if err != nil {
-		return u.checkDuplicateKeyError(err)
+		return nil, err
 	}
-	return err
+	_, err = u.bookRepo.CreatePublisher(ctx, name)
+	if err != nil {
+		return nil, u.checkDuplicateKeyError(err)
+	}
+	return &name, nil
 }
 
-func (u *RegisterBookUseCaseImpl) createGenreIfNeed(ctx context.Context, genre string) error {
+func (u *RegisterBookUseCaseImpl) createGenreIfNeed(ctx context.Context, genre string) (string, error) {
 	if len(genre) == 0 {
-		return nil
+		return genre, nil
 	}
-	_, err := u.bookRepo.CreateGenre(ctx, genre)
+	name, err := resolveCatalogName(ctx, u.catalogRepo, entity.CatalogGenre, genre)
 	if err != nil {
-		return u.checkDuplicateKeyError(err)
+		return "", err
 	}
-	return nil
+	_, err = u.bookRepo.CreateGenre(ctx, name)
+	if err != nil {
+		return "", u.checkDuplicateKeyError(err)
+	}
+	return name, nil
 }
 
 func (u *RegisterBookUseCaseImpl) checkDuplicateKeyError(err error) error {
@@ -184,6 +184,75 @@ func TestRegisterBook(t *testing.T) {
 				require.True(t, isSameDate(req.EndDate, res.EndDate))
 			},
 		},
+		{
+			name: "New audiobook with purchase details register success",
+			setup: func(t *testing.T) RegisterBookRequest {
+				price := int64(1500)
+				currency := "jpy"
+				store := testdata.RandomString(10)
+				purchaseDate := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+				duration := int32(480)
+				return RegisterBookRequest{
+					UserID:           signUpRes.UserID,
+					Title:            testdata.RandomString(10),
+					Status:           0,
+					Format:           entity.Audiobook,
+					PurchasePrice:    &price,
+					PurchaseCurrency: &currency,
+					PurchaseStore:    &store,
+					PurchaseDate:     &purchaseDate,
+					DurationMinutes:  &duration,
+				}
+			},
+			check: func(t *testing.T, req RegisterBookRequest, res *entity.Book, err error) {
+				require.NoError(t, err)
+				require.Equal(t, entity.Audiobook, res.Format)
+				require.Equal(t, req.PurchasePrice, res.PurchasePrice)
+				require.Equal(t, "JPY", *res.PurchaseCurrency)
+				require.Equal(t, req.PurchaseStore, res.PurchaseStore)
+				require.True(t, isSameDate(req.PurchaseDate, res.PurchaseDate))
+				require.Equal(t, req.DurationMinutes, res.DurationMinutes)
+			},
+		},
+		{
+			name: "Register book failure if purchase price is set without currency",
+			setup: func(t *testing.T) RegisterBookRequest {
+				price := int64(1500)
+				return RegisterBookRequest{
+					UserID:        signUpRes.UserID,
+					Title:         testdata.RandomString(10),
+					Status:        0,
+					PurchasePrice: &price,
+				}
+			},
+			check: func(t *testing.T, req RegisterBookRequest, res *entity.Book, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidPurchaseError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Register book failure if duration is set for paperback",
+			setup: func(t *testing.T) RegisterBookRequest {
+				duration := int32(60)
+				return RegisterBookRequest{
+					UserID:          signUpRes.UserID,
+					Title:           testdata.RandomString(10),
+					Status:          0,
+					Format:          entity.Paperback,
+					DurationMinutes: &duration,
+				}
+			},
+			check: func(t *testing.T, req RegisterBookRequest, res *entity.Book, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidDurationError, e.ErrorCode)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+	"readly/service/event"
+)
+
+type RelayOutboxEventsUseCase interface {
+	RelayOutboxEvents(ctx context.Context, req RelayOutboxEventsRequest) (*RelayOutboxEventsResponse, error)
+}
+
+type RelayOutboxEventsUseCaseImpl struct {
+	transactor repository.Transactor
+	outboxRepo repository.OutboxRepository
+	// 配信済みの記録と同じトランザクションで配信する
+	sinks []event.Sink
+	// 配信済みの記録がコミットされてから通知する。プロセス内のBusのようにトランザクションに参加できないSinkを登録する
+	notifiers []event.Sink
+}
+
+func NewRelayOutboxEventsUseCase(
+	transactor repository.Transactor,
+	outboxRepo repository.OutboxRepository,
+	sinks []event.Sink,
+	notifiers []event.Sink,
+) RelayOutboxEventsUseCase {
+	return &RelayOutboxEventsUseCaseImpl{
+		transactor: transactor,
+		outboxRepo: outboxRepo,
+		sinks:      sinks,
+		notifiers:  notifiers,
+	}
+}
+
+type RelayOutboxEventsRequest struct {
+	Limit int32
+}
+
+type RelayOutboxEventsResponse struct {
+	PublishedEvents int
+}
+
+// RelayOutboxEvents 未配信のイベントを古い順に全てのSinkへ配信する。
+// 配信に失敗したイベント以降は順序を保つために次回の実行まで配信しない。
+// 配信済みとして記録する前に失敗した場合は再度配信されるため、少なくとも1回は届く。
+// notifiersにはコミット後に通知するため、コミット前の配信順の番号やロールバックされた配信が見えることはない。
+func (u *RelayOutboxEventsUseCaseImpl) RelayOutboxEvents(ctx context.Context, req RelayOutboxEventsRequest) (*RelayOutboxEventsResponse, error) {
+	res := &RelayOutboxEventsResponse{}
+	var publishErr error
+	var published []entity.DomainEvent
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
+		published = nil
+		events, err := u.outboxRepo.GetUnpublished(ctx, req.Limit)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			e.PublishedSeq, err = u.outboxRepo.NextPublishedSeq(ctx)
+			if err != nil {
+				return err
+			}
+			de := newDomainEvent(e)
+			// 配信できたイベントまでは配信済みとして記録したいのでロールバックさせない
+			if publishErr = publish(ctx, u.sinks, de); publishErr != nil {
+				return nil
+			}
+			err = u.outboxRepo.MarkPublished(ctx, repository.MarkOutboxEventPublishedRequest{
+				ID:           e.ID,
+				PublishedSeq: e.PublishedSeq,
+			})
+			if err != nil {
+				return err
+			}
+			published = append(published, de)
+			res.PublishedEvents++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, handle(err)
+	}
+	for _, de := range published {
+		if err := publish(ctx, u.notifiers, de); err != nil {
+			return res, handle(err)
+		}
+	}
+	return res, handle(publishErr)
+}
+
+func newDomainEvent(e repository.OutboxEventResponse) entity.DomainEvent {
+	return entity.DomainEvent{
+		ID:           e.ID,
+		Type:         e.Type,
+		Payload:      e.Payload,
+		OccurredAt:   e.CreatedAt,
+		PublishedSeq: e.PublishedSeq,
+	}
+}
+
+func publish(ctx context.Context, sinks []event.Sink, e entity.DomainEvent) error {
+	for _, s := range sinks {
+		if err := s.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
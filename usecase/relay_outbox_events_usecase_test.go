@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/service/event"
+	"readly/testdata"
+	"testing"
+)
+
+// drainOutbox 他のテストで書き込まれたイベントを配信済みにする
+func drainOutbox(t *testing.T) {
+	relayUseCase := newTestRelayOutboxEventsUseCase(t, nil)
+	for {
+		res, err := relayUseCase.RelayOutboxEvents(context.Background(), RelayOutboxEventsRequest{Limit: 100})
+		require.NoError(t, err)
+		if res.PublishedEvents == 0 {
+			return
+		}
+	}
+}
+
+func TestRelayOutboxEvents(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	deleteBookUseCase := newTestDeleteBookUseCase(t)
+
+	drainOutbox(t)
+
+	user := signUpTestUser(t)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: user.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Reading,
+	})
+	require.NoError(t, err)
+	err = deleteBookUseCase.DeleteBook(context.Background(), DeleteBookRequest{UserID: user.UserID, BookID: book.ID})
+	require.NoError(t, err)
+
+	var published []entity.DomainEvent
+	fails := 1
+	sink := event.SinkFunc(func(_ context.Context, e entity.DomainEvent) error {
+		// 2件目の配信を1度だけ失敗させる
+		if len(published) == 1 && fails > 0 {
+			fails--
+			return errors.New("sink unavailable")
+		}
+		published = append(published, e)
+		return nil
+	})
+	var notified []entity.DomainEvent
+	notifier := event.SinkFunc(func(_ context.Context, e entity.DomainEvent) error {
+		notified = append(notified, e)
+		return nil
+	})
+	relayUseCase := newTestRelayOutboxEventsUseCase(t, []event.Sink{sink}, notifier)
+
+	res, err := relayUseCase.RelayOutboxEvents(context.Background(), RelayOutboxEventsRequest{Limit: 100})
+	require.Error(t, err)
+	require.Equal(t, 1, res.PublishedEvents)
+	require.Len(t, published, 1)
+	// 配信済みとして記録されたイベントだけをコミット後に通知する
+	require.Len(t, notified, 1)
+	require.Equal(t, published[0].ID, notified[0].ID)
+
+	res, err = relayUseCase.RelayOutboxEvents(context.Background(), RelayOutboxEventsRequest{Limit: 100})
+	require.NoError(t, err)
+	require.Equal(t, 2, res.PublishedEvents)
+	require.Len(t, published, 3)
+	require.Len(t, notified, 3)
+
+	require.Equal(t, entity.UserSignedUp, published[0].Type)
+	require.Equal(t, entity.BookRegistered, published[1].Type)
+	require.Equal(t, entity.BookDeleted, published[2].Type)
+	require.Less(t, published[0].ID, published[1].ID)
+	require.Less(t, published[1].ID, published[2].ID)
+	// 配信した順に番号が増え、失敗した配信の番号は欠番になる
+	require.Less(t, published[0].PublishedSeq, published[1].PublishedSeq-1)
+	require.Less(t, published[1].PublishedSeq, published[2].PublishedSeq)
+
+	var payload entity.BookRegisteredPayload
+	require.NoError(t, json.Unmarshal(published[1].Payload, &payload))
+	require.Equal(t, user.UserID, payload.UserID)
+	require.Equal(t, book.ID, payload.BookID)
+	require.Equal(t, entity.Reading.String(), payload.Status)
+
+	res, err = relayUseCase.RelayOutboxEvents(context.Background(), RelayOutboxEventsRequest{Limit: 100})
+	require.NoError(t, err)
+	require.Zero(t, res.PublishedEvents)
+}
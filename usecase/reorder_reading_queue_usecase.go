@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type ReorderReadingQueueUseCase interface {
+	ReorderReadingQueue(ctx context.Context, req ReorderReadingQueueRequest) ([]entity.Book, error)
+}
+
+type ReorderReadingQueueUseCaseImpl struct {
+	transactor         repository.Transactor
+	readingHistoryRepo repository.ReadingHistoryRepository
+}
+
+func NewReorderReadingQueueUseCase(
+	transactor repository.Transactor,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+) ReorderReadingQueueUseCase {
+	return &ReorderReadingQueueUseCaseImpl{
+		transactor:         transactor,
+		readingHistoryRepo: readingHistoryRepo,
+	}
+}
+
+type ReorderReadingQueueRequest struct {
+	UserID int64
+	// 新しい並び順。キューにある本を過不足なく指定する
+	BookIDs []int64
+}
+
+func (u *ReorderReadingQueueUseCaseImpl) ReorderReadingQueue(ctx context.Context, req ReorderReadingQueueRequest) ([]entity.Book, error) {
+	var res []entity.Book
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
+		queue, err := u.readingHistoryRepo.GetQueue(ctx, req.UserID)
+		if err != nil {
+			return err
+		}
+		if !isSameBookSet(queue, req.BookIDs) {
+			return newError(BadRequest, InvalidQueueError, "book ids must match the books in the queue")
+		}
+		for i, bookID := range req.BookIDs {
+			args := repository.UpdateQueuePositionRequest{
+				UserID:        req.UserID,
+				BookID:        bookID,
+				QueuePosition: int32(i + 1),
+			}
+			err := u.readingHistoryRepo.UpdateQueuePosition(ctx, args)
+			if err != nil {
+				return err
+			}
+		}
+		queue, err = u.readingHistoryRepo.GetQueue(ctx, req.UserID)
+		if err != nil {
+			return err
+		}
+		res = newQueuedBooks(queue)
+		return nil
+	})
+	return res, handle(err)
+}
+
+func isSameBookSet(queue []repository.GetReadingQueueResponse, bookIDs []int64) bool {
+	if len(queue) != len(bookIDs) {
+		return false
+	}
+	queued := make(map[int64]bool, len(queue))
+	for _, q := range queue {
+		queued[q.BookID] = true
+	}
+	for _, id := range bookIDs {
+		if !queued[id] {
+			return false
+		}
+		// 同じ本の重複指定を防ぐ
+		delete(queued, id)
+	}
+	return true
+}
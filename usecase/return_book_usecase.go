@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+	"time"
+)
+
+type ReturnBookUseCase interface {
+	ReturnBook(ctx context.Context, req ReturnBookRequest) (*entity.Loan, error)
+}
+
+type ReturnBookUseCaseImpl struct {
+	transactor repository.Transactor
+	loanRepo   repository.LoanRepository
+	userRepo   repository.UserRepository
+}
+
+func NewReturnBookUseCase(
+	transactor repository.Transactor,
+	loanRepo repository.LoanRepository,
+	userRepo repository.UserRepository,
+) ReturnBookUseCase {
+	return &ReturnBookUseCaseImpl{
+		transactor: transactor,
+		loanRepo:   loanRepo,
+		userRepo:   userRepo,
+	}
+}
+
+type ReturnBookRequest struct {
+	UserID int64
+	LoanID int64
+	// nilの場合はユーザーのタイムゾーンでの今日を返却日とする
+	ReturnDate *time.Time
+}
+
+func (u *ReturnBookUseCaseImpl) ReturnBook(ctx context.Context, req ReturnBookRequest) (*entity.Loan, error) {
+	var res *entity.Loan
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
+		loan, err := u.loanRepo.GetByID(ctx, repository.GetLoanByIDRequest{
+			ID:     req.LoanID,
+			UserID: req.UserID,
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return newError(NotFound, NotFoundLoanError, "loan not found")
+			}
+			return err
+		}
+		if loan.ReturnDate != nil {
+			return newError(Conflict, LoanAlreadyReturnedError, "book is already returned")
+		}
+
+		var returnDate time.Time
+		if req.ReturnDate != nil {
+			returnDate = toDate(*req.ReturnDate, time.UTC)
+		} else {
+			user, err := u.userRepo.GetUserByID(ctx, req.UserID)
+			if err != nil {
+				return newError(BadRequest, NotFoundUserError, "user not found")
+			}
+			loc, err := time.LoadLocation(user.Timezone)
+			if err != nil {
+				return err
+			}
+			returnDate = toDate(time.Now(), loc)
+		}
+		if returnDate.Before(toDate(loan.LoanDate, time.UTC)) {
+			return newError(BadRequest, InvalidDateRangeError, "return date must be on or after loan date")
+		}
+
+		_, err = u.loanRepo.Return(ctx, repository.ReturnLoanRequest{
+			ID:         req.LoanID,
+			UserID:     req.UserID,
+			ReturnDate: returnDate,
+		})
+		if err != nil {
+			// 同時に返却された場合は条件に一致する行がなくなる
+			if errors.Is(err, sql.ErrNoRows) {
+				return newError(Conflict, LoanAlreadyReturnedError, "book is already returned")
+			}
+			return err
+		}
+		loan, err = u.loanRepo.GetByID(ctx, repository.GetLoanByIDRequest{
+			ID:     req.LoanID,
+			UserID: req.UserID,
+		})
+		if err != nil {
+			return err
+		}
+		l := newLoan(*loan)
+		res = &l
+		return nil
+	})
+	return res, handle(err)
+}
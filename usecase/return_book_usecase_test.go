@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestReturnBook(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	lendBookUseCase := newTestLendBookUseCase(t)
+	returnBookUseCase := newTestReturnBookUseCase(t)
+	activeLoansUseCase := newTestListActiveLoansUseCase(t)
+	overdueLoansUseCase := newTestListOverdueLoansUseCase(t)
+
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	})
+	require.NoError(t, err)
+
+	name := testdata.RandomString(10)
+	loanDate := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	pastDueDate := time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)
+	futureDueDate := time.Now().AddDate(0, 1, 0)
+	lend := func(dueDate *time.Time) *entity.Loan {
+		book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID: signUpRes.UserID,
+			Title:  testdata.RandomString(10),
+			Status: entity.Done,
+		})
+		require.NoError(t, err)
+		loan, err := lendBookUseCase.LendBook(context.Background(), LendBookRequest{
+			UserID:       signUpRes.UserID,
+			BookID:       book.ID,
+			BorrowerName: &name,
+			LoanDate:     &loanDate,
+			DueDate:      dueDate,
+		})
+		require.NoError(t, err)
+		return loan
+	}
+	overdue := lend(&pastDueDate)
+	notDue := lend(&futureDueDate)
+	noDueDate := lend(nil)
+
+	active, err := activeLoansUseCase.ListActiveLoans(context.Background(), ListActiveLoansRequest{UserID: signUpRes.UserID})
+	require.NoError(t, err)
+	require.Len(t, active, 3)
+	overdueLoans, err := overdueLoansUseCase.ListOverdueLoans(context.Background(), ListOverdueLoansRequest{UserID: signUpRes.UserID})
+	require.NoError(t, err)
+	require.Len(t, overdueLoans, 1)
+	require.Equal(t, overdue.ID, overdueLoans[0].ID)
+	require.True(t, overdueLoans[0].Book.Overdue)
+
+	t.Run("Return book failure if return date is before loan date", func(t *testing.T) {
+		returnDate := loanDate.AddDate(0, 0, -1)
+		loan, err := returnBookUseCase.ReturnBook(context.Background(), ReturnBookRequest{
+			UserID:     signUpRes.UserID,
+			LoanID:     notDue.ID,
+			ReturnDate: &returnDate,
+		})
+		require.Nil(t, loan)
+		var e *Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, BadRequest, e.StatusCode)
+		require.Equal(t, InvalidDateRangeError, e.ErrorCode)
+	})
+
+	t.Run("Return book success", func(t *testing.T) {
+		loan, err := returnBookUseCase.ReturnBook(context.Background(), ReturnBookRequest{
+			UserID: signUpRes.UserID,
+			LoanID: overdue.ID,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, loan.ReturnDate)
+		require.False(t, loan.Overdue)
+		require.False(t, loan.Book.Overdue)
+
+		active, err := activeLoansUseCase.ListActiveLoans(context.Background(), ListActiveLoansRequest{UserID: signUpRes.UserID})
+		require.NoError(t, err)
+		require.Len(t, active, 2)
+		require.ElementsMatch(t, []int64{notDue.ID, noDueDate.ID}, []int64{active[0].ID, active[1].ID})
+		overdueLoans, err := overdueLoansUseCase.ListOverdueLoans(context.Background(), ListOverdueLoansRequest{UserID: signUpRes.UserID})
+		require.NoError(t, err)
+		require.Empty(t, overdueLoans)
+	})
+
+	t.Run("Return book failure if already returned", func(t *testing.T) {
+		loan, err := returnBookUseCase.ReturnBook(context.Background(), ReturnBookRequest{
+			UserID: signUpRes.UserID,
+			LoanID: overdue.ID,
+		})
+		require.Nil(t, loan)
+		var e *Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, Conflict, e.StatusCode)
+		require.Equal(t, LoanAlreadyReturnedError, e.ErrorCode)
+	})
+
+	t.Run("Return book failure if loan is not found", func(t *testing.T) {
+		loan, err := returnBookUseCase.ReturnBook(context.Background(), ReturnBookRequest{
+			UserID: signUpRes.UserID,
+			LoanID: -1,
+		})
+		require.Nil(t, loan)
+		var e *Error
+		require.ErrorAs(t, err, &e)
+		require.Equal(t, NotFound, e.StatusCode)
+		require.Equal(t, NotFoundLoanError, e.ErrorCode)
+	})
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+type RevokeUserSessionsUseCase interface {
+	RevokeUserSessions(ctx context.Context, req RevokeUserSessionsRequest) (int64, error)
+}
+
+type RevokeUserSessionsUseCaseImpl struct {
+	transactor   repository.Transactor
+	userRepo     repository.UserRepository
+	sessionRepo  repository.SessionRepository
+	auditLogRepo repository.AuditLogRepository
+}
+
+func NewRevokeUserSessionsUseCase(
+	transactor repository.Transactor,
+	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
+	auditLogRepo repository.AuditLogRepository,
+) RevokeUserSessionsUseCase {
+	return &RevokeUserSessionsUseCaseImpl{
+		transactor:   transactor,
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		auditLogRepo: auditLogRepo,
+	}
+}
+
+type RevokeUserSessionsRequest struct {
+	ActorID int64
+	Role    entity.UserRole
+	UserID  int64
+}
+
+// RevokeUserSessions ユーザーのすべてのリフレッシュトークンを失効させ、失効させた数を返す
+func (u *RevokeUserSessionsUseCaseImpl) RevokeUserSessions(ctx context.Context, req RevokeUserSessionsRequest) (revoked int64, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	err = authorize(req.Role, ManageUsers)
+	if err != nil {
+		return 0, err
+	}
+
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		_, err := u.userRepo.GetUserByID(ctx, req.UserID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return newError(NotFound, NotFoundUserError, "user not found")
+			}
+			return err
+		}
+		revoked, err = u.sessionRepo.RevokeSessionsByUserID(ctx, req.UserID)
+		if err != nil {
+			return err
+		}
+		return u.auditLogRepo.Create(ctx, repository.CreateAuditLogRequest{
+			ActorID:    req.ActorID,
+			Action:     entity.AuditRevokeSessions,
+			TargetType: entity.AuditTargetUser,
+			TargetID:   &req.UserID,
+			Detail: map[string]any{
+				"revoked_sessions": revoked,
+			},
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return revoked, nil
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+	"strings"
+	"time"
+)
+
+const maxClubSections = 100
+
+type SetClubScheduleUseCase interface {
+	SetClubSchedule(ctx context.Context, req SetClubScheduleRequest) ([]entity.ClubSection, error)
+}
+
+type SetClubScheduleUseCaseImpl struct {
+	transactor repository.Transactor
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+	bookRepo   repository.BookRepository
+}
+
+func NewSetClubScheduleUseCase(
+	transactor repository.Transactor,
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+	bookRepo repository.BookRepository,
+) SetClubScheduleUseCase {
+	return &SetClubScheduleUseCaseImpl{
+		transactor: transactor,
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+		bookRepo:   bookRepo,
+	}
+}
+
+type SetClubScheduleRequest struct {
+	ClubID int64
+	UserID int64
+	BookID int64
+	// 読む順に並べる
+	Sections []ClubSectionRequest
+}
+
+type ClubSectionRequest struct {
+	Title string
+	// 章とページの少なくともどちらかを指定する
+	Chapter    *string
+	TargetPage *int32
+	DueDate    time.Time
+}
+
+// SetClubSchedule クラブで読む本とスケジュールを設定する。
+// 本を変更した場合はメンバーの進捗をリセットする。同じ本の場合は同じ位置の区切りのディスカッションを引き継ぐ
+func (u *SetClubScheduleUseCaseImpl) SetClubSchedule(ctx context.Context, req SetClubScheduleRequest) (res []entity.ClubSection, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	club, _, err := authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.UserID, repository.ClubOwner, repository.ClubModerator)
+	if err != nil {
+		return nil, err
+	}
+	book, err := u.bookRepo.GetBookByID(ctx, req.BookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, newError(NotFound, NotFoundBookError, "book not found")
+		}
+		return nil, err
+	}
+	err = validateClubSections(req.Sections, book.PageCount)
+	if err != nil {
+		return nil, err
+	}
+
+	var sections []repository.ClubSectionResponse
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		if club.CurrentBookID == nil || *club.CurrentBookID != book.ID {
+			_, err := u.clubRepo.UpdateCurrentBook(ctx, repository.UpdateClubCurrentBookRequest{
+				ID:     club.ID,
+				BookID: book.ID,
+			})
+			if err != nil {
+				return err
+			}
+			err = u.memberRepo.ResetProgress(ctx, club.ID)
+			if err != nil {
+				return err
+			}
+		}
+		for i, s := range req.Sections {
+			section, err := u.clubRepo.UpsertSection(ctx, repository.UpsertClubSectionRequest{
+				ClubID:     club.ID,
+				BookID:     book.ID,
+				Position:   int32(i + 1),
+				Title:      strings.TrimSpace(s.Title),
+				Chapter:    s.Chapter,
+				TargetPage: s.TargetPage,
+				DueDate:    s.DueDate,
+			})
+			if err != nil {
+				return err
+			}
+			sections = append(sections, *section)
+		}
+		return u.clubRepo.DeleteSectionsAfter(ctx, repository.DeleteClubSectionsAfterRequest{
+			ClubID:   club.ID,
+			BookID:   book.ID,
+			Position: int32(len(req.Sections)),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newClubSections(sections), nil
+}
+
+// validateClubSections 期日とページは前の区切りより後ろである必要がある
+func validateClubSections(sections []ClubSectionRequest, pageCount *int32) error {
+	if len(sections) == 0 || len(sections) > maxClubSections {
+		return newError(BadRequest, InvalidScheduleError, "schedule must have between 1 and 100 sections")
+	}
+	var prev *ClubSectionRequest
+	for i := range sections {
+		s := &sections[i]
+		if strings.TrimSpace(s.Title) == "" {
+			return newError(BadRequest, InvalidScheduleError, "section title is required")
+		}
+		if (s.Chapter == nil || strings.TrimSpace(*s.Chapter) == "") && s.TargetPage == nil {
+			return newError(BadRequest, InvalidScheduleError, "section must have a chapter or target page")
+		}
+		if s.TargetPage != nil {
+			if *s.TargetPage <= 0 {
+				return newError(BadRequest, InvalidScheduleError, "target page must be positive")
+			}
+			if pageCount != nil && *s.TargetPage > *pageCount {
+				return newError(BadRequest, InvalidScheduleError, "target page exceeds page count of the book")
+			}
+		}
+		if prev != nil {
+			if s.DueDate.Before(prev.DueDate) {
+				return newError(BadRequest, InvalidScheduleError, "due dates must be in order")
+			}
+			if s.TargetPage != nil && prev.TargetPage != nil && *s.TargetPage < *prev.TargetPage {
+				return newError(BadRequest, InvalidScheduleError, "target pages must be in order")
+			}
+		}
+		prev = s
+	}
+	return nil
+}
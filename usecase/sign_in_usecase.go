@@ -55,7 +55,7 @@ type SignInResponse struct {
 
 func (u *SignInUseCaseImpl) SignIn(ctx context.Context, req SignInRequest) (*SignInResponse, error) {
 	var res *SignInResponse
-	err := u.transactor.Exec(ctx, func() error {
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
 		user, err := u.userRepo.GetUserByEmail(ctx, req.Email)
 		if err != nil {
 			return newError(BadRequest, NotFoundUserError, "user not found")
@@ -65,13 +65,16 @@ func (u *SignInUseCaseImpl) SignIn(ctx context.Context, req SignInRequest) (*Sig
 		if err != nil {
 			return newError(BadRequest, InvalidPasswordError, "invalid password")
 		}
+		if user.DisabledAt != nil {
+			return newError(Forbidden, UserDisabledError, "user is disabled")
+		}
 
-		accessTokenPayload, err := u.maker.Generate(user.ID, u.config.AccessTokenDuration)
+		accessTokenPayload, err := u.maker.Generate(user.ID, user.Role.ToEntity(), u.config.AccessTokenDuration)
 		if err != nil {
 			return err
 		}
 
-		refreshTokenPayload, err := u.maker.Generate(user.ID, u.config.RefreshTokenDuration)
+		refreshTokenPayload, err := u.maker.Generate(user.ID, user.Role.ToEntity(), u.config.RefreshTokenDuration)
 		if err != nil {
 			return err
 		}
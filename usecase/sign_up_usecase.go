@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/lib/pq"
 	"golang.org/x/crypto/bcrypt"
+	"readly/entity"
 	"readly/env"
 	"readly/repository"
 	"readly/service/auth"
@@ -20,6 +21,7 @@ type SignUpUseCaseImpl struct {
 	transactor  repository.Transactor
 	sessionRepo repository.SessionRepository
 	userRepo    repository.UserRepository
+	outboxRepo  repository.OutboxRepository
 }
 
 func NewSignUpUseCase(
@@ -28,6 +30,7 @@ func NewSignUpUseCase(
 	transactor repository.Transactor,
 	sessionRepo repository.SessionRepository,
 	userRepo repository.UserRepository,
+	outboxRepo repository.OutboxRepository,
 ) SignUpUseCase {
 	return &SignUpUseCaseImpl{
 		config:      config,
@@ -35,6 +38,7 @@ func NewSignUpUseCase(
 		transactor:  transactor,
 		sessionRepo: sessionRepo,
 		userRepo:    userRepo,
+		outboxRepo:  outboxRepo,
 	}
 }
 
@@ -56,7 +60,7 @@ type SignUpResponse struct {
 
 func (u *SignUpUseCaseImpl) SignUp(ctx context.Context, req SignUpRequest) (*SignUpResponse, error) {
 	var res *SignUpResponse
-	err := u.transactor.Exec(ctx, func() error {
+	err := u.transactor.Exec(ctx, func(ctx context.Context) error {
 		hashedPassword, err := generateHashedPassword(req.Password)
 		if err != nil {
 			return err
@@ -77,12 +81,12 @@ func (u *SignUpUseCaseImpl) SignUp(ctx context.Context, req SignUpRequest) (*Sig
 			return err
 		}
 
-		accessTokenPayload, err := u.maker.Generate(user.ID, u.config.AccessTokenDuration)
+		accessTokenPayload, err := u.maker.Generate(user.ID, user.Role.ToEntity(), u.config.AccessTokenDuration)
 		if err != nil {
 			return err
 		}
 
-		refreshTokenPayload, err := u.maker.Generate(user.ID, u.config.RefreshTokenDuration)
+		refreshTokenPayload, err := u.maker.Generate(user.ID, user.Role.ToEntity(), u.config.RefreshTokenDuration)
 		if err != nil {
 			return err
 		}
@@ -99,6 +103,17 @@ func (u *SignUpUseCaseImpl) SignUp(ctx context.Context, req SignUpRequest) (*Sig
 		if err != nil {
 			return err
 		}
+		eventArgs := repository.CreateOutboxEventRequest{
+			Type: entity.UserSignedUp,
+			Payload: entity.UserSignedUpPayload{
+				UserID: user.ID,
+				Name:   user.Name,
+			},
+		}
+		err = u.outboxRepo.Create(ctx, eventArgs)
+		if err != nil {
+			return err
+		}
 
 		res = &SignUpResponse{
 			AccessToken:  accessTokenPayload.Token,
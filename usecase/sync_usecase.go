@@ -0,0 +1,573 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"readly/entity"
+	"readly/repository"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	maxSyncChanges = 500
+	maxTagLength   = 50
+	maxNoteLength  = 2000
+	// 同期中にコミットされたトランザクションの変更を取りこぼさないよう、前回のトークンより少し前から返す
+	syncTokenOverlap = time.Minute
+	// クライアントの時計のずれとして許容する範囲
+	maxSyncClockSkew = 5 * time.Minute
+)
+
+type SyncUseCase interface {
+	Sync(ctx context.Context, req SyncRequest) (*SyncResponse, error)
+}
+
+type SyncUseCaseImpl struct {
+	transactor         repository.Transactor
+	readingHistoryRepo repository.ReadingHistoryRepository
+	activityRepo       repository.ReadingActivityRepository
+	feedRepo           repository.FeedRepository
+	outboxRepo         repository.OutboxRepository
+	tagRepo            repository.BookTagRepository
+	noteRepo           repository.BookNoteRepository
+	tombstoneRepo      repository.SyncTombstoneRepository
+}
+
+func NewSyncUseCase(
+	transactor repository.Transactor,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+	activityRepo repository.ReadingActivityRepository,
+	feedRepo repository.FeedRepository,
+	outboxRepo repository.OutboxRepository,
+	tagRepo repository.BookTagRepository,
+	noteRepo repository.BookNoteRepository,
+	tombstoneRepo repository.SyncTombstoneRepository,
+) SyncUseCase {
+	return &SyncUseCaseImpl{
+		transactor:         transactor,
+		readingHistoryRepo: readingHistoryRepo,
+		activityRepo:       activityRepo,
+		feedRepo:           feedRepo,
+		outboxRepo:         outboxRepo,
+		tagRepo:            tagRepo,
+		noteRepo:           noteRepo,
+		tombstoneRepo:      tombstoneRepo,
+	}
+}
+
+type SyncRequest struct {
+	UserID int64
+	// 前回のレスポンスのSyncToken。空の場合は全件を返す
+	SyncToken string
+	Histories []SyncReadingHistoryChange
+	Tags      []SyncTagChange
+	Notes     []SyncNoteChange
+}
+
+// SyncReadingHistoryChange オフライン中に変更した読書履歴。UpdatedAtは端末で変更した日時
+type SyncReadingHistoryChange struct {
+	BookID    int64
+	Status    entity.ReadingStatus
+	StartDate *time.Time
+	EndDate   *time.Time
+	Priority  int16
+	Owned     bool
+	UpdatedAt time.Time
+}
+
+type SyncTagChange struct {
+	BookID    int64
+	Name      string
+	Deleted   bool
+	UpdatedAt time.Time
+}
+
+type SyncNoteChange struct {
+	ID        string
+	BookID    int64
+	Body      string
+	Deleted   bool
+	UpdatedAt time.Time
+}
+
+type SyncResponse struct {
+	SyncToken  string
+	Books      []entity.SyncedBook
+	Tags       []entity.BookTag
+	Notes      []entity.BookNote
+	Tombstones []entity.SyncTombstone
+	// 適用しなかった変更。サーバーの状態はBooks、Tags、Notes、Tombstonesに含まれる
+	Conflicts []entity.SyncConflict
+}
+
+// Sync クライアントの変更を適用してから、前回の同期以降にサーバーで変更されたものを返す。
+// 同じものが両方で変更されていた場合はUpdatedAtが新しい方を採用し、同じ時刻ならサーバーを優先する。
+func (u *SyncUseCaseImpl) Sync(ctx context.Context, req SyncRequest) (*SyncResponse, error) {
+	since := time.Time{}
+	if req.SyncToken != "" {
+		us, err := decodeCursor(req.SyncToken)
+		if err != nil {
+			return nil, handle(newError(BadRequest, InvalidCursorError, "invalid sync token"))
+		}
+		since = time.UnixMicro(us).Add(-syncTokenOverlap)
+	}
+	now := time.Now()
+	err := validateSyncRequest(req, now)
+	if err != nil {
+		return nil, handle(err)
+	}
+
+	var res *SyncResponse
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		s := newSyncSession(req.UserID)
+		for _, c := range req.Histories {
+			if err := u.applyHistory(ctx, s, c); err != nil {
+				return err
+			}
+		}
+		for _, c := range req.Tags {
+			if err := u.applyTag(ctx, s, c); err != nil {
+				return err
+			}
+		}
+		for _, c := range req.Notes {
+			if err := u.applyNote(ctx, s, c); err != nil {
+				return err
+			}
+		}
+		if err := u.collectChanges(ctx, s, since); err != nil {
+			return err
+		}
+		res = s.response(encodeCursor(now.UnixMicro()))
+		return nil
+	})
+	if err != nil {
+		return nil, handle(err)
+	}
+	return res, nil
+}
+
+func validateSyncRequest(req SyncRequest, now time.Time) error {
+	if len(req.Histories)+len(req.Tags)+len(req.Notes) > maxSyncChanges {
+		return newError(BadRequest, InvalidSyncError, "too many changes")
+	}
+	latest := now.Add(maxSyncClockSkew)
+	validateUpdatedAt := func(t time.Time) error {
+		if t.IsZero() || t.After(latest) {
+			return newError(BadRequest, InvalidSyncError, "updated_at must be set and must not be in the future")
+		}
+		return nil
+	}
+	for _, c := range req.Histories {
+		if err := validateUpdatedAt(c.UpdatedAt); err != nil {
+			return err
+		}
+		if c.Status == entity.Unknown {
+			return newError(BadRequest, InvalidSyncError, "status is invalid")
+		}
+		if c.Priority < minPriority || c.Priority > maxPriority {
+			return newError(BadRequest, InvalidPriorityError, "priority must be between 0 and 5")
+		}
+		if c.StartDate != nil && c.EndDate != nil && c.StartDate.After(*c.EndDate) {
+			return newError(BadRequest, InvalidDateRangeError, "start date must be before end date")
+		}
+	}
+	for _, c := range req.Tags {
+		if err := validateUpdatedAt(c.UpdatedAt); err != nil {
+			return err
+		}
+		if c.Name == "" || utf8.RuneCountInString(c.Name) > maxTagLength {
+			return newError(BadRequest, InvalidSyncError, "tag name must be between 1 and 50 characters")
+		}
+	}
+	for _, c := range req.Notes {
+		if err := validateUpdatedAt(c.UpdatedAt); err != nil {
+			return err
+		}
+		if _, err := uuid.Parse(c.ID); err != nil {
+			return newError(BadRequest, InvalidSyncError, "note id must be a UUID")
+		}
+		if !c.Deleted && (c.Body == "" || utf8.RuneCountInString(c.Body) > maxNoteLength) {
+			return newError(BadRequest, InvalidSyncError, "note body must be between 1 and 2000 characters")
+		}
+	}
+	return nil
+}
+
+func (u *SyncUseCaseImpl) applyHistory(ctx context.Context, s *syncSession, c SyncReadingHistoryChange) error {
+	key := entity.BookSyncKey(c.BookID)
+	cur, err := u.getBook(ctx, s, c.BookID)
+	if err != nil {
+		return err
+	}
+	if cur == nil || !c.UpdatedAt.After(cur.UpdatedAt) {
+		s.conflict(entity.SyncBook, key)
+		return nil
+	}
+
+	book := cur.Book
+	if c.Status != book.Status || !sameDate(c.StartDate, book.StartDate) || !sameDate(c.EndDate, book.EndDate) {
+		_, err := u.readingHistoryRepo.Update(ctx, repository.UpdateReadingHistoryRequest{
+			UserID:    s.userID,
+			BookID:    c.BookID,
+			Status:    repository.NewReadingStatus[entity.ReadingStatus](c.Status),
+			StartDate: c.StartDate,
+			EndDate:   c.EndDate,
+		})
+		if err != nil {
+			return err
+		}
+		if c.Status != book.Status {
+			if err := u.recordStatusChange(ctx, s.userID, c.BookID, book.Status, c.Status); err != nil {
+				return err
+			}
+		}
+	}
+	if c.Priority != book.Priority || c.Owned != book.Owned {
+		_, err := u.readingHistoryRepo.UpdateWishlistEntry(ctx, repository.UpdateWishlistEntryRequest{
+			UserID:   s.userID,
+			BookID:   c.BookID,
+			Priority: c.Priority,
+			Owned:    c.Owned,
+		})
+		if err != nil {
+			return err
+		}
+		eventArgs := repository.CreateOutboxEventRequest{
+			Type: entity.BookUpdated,
+			Payload: entity.BookUpdatedPayload{
+				UserID:   s.userID,
+				BookID:   c.BookID,
+				Priority: c.Priority,
+				Owned:    c.Owned,
+			},
+		}
+		if err := u.outboxRepo.Create(ctx, eventArgs); err != nil {
+			return err
+		}
+	}
+	// 変更後の状態は後でまとめて取得する
+	delete(s.books, key)
+	return nil
+}
+
+// recordStatusChange 他の経路で状態を変えた場合と同じく、ストリークやフィード、イベントに反映する
+func (u *SyncUseCaseImpl) recordStatusChange(ctx context.Context, userID int64, bookID int64, from entity.ReadingStatus, to entity.ReadingStatus) error {
+	err := u.activityRepo.Create(ctx, repository.CreateReadingActivityRequest{
+		UserID:     userID,
+		BookID:     bookID,
+		Type:       repository.StatusChanged,
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if to == entity.Reading || to == entity.Done {
+		err = u.feedRepo.CreateEvent(ctx, repository.CreateFeedEventRequest{
+			UserID: userID,
+			BookID: bookID,
+			Type:   newFeedEventType(to),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return u.outboxRepo.Create(ctx, repository.CreateOutboxEventRequest{
+		Type: entity.StatusChanged,
+		Payload: entity.StatusChangedPayload{
+			UserID: userID,
+			BookID: bookID,
+			From:   from.String(),
+			To:     to.String(),
+		},
+	})
+}
+
+func (u *SyncUseCaseImpl) applyTag(ctx context.Context, s *syncSession, c SyncTagChange) error {
+	key := entity.TagSyncKey(c.BookID, c.Name)
+	book, err := u.getBook(ctx, s, c.BookID)
+	if err != nil {
+		return err
+	}
+	if book == nil {
+		s.conflict(entity.SyncTag, key)
+		return nil
+	}
+
+	args := repository.BookTagRequest{UserID: s.userID, BookID: c.BookID, Name: c.Name}
+	tombstoneArgs := repository.SyncTombstoneRequest{UserID: s.userID, Type: repository.SyncTag, Key: key}
+	cur, err := u.tagRepo.Get(ctx, args)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if cur != nil {
+		if !c.UpdatedAt.After(cur.UpdatedAt) {
+			s.addTag(*cur)
+			s.conflict(entity.SyncTag, key)
+			return nil
+		}
+	} else {
+		tombstone, err := u.tombstoneRepo.Get(ctx, tombstoneArgs)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if tombstone != nil && !c.UpdatedAt.After(tombstone.DeletedAt) {
+			s.addTombstone(*tombstone)
+			s.conflict(entity.SyncTag, key)
+			return nil
+		}
+	}
+
+	if c.Deleted {
+		err = u.tagRepo.Delete(ctx, args)
+		if err != nil && !errors.Is(err, repository.ErrNoRowsDeleted) {
+			return err
+		}
+		return u.tombstoneRepo.Create(ctx, tombstoneArgs)
+	}
+	_, err = u.tagRepo.Upsert(ctx, args)
+	if err != nil {
+		return err
+	}
+	return u.tombstoneRepo.Delete(ctx, tombstoneArgs)
+}
+
+func (u *SyncUseCaseImpl) applyNote(ctx context.Context, s *syncSession, c SyncNoteChange) error {
+	id := uuid.MustParse(c.ID)
+	key := id.String()
+	book, err := u.getBook(ctx, s, c.BookID)
+	if err != nil {
+		return err
+	}
+	if book == nil {
+		s.conflict(entity.SyncNote, key)
+		return nil
+	}
+
+	tombstoneArgs := repository.SyncTombstoneRequest{UserID: s.userID, Type: repository.SyncNote, Key: key}
+	cur, err := u.noteRepo.Get(ctx, s.userID, id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if cur != nil {
+		if !c.UpdatedAt.After(cur.UpdatedAt) {
+			s.addNote(*cur)
+			s.conflict(entity.SyncNote, key)
+			return nil
+		}
+	} else {
+		// 削除したメモは同じIDで作り直せない
+		tombstone, err := u.tombstoneRepo.Get(ctx, tombstoneArgs)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if tombstone != nil {
+			s.addTombstone(*tombstone)
+			s.conflict(entity.SyncNote, key)
+			return nil
+		}
+	}
+
+	if c.Deleted {
+		err = u.noteRepo.Delete(ctx, s.userID, id)
+		if err != nil && !errors.Is(err, repository.ErrNoRowsDeleted) {
+			return err
+		}
+		return u.tombstoneRepo.Create(ctx, tombstoneArgs)
+	}
+	_, err = u.noteRepo.Upsert(ctx, repository.UpsertBookNoteRequest{
+		ID:     id,
+		UserID: s.userID,
+		BookID: c.BookID,
+		Body:   c.Body,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// 他のユーザーか別の本のメモとIDが重複している
+		s.conflict(entity.SyncNote, key)
+		return nil
+	}
+	return err
+}
+
+// getBook ライブラリにない本の場合はnilを返し、削除済みであれば墓標をレスポンスに加える
+func (u *SyncUseCaseImpl) getBook(ctx context.Context, s *syncSession, bookID int64) (*entity.SyncedBook, error) {
+	key := entity.BookSyncKey(bookID)
+	if b, ok := s.books[key]; ok {
+		return &b, nil
+	}
+	h, err := u.readingHistoryRepo.GetForSync(ctx, repository.GetReadingHistoryForSyncRequest{
+		UserID: s.userID,
+		BookID: bookID,
+	})
+	if err == nil {
+		s.addBook(*h)
+		b := s.books[key]
+		return &b, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	tombstone, err := u.tombstoneRepo.Get(ctx, repository.SyncTombstoneRequest{
+		UserID: s.userID,
+		Type:   repository.SyncBook,
+		Key:    key,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	s.addTombstone(*tombstone)
+	return nil, nil
+}
+
+func (u *SyncUseCaseImpl) collectChanges(ctx context.Context, s *syncSession, since time.Time) error {
+	histories, err := u.readingHistoryRepo.GetUpdatedSince(ctx, repository.GetReadingHistoriesUpdatedSinceRequest{
+		UserID: s.userID,
+		Since:  since,
+	})
+	if err != nil {
+		return err
+	}
+	for _, h := range histories {
+		s.addBook(h)
+	}
+	tags, err := u.tagRepo.GetUpdatedSince(ctx, s.userID, since)
+	if err != nil {
+		return err
+	}
+	for _, t := range tags {
+		s.addTag(t)
+	}
+	notes, err := u.noteRepo.GetUpdatedSince(ctx, s.userID, since)
+	if err != nil {
+		return err
+	}
+	for _, n := range notes {
+		s.addNote(n)
+	}
+	tombstones, err := u.tombstoneRepo.GetSince(ctx, s.userID, since)
+	if err != nil {
+		return err
+	}
+	for _, t := range tombstones {
+		s.addTombstone(t)
+	}
+	return nil
+}
+
+// syncSession レスポンスに含めるものをキーで重複なく集める
+type syncSession struct {
+	userID     int64
+	books      map[string]entity.SyncedBook
+	tags       map[string]entity.BookTag
+	notes      map[string]entity.BookNote
+	tombstones map[string]entity.SyncTombstone
+	conflicts  []entity.SyncConflict
+}
+
+func newSyncSession(userID int64) *syncSession {
+	return &syncSession{
+		userID:     userID,
+		books:      map[string]entity.SyncedBook{},
+		tags:       map[string]entity.BookTag{},
+		notes:      map[string]entity.BookNote{},
+		tombstones: map[string]entity.SyncTombstone{},
+	}
+}
+
+func (s *syncSession) addBook(h repository.SyncReadingHistoryResponse) {
+	s.books[entity.BookSyncKey(h.BookID)] = entity.SyncedBook{
+		Book: entity.Book{
+			ID:               h.BookID,
+			Title:            h.Title,
+			Genres:           h.Genres,
+			Description:      h.Description,
+			CoverImageURL:    h.CoverImageURL,
+			URL:              h.URL,
+			AuthorName:       h.AuthorName,
+			PublisherName:    h.PublisherName,
+			PublishDate:      h.PublishDate,
+			ISBN:             h.ISBN,
+			PageCount:        h.PageCount,
+			Status:           h.Status.ToEntity(),
+			StartDate:        h.StartDate,
+			EndDate:          h.EndDate,
+			Priority:         h.Priority,
+			QueuePosition:    h.QueuePosition,
+			Owned:            h.Owned,
+			Format:           h.Format.ToEntity(),
+			PurchasePrice:    h.PurchasePrice,
+			PurchaseCurrency: h.PurchaseCurrency,
+			PurchaseStore:    h.PurchaseStore,
+			PurchaseDate:     h.PurchaseDate,
+			DurationMinutes:  h.DurationMinutes,
+		},
+		UpdatedAt: h.UpdatedAt,
+	}
+}
+
+func (s *syncSession) addTag(t repository.BookTagResponse) {
+	s.tags[entity.TagSyncKey(t.BookID, t.Name)] = entity.BookTag{
+		BookID:    t.BookID,
+		Name:      t.Name,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func (s *syncSession) addNote(n repository.BookNoteResponse) {
+	s.notes[n.ID.String()] = entity.BookNote{
+		ID:        n.ID.String(),
+		BookID:    n.BookID,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+}
+
+func (s *syncSession) addTombstone(t repository.SyncTombstoneResponse) {
+	s.tombstones[string(t.Type)+":"+t.Key] = entity.SyncTombstone{
+		Type:      t.Type.ToEntity(),
+		Key:       t.Key,
+		DeletedAt: t.DeletedAt,
+	}
+}
+
+func (s *syncSession) conflict(t entity.SyncEntityType, key string) {
+	s.conflicts = append(s.conflicts, entity.SyncConflict{Type: t, Key: key})
+}
+
+func (s *syncSession) response(token string) *SyncResponse {
+	res := &SyncResponse{
+		SyncToken:  token,
+		Books:      make([]entity.SyncedBook, 0, len(s.books)),
+		Tags:       make([]entity.BookTag, 0, len(s.tags)),
+		Notes:      make([]entity.BookNote, 0, len(s.notes)),
+		Tombstones: make([]entity.SyncTombstone, 0, len(s.tombstones)),
+		Conflicts:  s.conflicts,
+	}
+	for _, b := range s.books {
+		res.Books = append(res.Books, b)
+	}
+	for _, t := range s.tags {
+		res.Tags = append(res.Tags, t)
+	}
+	for _, n := range s.notes {
+		res.Notes = append(res.Notes, n)
+	}
+	for _, t := range s.tombstones {
+		res.Tombstones = append(res.Tombstones, t)
+	}
+	return res
+}
+
+func sameDate(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Format(time.DateOnly) == b.Format(time.DateOnly)
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func TestSync(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	deleteBookUseCase := newTestDeleteBookUseCase(t)
+	syncUseCase := newTestSyncUseCase(t)
+
+	user := signUpTestUser(t)
+	register := func() *entity.Book {
+		book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+			UserID: user.UserID,
+			Title:  testdata.RandomString(10),
+			Status: entity.Unread,
+		})
+		require.NoError(t, err)
+		return book
+	}
+	book := register()
+	deletedBook := register()
+
+	first, err := syncUseCase.Sync(context.Background(), SyncRequest{UserID: user.UserID})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.SyncToken)
+	require.Len(t, first.Books, 2)
+	require.Empty(t, first.Tombstones)
+
+	noteID := uuid.NewString()
+	testCases := []struct {
+		name  string
+		setup func(t *testing.T) SyncRequest
+		check func(t *testing.T, res *SyncResponse, err error)
+	}{
+		{
+			name: "Sync uploads offline changes",
+			setup: func(t *testing.T) SyncRequest {
+				return SyncRequest{
+					UserID:    user.UserID,
+					SyncToken: first.SyncToken,
+					Histories: []SyncReadingHistoryChange{{
+						BookID:    book.ID,
+						Status:    entity.Reading,
+						Priority:  2,
+						Owned:     true,
+						UpdatedAt: time.Now(),
+					}},
+					Tags:  []SyncTagChange{{BookID: book.ID, Name: "SF", UpdatedAt: time.Now()}},
+					Notes: []SyncNoteChange{{ID: noteID, BookID: book.ID, Body: "面白い", UpdatedAt: time.Now()}},
+				}
+			},
+			check: func(t *testing.T, res *SyncResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Conflicts)
+				var synced *entity.SyncedBook
+				for i := range res.Books {
+					if res.Books[i].Book.ID == book.ID {
+						synced = &res.Books[i]
+					}
+				}
+				require.NotNil(t, synced)
+				require.Equal(t, entity.Reading, synced.Book.Status)
+				require.Equal(t, int16(2), synced.Book.Priority)
+				require.Len(t, res.Tags, 1)
+				require.Equal(t, "SF", res.Tags[0].Name)
+				require.Len(t, res.Notes, 1)
+				require.Equal(t, noteID, res.Notes[0].ID)
+			},
+		},
+		{
+			name: "Sync keeps server version when it is newer",
+			setup: func(t *testing.T) SyncRequest {
+				return SyncRequest{
+					UserID:    user.UserID,
+					SyncToken: first.SyncToken,
+					Histories: []SyncReadingHistoryChange{{
+						BookID:    book.ID,
+						Status:    entity.Done,
+						UpdatedAt: time.Now().Add(-time.Hour),
+					}},
+					Notes: []SyncNoteChange{{ID: noteID, BookID: book.ID, Body: "古い", UpdatedAt: time.Now().Add(-time.Hour)}},
+				}
+			},
+			check: func(t *testing.T, res *SyncResponse, err error) {
+				require.NoError(t, err)
+				require.ElementsMatch(t, []entity.SyncConflict{
+					{Type: entity.SyncBook, Key: entity.BookSyncKey(book.ID)},
+					{Type: entity.SyncNote, Key: noteID},
+				}, res.Conflicts)
+				require.Len(t, res.Notes, 1)
+				require.Equal(t, "面白い", res.Notes[0].Body)
+				for _, b := range res.Books {
+					if b.Book.ID == book.ID {
+						require.Equal(t, entity.Reading, b.Book.Status)
+					}
+				}
+			},
+		},
+		{
+			name: "Sync returns tombstones of deleted books and tags",
+			setup: func(t *testing.T) SyncRequest {
+				err := deleteBookUseCase.DeleteBook(context.Background(), DeleteBookRequest{UserID: user.UserID, BookID: deletedBook.ID})
+				require.NoError(t, err)
+				return SyncRequest{
+					UserID:    user.UserID,
+					SyncToken: first.SyncToken,
+					Tags:      []SyncTagChange{{BookID: book.ID, Name: "SF", Deleted: true, UpdatedAt: time.Now()}},
+				}
+			},
+			check: func(t *testing.T, res *SyncResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.Conflicts)
+				require.Empty(t, res.Tags)
+				require.ElementsMatch(t, []string{entity.BookSyncKey(deletedBook.ID), entity.TagSyncKey(book.ID, "SF")}, tombstoneKeys(res.Tombstones))
+				for _, b := range res.Books {
+					require.NotEqual(t, deletedBook.ID, b.Book.ID)
+				}
+			},
+		},
+		{
+			name: "Sync rejects changes to deleted books",
+			setup: func(t *testing.T) SyncRequest {
+				return SyncRequest{
+					UserID: user.UserID,
+					Tags:   []SyncTagChange{{BookID: deletedBook.ID, Name: "SF", UpdatedAt: time.Now()}},
+				}
+			},
+			check: func(t *testing.T, res *SyncResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []entity.SyncConflict{{Type: entity.SyncTag, Key: entity.TagSyncKey(deletedBook.ID, "SF")}}, res.Conflicts)
+			},
+		},
+		{
+			name: "Sync failure when token is invalid",
+			setup: func(t *testing.T) SyncRequest {
+				return SyncRequest{UserID: user.UserID, SyncToken: "invalid"}
+			},
+			check: func(t *testing.T, res *SyncResponse, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidCursorError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Sync failure when updated_at is in the future",
+			setup: func(t *testing.T) SyncRequest {
+				return SyncRequest{
+					UserID: user.UserID,
+					Tags:   []SyncTagChange{{BookID: book.ID, Name: "SF", UpdatedAt: time.Now().Add(time.Hour)}},
+				}
+			},
+			check: func(t *testing.T, res *SyncResponse, err error) {
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidSyncError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := tc.setup(t)
+			res, err := syncUseCase.Sync(context.Background(), req)
+			tc.check(t, res, err)
+		})
+	}
+}
+
+func tombstoneKeys(tombstones []entity.SyncTombstone) []string {
+	keys := make([]string, len(tombstones))
+	for i, t := range tombstones {
+		keys[i] = t.Key
+	}
+	return keys
+}
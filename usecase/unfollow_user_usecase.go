@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"readly/repository"
+)
+
+type UnfollowUserUseCase interface {
+	UnfollowUser(ctx context.Context, req UnfollowUserRequest) error
+}
+
+type UnfollowUserUseCaseImpl struct {
+	followRepo repository.FollowRepository
+}
+
+func NewUnfollowUserUseCase(
+	followRepo repository.FollowRepository,
+) UnfollowUserUseCase {
+	return &UnfollowUserUseCaseImpl{
+		followRepo: followRepo,
+	}
+}
+
+type UnfollowUserRequest struct {
+	FollowerID int64
+	FolloweeID int64
+}
+
+func (u *UnfollowUserUseCaseImpl) UnfollowUser(ctx context.Context, req UnfollowUserRequest) error {
+	err := u.followRepo.Delete(ctx, repository.FollowRequest{
+		FollowerID: req.FollowerID,
+		FolloweeID: req.FolloweeID,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRowsDeleted) {
+			return newError(NotFound, NotFollowingError, "not following")
+		}
+		return handle(err)
+	}
+	return nil
+}
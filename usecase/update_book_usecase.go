@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+	"time"
+)
+
+type UpdateBookUseCase interface {
+	UpdateBook(ctx context.Context, req UpdateBookRequest) (*entity.Book, error)
+}
+
+type UpdateBookUseCaseImpl struct {
+	transactor  repository.Transactor
+	bookRepo    repository.BookRepository
+	catalogRepo repository.CatalogRepository
+}
+
+func NewUpdateBookUseCase(
+	transactor repository.Transactor,
+	bookRepo repository.BookRepository,
+	catalogRepo repository.CatalogRepository,
+) UpdateBookUseCase {
+	return &UpdateBookUseCaseImpl{
+		transactor:  transactor,
+		bookRepo:    bookRepo,
+		catalogRepo: catalogRepo,
+	}
+}
+
+// UpdateBookRequest 共有している書籍の情報を更新する。読書記録は更新しない
+type UpdateBookRequest struct {
+	UserID        int64
+	Role          entity.UserRole
+	BookID        int64
+	Title         string
+	Description   *string
+	CoverImageURL *string
+	URL           *string
+	AuthorName    *string
+	PublisherName *string
+	PublishDate   *time.Time
+	ISBN          *string
+	PageCount     *int32
+	// nilでない場合は現在のバージョンと一致するときだけ更新する
+	ExpectedVersion *int64
+}
+
+func (u *UpdateBookUseCaseImpl) UpdateBook(ctx context.Context, req UpdateBookRequest) (res *entity.Book, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	// 他のユーザーの本棚にも表示されるため、カタログを編集できるロールに限る
+	err = authorize(req.Role, EditCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		author := req.AuthorName
+		if author != nil && len(*author) > 0 {
+			name, err := resolveCatalogName(ctx, u.catalogRepo, entity.CatalogAuthor, *author)
+			if err != nil {
+				return err
+			}
+			_, err = u.bookRepo.CreateAuthor(ctx, name)
+			if err != nil {
+				return err
+			}
+			author = &name
+		}
+		publisher := req.PublisherName
+		if publisher != nil && len(*publisher) > 0 {
+			name, err := resolveCatalogName(ctx, u.catalogRepo, entity.CatalogPublisher, *publisher)
+			if err != nil {
+				return err
+			}
+			_, err = u.bookRepo.CreatePublisher(ctx, name)
+			if err != nil {
+				return err
+			}
+			publisher = &name
+		}
+		args := repository.UpdateBookRequest{
+			ID:              req.BookID,
+			Title:           req.Title,
+			Description:     req.Description,
+			CoverImageURL:   req.CoverImageURL,
+			URL:             req.URL,
+			Author:          author,
+			Publisher:       publisher,
+			PublishDate:     req.PublishDate,
+			ISBN:            req.ISBN,
+			PageCount:       req.PageCount,
+			ExpectedVersion: req.ExpectedVersion,
+		}
+		b, err := u.bookRepo.UpdateBook(ctx, args)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return u.notUpdatedError(ctx, req)
+			}
+			return err
+		}
+		genres, err := u.bookRepo.GetGenresByBookID(ctx, b.ID)
+		if err != nil {
+			return err
+		}
+		res = &entity.Book{
+			ID:             b.ID,
+			Title:          b.Title,
+			Genres:         genres,
+			Description:    b.Description,
+			CoverImageURL:  b.CoverImageURL,
+			URL:            b.URL,
+			AuthorName:     b.Author,
+			PublisherName:  b.Publisher,
+			PublishDate:    b.PublishDate,
+			ISBN:           b.ISBN,
+			PageCount:      b.PageCount,
+			CatalogVersion: b.Version,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// notUpdatedError 更新できなかったのが書籍が存在しないためか、バージョンが一致しないためかを区別する
+func (u *UpdateBookUseCaseImpl) notUpdatedError(ctx context.Context, req UpdateBookRequest) error {
+	notFound := newError(NotFound, NotFoundBookError, "book not found")
+	if req.ExpectedVersion == nil {
+		return notFound
+	}
+	_, err := u.bookRepo.GetBookByID(ctx, req.BookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		return err
+	}
+	return newError(PreconditionFailed, VersionMismatchError, "book was modified by another request")
+}
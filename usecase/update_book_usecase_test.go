@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+)
+
+func TestUpdateBook(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	updateBookUseCase := newTestUpdateBookUseCase(t)
+
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	})
+	require.NoError(t, err)
+	genre := testdata.RandomString(6)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: signUpRes.UserID,
+		Title:  testdata.RandomString(10),
+		Genres: []string{genre},
+		Status: entity.Unread,
+	})
+	require.NoError(t, err)
+	author := testdata.RandomString(10)
+	// 1件目のケースで1つ進むため、2件目のケースではその次のバージョンを指定する
+	currentVersion := book.CatalogVersion + 1
+	staleVersion := book.CatalogVersion
+
+	testCases := []struct {
+		name  string
+		req   UpdateBookRequest
+		check func(t *testing.T, res *entity.Book, err error)
+	}{
+		{
+			name: "Update book by librarian success",
+			req: UpdateBookRequest{
+				UserID:     signUpRes.UserID,
+				Role:       entity.RoleLibrarian,
+				BookID:     book.ID,
+				Title:      "ノルウェイの森",
+				AuthorName: &author,
+			},
+			check: func(t *testing.T, res *entity.Book, err error) {
+				require.NoError(t, err)
+				require.Equal(t, book.ID, res.ID)
+				require.Equal(t, "ノルウェイの森", res.Title)
+				require.Equal(t, author, *res.AuthorName)
+				require.Equal(t, []string{genre}, res.Genres)
+				require.Equal(t, book.CatalogVersion+1, res.CatalogVersion)
+			},
+		},
+		{
+			name: "Update book with current version success",
+			req: UpdateBookRequest{
+				UserID:          signUpRes.UserID,
+				Role:            entity.RoleLibrarian,
+				BookID:          book.ID,
+				Title:           "ノルウェイの森",
+				ExpectedVersion: &currentVersion,
+			},
+			check: func(t *testing.T, res *entity.Book, err error) {
+				require.NoError(t, err)
+				require.Equal(t, currentVersion+1, res.CatalogVersion)
+			},
+		},
+		{
+			name: "Update book with stale version failed",
+			req: UpdateBookRequest{
+				UserID:          signUpRes.UserID,
+				Role:            entity.RoleLibrarian,
+				BookID:          book.ID,
+				Title:           testdata.RandomString(10),
+				ExpectedVersion: &staleVersion,
+			},
+			check: func(t *testing.T, res *entity.Book, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, PreconditionFailed, e.StatusCode)
+				require.Equal(t, VersionMismatchError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Update book by user is forbidden",
+			req: UpdateBookRequest{
+				UserID: signUpRes.UserID,
+				Role:   entity.RoleUser,
+				BookID: book.ID,
+				Title:  testdata.RandomString(10),
+			},
+			check: func(t *testing.T, res *entity.Book, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, Forbidden, e.StatusCode)
+				require.Equal(t, PermissionDeniedError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Update not exist book failed",
+			req: UpdateBookRequest{
+				UserID: signUpRes.UserID,
+				Role:   entity.RoleAdmin,
+				BookID: 0,
+				Title:  testdata.RandomString(10),
+			},
+			check: func(t *testing.T, res *entity.Book, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, NotFound, e.StatusCode)
+				require.Equal(t, NotFoundBookError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := updateBookUseCase.UpdateBook(context.Background(), tc.req)
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+type UpdateClubMemberRoleUseCase interface {
+	UpdateClubMemberRole(ctx context.Context, req UpdateClubMemberRoleRequest) error
+}
+
+type UpdateClubMemberRoleUseCaseImpl struct {
+	transactor repository.Transactor
+	clubRepo   repository.BookClubRepository
+	memberRepo repository.ClubMemberRepository
+}
+
+func NewUpdateClubMemberRoleUseCase(
+	transactor repository.Transactor,
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+) UpdateClubMemberRoleUseCase {
+	return &UpdateClubMemberRoleUseCaseImpl{
+		transactor: transactor,
+		clubRepo:   clubRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+type UpdateClubMemberRoleRequest struct {
+	ClubID int64
+	// 変更を行うオーナー
+	UserID       int64
+	TargetUserID int64
+	Role         entity.ClubRole
+}
+
+// UpdateClubMemberRole オーナーのみ変更できる。オーナーを指定した場合は譲渡し、元のオーナーはモデレーターになる
+func (u *UpdateClubMemberRoleUseCaseImpl) UpdateClubMemberRole(ctx context.Context, req UpdateClubMemberRoleRequest) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	if !isValidClubRole(req.Role) {
+		return newError(BadRequest, InvalidClubRoleError, "invalid club role")
+	}
+	if req.UserID == req.TargetUserID {
+		return newError(BadRequest, InvalidClubRoleError, "cannot change your own role")
+	}
+	_, _, err = authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.UserID, repository.ClubOwner)
+	if err != nil {
+		return err
+	}
+	_, err = u.memberRepo.Get(ctx, repository.GetClubMemberRequest{
+		ClubID: req.ClubID,
+		UserID: req.TargetUserID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return newError(NotFound, NotClubMemberError, "user is not a member of the club")
+		}
+		return err
+	}
+
+	return u.transactor.Exec(ctx, func(ctx context.Context) error {
+		// オーナーは1人のみのため、先に元のオーナーを変更する
+		if req.Role == entity.ClubOwner {
+			_, err := u.memberRepo.UpdateRole(ctx, repository.UpdateClubRoleRequest{
+				ClubID: req.ClubID,
+				UserID: req.UserID,
+				Role:   repository.ClubModerator,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		_, err := u.memberRepo.UpdateRole(ctx, repository.UpdateClubRoleRequest{
+			ClubID: req.ClubID,
+			UserID: req.TargetUserID,
+			Role:   repository.NewClubRole[entity.ClubRole](req.Role),
+		})
+		return err
+	})
+}
+
+func isValidClubRole(r entity.ClubRole) bool {
+	switch r {
+	case entity.ClubOwner, entity.ClubModerator, entity.ClubMember:
+		return true
+	default:
+		return false
+	}
+}
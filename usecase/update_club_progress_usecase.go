@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"readly/repository"
+	"time"
+)
+
+type UpdateClubProgressUseCase interface {
+	UpdateClubProgress(ctx context.Context, req UpdateClubProgressRequest) error
+}
+
+type UpdateClubProgressUseCaseImpl struct {
+	transactor          repository.Transactor
+	clubRepo            repository.BookClubRepository
+	memberRepo          repository.ClubMemberRepository
+	bookRepo            repository.BookRepository
+	readingActivityRepo repository.ReadingActivityRepository
+}
+
+func NewUpdateClubProgressUseCase(
+	transactor repository.Transactor,
+	clubRepo repository.BookClubRepository,
+	memberRepo repository.ClubMemberRepository,
+	bookRepo repository.BookRepository,
+	readingActivityRepo repository.ReadingActivityRepository,
+) UpdateClubProgressUseCase {
+	return &UpdateClubProgressUseCaseImpl{
+		transactor:          transactor,
+		clubRepo:            clubRepo,
+		memberRepo:          memberRepo,
+		bookRepo:            bookRepo,
+		readingActivityRepo: readingActivityRepo,
+	}
+}
+
+type UpdateClubProgressRequest struct {
+	ClubID      int64
+	UserID      int64
+	CurrentPage int32
+}
+
+// UpdateClubProgress クラブで読んでいる本の進捗を更新する。読書記録にも進捗の更新として残す
+func (u *UpdateClubProgressUseCaseImpl) UpdateClubProgress(ctx context.Context, req UpdateClubProgressRequest) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	club, _, err := authorizeClubMember(ctx, u.clubRepo, u.memberRepo, req.ClubID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if club.CurrentBookID == nil {
+		return newError(BadRequest, NoCurrentBookError, "club has no current book")
+	}
+	if req.CurrentPage < 0 {
+		return newError(BadRequest, InvalidProgressError, "current page must not be negative")
+	}
+	book, err := u.bookRepo.GetBookByID(ctx, *club.CurrentBookID)
+	if err != nil {
+		return err
+	}
+	if book.PageCount != nil && req.CurrentPage > *book.PageCount {
+		return newError(BadRequest, InvalidProgressError, "current page exceeds page count of the book")
+	}
+
+	return u.transactor.Exec(ctx, func(ctx context.Context) error {
+		_, err := u.memberRepo.UpdateProgress(ctx, repository.UpdateClubProgressRequest{
+			ClubID:      req.ClubID,
+			UserID:      req.UserID,
+			CurrentPage: req.CurrentPage,
+		})
+		if err != nil {
+			return err
+		}
+		return u.readingActivityRepo.Create(ctx, repository.CreateReadingActivityRequest{
+			UserID:     req.UserID,
+			BookID:     book.ID,
+			Type:       repository.ProgressUpdated,
+			OccurredAt: time.Now(),
+		})
+	})
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"readly/entity"
+	"readly/repository"
+)
+
+type UpdatePrivacySettingsUseCase interface {
+	UpdatePrivacySettings(ctx context.Context, req UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsResponse, error)
+}
+
+type UpdatePrivacySettingsUseCaseImpl struct {
+	transactor repository.Transactor
+	userRepo   repository.UserRepository
+	shelfRepo  repository.ShelfVisibilityRepository
+}
+
+func NewUpdatePrivacySettingsUseCase(
+	transactor repository.Transactor,
+	userRepo repository.UserRepository,
+	shelfRepo repository.ShelfVisibilityRepository,
+) UpdatePrivacySettingsUseCase {
+	return &UpdatePrivacySettingsUseCaseImpl{
+		transactor: transactor,
+		userRepo:   userRepo,
+		shelfRepo:  shelfRepo,
+	}
+}
+
+type UpdatePrivacySettingsRequest struct {
+	UserID int64
+	// nilの場合は変更しない
+	ActivityVisibility *entity.Visibility
+	ProfileVisibility  *entity.Visibility
+	// 本棚ごとの設定がない場合のデフォルトの公開範囲
+	LibraryVisibility *entity.Visibility
+	// 指定した本棚のみ変更する
+	Shelves []entity.ShelfVisibility
+}
+
+type UpdatePrivacySettingsResponse struct {
+	ActivityVisibility entity.Visibility
+	ProfileVisibility  entity.Visibility
+	LibraryVisibility  entity.Visibility
+	Shelves            []entity.ShelfVisibility
+}
+
+func (u *UpdatePrivacySettingsUseCaseImpl) UpdatePrivacySettings(ctx context.Context, req UpdatePrivacySettingsRequest) (res *UpdatePrivacySettingsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	user, err := u.userRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, newError(BadRequest, NotFoundUserError, "user not found")
+	}
+	args := repository.UpdatePrivacyRequest{
+		ID:                 req.UserID,
+		ActivityVisibility: user.ActivityVisibility,
+		ProfileVisibility:  user.ProfileVisibility,
+		LibraryVisibility:  user.LibraryVisibility,
+	}
+	if req.ActivityVisibility != nil {
+		if !isValidVisibility(*req.ActivityVisibility) {
+			return nil, newError(BadRequest, InvalidVisibilityError, "invalid activity visibility")
+		}
+		args.ActivityVisibility = repository.NewVisibility[entity.Visibility](*req.ActivityVisibility)
+	}
+	if req.ProfileVisibility != nil {
+		if !isValidVisibility(*req.ProfileVisibility) {
+			return nil, newError(BadRequest, InvalidVisibilityError, "invalid profile visibility")
+		}
+		args.ProfileVisibility = repository.NewVisibility[entity.Visibility](*req.ProfileVisibility)
+	}
+	if req.LibraryVisibility != nil {
+		if !isValidVisibility(*req.LibraryVisibility) {
+			return nil, newError(BadRequest, InvalidVisibilityError, "invalid library visibility")
+		}
+		args.LibraryVisibility = repository.NewVisibility[entity.Visibility](*req.LibraryVisibility)
+	}
+	for _, s := range req.Shelves {
+		if !isValidShelf(s.Status) {
+			return nil, newError(BadRequest, InvalidVisibilityError, "invalid shelf status")
+		}
+		if !isValidVisibility(s.Visibility) {
+			return nil, newError(BadRequest, InvalidVisibilityError, "invalid shelf visibility")
+		}
+	}
+
+	var shelves []repository.ShelfVisibilityResponse
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		user, err = u.userRepo.UpdatePrivacy(ctx, args)
+		if err != nil {
+			return err
+		}
+		for _, s := range req.Shelves {
+			_, err = u.shelfRepo.Upsert(ctx, repository.UpsertShelfVisibilityRequest{
+				UserID:     req.UserID,
+				Status:     repository.NewReadingStatus[entity.ReadingStatus](s.Status),
+				Visibility: repository.NewVisibility[entity.Visibility](s.Visibility),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		shelves, err = u.shelfRepo.GetByUser(ctx, req.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &UpdatePrivacySettingsResponse{
+		ActivityVisibility: user.ActivityVisibility.ToEntity(),
+		ProfileVisibility:  user.ProfileVisibility.ToEntity(),
+		LibraryVisibility:  user.LibraryVisibility.ToEntity(),
+		Shelves:            make([]entity.ShelfVisibility, len(shelves)),
+	}
+	for i, s := range shelves {
+		res.Shelves[i] = entity.ShelfVisibility{
+			Status:     s.Status.ToEntity(),
+			Visibility: s.Visibility.ToEntity(),
+		}
+	}
+	return res, nil
+}
+
+func isValidVisibility(v entity.Visibility) bool {
+	switch v {
+	case entity.Private, entity.FollowersOnly, entity.Public:
+		return true
+	default:
+		return false
+	}
+}
+
+// isValidShelf 本棚として扱える読書ステータスかどうか
+func isValidShelf(s entity.ReadingStatus) bool {
+	switch s {
+	case entity.Unread, entity.Reading, entity.Done, entity.Abandoned, entity.OnHold:
+		return true
+	default:
+		return false
+	}
+}
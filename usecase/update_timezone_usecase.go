@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"readly/repository"
+	"time"
+)
+
+type UpdateTimezoneUseCase interface {
+	UpdateTimezone(ctx context.Context, req UpdateTimezoneRequest) (*UpdateTimezoneResponse, error)
+}
+
+type UpdateTimezoneUseCaseImpl struct {
+	userRepo repository.UserRepository
+}
+
+func NewUpdateTimezoneUseCase(
+	userRepo repository.UserRepository,
+) UpdateTimezoneUseCase {
+	return &UpdateTimezoneUseCaseImpl{
+		userRepo: userRepo,
+	}
+}
+
+type UpdateTimezoneRequest struct {
+	UserID   int64
+	Timezone string
+}
+
+type UpdateTimezoneResponse struct {
+	Timezone string
+}
+
+func (u *UpdateTimezoneUseCaseImpl) UpdateTimezone(ctx context.Context, req UpdateTimezoneRequest) (res *UpdateTimezoneResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	// IANAのタイムゾーン名のみ受け付ける(空文字はUTCとして解釈されるため除外)
+	if req.Timezone == "" {
+		return nil, newError(BadRequest, InvalidTimezoneError, "timezone is required")
+	}
+	// Localはサーバーのタイムゾーンを指し、PostgreSQLでは解釈できないため除外
+	if req.Timezone == "Local" {
+		return nil, newError(BadRequest, InvalidTimezoneError, "invalid timezone")
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return nil, newError(BadRequest, InvalidTimezoneError, "invalid timezone")
+	}
+
+	user, err := u.userRepo.UpdateTimezone(ctx, repository.UpdateTimezoneRequest{
+		ID:       req.UserID,
+		Timezone: req.Timezone,
+	})
+	if err != nil {
+		return nil, newError(BadRequest, NotFoundUserError, "user not found")
+	}
+	return &UpdateTimezoneResponse{
+		Timezone: user.Timezone,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/testdata"
+	"testing"
+)
+
+func TestUpdateTimezone(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	timezoneUseCase := newTestUpdateTimezoneUseCase(t)
+
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		req   UpdateTimezoneRequest
+		check func(t *testing.T, res *UpdateTimezoneResponse, err error)
+	}{
+		{
+			name: "Update timezone success",
+			req: UpdateTimezoneRequest{
+				UserID:   signUpRes.UserID,
+				Timezone: "Asia/Tokyo",
+			},
+			check: func(t *testing.T, res *UpdateTimezoneResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Asia/Tokyo", res.Timezone)
+			},
+		},
+		{
+			name: "Update timezone failure if timezone is invalid",
+			req: UpdateTimezoneRequest{
+				UserID:   signUpRes.UserID,
+				Timezone: "Invalid/Zone",
+			},
+			check: func(t *testing.T, res *UpdateTimezoneResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidTimezoneError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Update timezone failure if timezone is Local",
+			req: UpdateTimezoneRequest{
+				UserID:   signUpRes.UserID,
+				Timezone: "Local",
+			},
+			check: func(t *testing.T, res *UpdateTimezoneResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, BadRequest, e.StatusCode)
+				require.Equal(t, InvalidTimezoneError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Update timezone failure if timezone is empty",
+			req: UpdateTimezoneRequest{
+				UserID: signUpRes.UserID,
+			},
+			check: func(t *testing.T, res *UpdateTimezoneResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, InvalidTimezoneError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := timezoneUseCase.UpdateTimezone(context.Background(), tc.req)
+			tc.check(t, res, err)
+		})
+	}
+}
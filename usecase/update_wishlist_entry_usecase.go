@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"readly/entity"
+	"readly/repository"
+)
+
+const (
+	minPriority int16 = 0
+	maxPriority int16 = 5
+)
+
+type UpdateWishlistEntryUseCase interface {
+	UpdateWishlistEntry(ctx context.Context, req UpdateWishlistEntryRequest) (*UpdateWishlistEntryResponse, error)
+}
+
+type UpdateWishlistEntryUseCaseImpl struct {
+	transactor         repository.Transactor
+	readingHistoryRepo repository.ReadingHistoryRepository
+	outboxRepo         repository.OutboxRepository
+}
+
+func NewUpdateWishlistEntryUseCase(
+	transactor repository.Transactor,
+	readingHistoryRepo repository.ReadingHistoryRepository,
+	outboxRepo repository.OutboxRepository,
+) UpdateWishlistEntryUseCase {
+	return &UpdateWishlistEntryUseCaseImpl{
+		transactor:         transactor,
+		readingHistoryRepo: readingHistoryRepo,
+		outboxRepo:         outboxRepo,
+	}
+}
+
+type UpdateWishlistEntryRequest struct {
+	UserID   int64
+	BookID   int64
+	Priority int16
+	Owned    bool
+	// nilでない場合は現在のバージョンと一致するときだけ更新する
+	ExpectedVersion *int64
+}
+
+type UpdateWishlistEntryResponse struct {
+	BookID        int64
+	Priority      int16
+	QueuePosition *int32
+	Owned         bool
+	Version       int64
+}
+
+func (u *UpdateWishlistEntryUseCaseImpl) UpdateWishlistEntry(ctx context.Context, req UpdateWishlistEntryRequest) (res *UpdateWishlistEntryResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	if req.Priority < minPriority || req.Priority > maxPriority {
+		return nil, newError(BadRequest, InvalidPriorityError, "priority must be between 0 and 5")
+	}
+
+	err = u.transactor.Exec(ctx, func(ctx context.Context) error {
+		args := repository.UpdateWishlistEntryRequest{
+			UserID:          req.UserID,
+			BookID:          req.BookID,
+			Priority:        req.Priority,
+			Owned:           req.Owned,
+			ExpectedVersion: req.ExpectedVersion,
+		}
+		rh, err := u.readingHistoryRepo.UpdateWishlistEntry(ctx, args)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return u.notUpdatedError(ctx, req)
+			}
+			return err
+		}
+		eventArgs := repository.CreateOutboxEventRequest{
+			Type: entity.BookUpdated,
+			Payload: entity.BookUpdatedPayload{
+				UserID:   req.UserID,
+				BookID:   rh.BookID,
+				Priority: rh.Priority,
+				Owned:    rh.Owned,
+			},
+		}
+		err = u.outboxRepo.Create(ctx, eventArgs)
+		if err != nil {
+			return err
+		}
+		res = &UpdateWishlistEntryResponse{
+			BookID:        rh.BookID,
+			Priority:      rh.Priority,
+			QueuePosition: rh.QueuePosition,
+			Owned:         rh.Owned,
+			Version:       rh.Version,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// notUpdatedError 更新できなかったのが本棚にないためか、バージョンが一致しないためかを区別する
+func (u *UpdateWishlistEntryUseCaseImpl) notUpdatedError(ctx context.Context, req UpdateWishlistEntryRequest) error {
+	notFound := newError(NotFound, NotFoundBookError, "reading history not found")
+	if req.ExpectedVersion == nil {
+		return notFound
+	}
+	_, err := u.readingHistoryRepo.GetByUserAndBook(ctx, repository.GetReadingHistoryByUserAndBookRequest{
+		UserID: req.UserID,
+		BookID: req.BookID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		return err
+	}
+	return newError(PreconditionFailed, VersionMismatchError, "book was modified by another request")
+}
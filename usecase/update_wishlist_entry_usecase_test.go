@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/testdata"
+	"testing"
+)
+
+func TestUpdateWishlistEntry(t *testing.T) {
+	signUpUseCase := newTestSignUpUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	wishlistUseCase := newTestUpdateWishlistEntryUseCase(t)
+
+	signUpRes, err := signUpUseCase.SignUp(context.Background(), SignUpRequest{
+		Name:     testdata.RandomString(10),
+		Email:    testdata.RandomEmail(),
+		Password: testdata.RandomString(16),
+	})
+	require.NoError(t, err)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: signUpRes.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Unread,
+	})
+	require.NoError(t, err)
+	staleVersion := book.Version
+	// 最初のテストケースで1回更新された後のバージョン
+	currentVersion := book.Version + 1
+
+	testCases := []struct {
+		name  string
+		req   UpdateWishlistEntryRequest
+		check func(t *testing.T, res *UpdateWishlistEntryResponse, err error)
+	}{
+		{
+			name: "Update wishlist entry success",
+			req: UpdateWishlistEntryRequest{
+				UserID:   signUpRes.UserID,
+				BookID:   book.ID,
+				Priority: 5,
+				Owned:    false,
+			},
+			check: func(t *testing.T, res *UpdateWishlistEntryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, book.ID, res.BookID)
+				require.Equal(t, int16(5), res.Priority)
+				require.False(t, res.Owned)
+				require.Equal(t, book.QueuePosition, res.QueuePosition)
+				require.Equal(t, book.Version+1, res.Version)
+			},
+		},
+		{
+			name: "Update wishlist entry success if version matches",
+			req: UpdateWishlistEntryRequest{
+				UserID:          signUpRes.UserID,
+				BookID:          book.ID,
+				Priority:        4,
+				Owned:           true,
+				ExpectedVersion: &currentVersion,
+			},
+			check: func(t *testing.T, res *UpdateWishlistEntryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int16(4), res.Priority)
+				require.Equal(t, currentVersion+1, res.Version)
+			},
+		},
+		{
+			name: "Update wishlist entry failure if version does not match",
+			req: UpdateWishlistEntryRequest{
+				UserID:          signUpRes.UserID,
+				BookID:          book.ID,
+				Priority:        1,
+				ExpectedVersion: &staleVersion,
+			},
+			check: func(t *testing.T, res *UpdateWishlistEntryResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, PreconditionFailed, e.StatusCode)
+				require.Equal(t, VersionMismatchError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Update wishlist entry failure if priority is out of range",
+			req: UpdateWishlistEntryRequest{
+				UserID:   signUpRes.UserID,
+				BookID:   book.ID,
+				Priority: 6,
+			},
+			check: func(t *testing.T, res *UpdateWishlistEntryResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, InvalidPriorityError, e.ErrorCode)
+			},
+		},
+		{
+			name: "Update wishlist entry failure if book is not registered",
+			req: UpdateWishlistEntryRequest{
+				UserID:          signUpRes.UserID,
+				BookID:          -1,
+				Priority:        1,
+				ExpectedVersion: &staleVersion,
+			},
+			check: func(t *testing.T, res *UpdateWishlistEntryResponse, err error) {
+				require.Nil(t, res)
+				var e *Error
+				require.ErrorAs(t, err, &e)
+				require.Equal(t, NotFound, e.StatusCode)
+				require.Equal(t, NotFoundBookError, e.ErrorCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := wishlistUseCase.UpdateWishlistEntry(context.Background(), tc.req)
+			tc.check(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"readly/entity"
+	"readly/repository"
+	"readly/service/event"
+)
+
+const watchLibraryReplayPageSize int32 = 100
+
+var libraryEventTypes = []entity.DomainEventType{
+	entity.BookRegistered,
+	entity.BookUpdated,
+	entity.BookDeleted,
+	entity.StatusChanged,
+}
+
+type WatchLibraryUseCase interface {
+	WatchLibrary(ctx context.Context, req WatchLibraryRequest, send func(entity.LibraryEvent) error) error
+}
+
+type WatchLibraryUseCaseImpl struct {
+	outboxRepo repository.OutboxRepository
+	bus        *event.Bus
+}
+
+func NewWatchLibraryUseCase(
+	outboxRepo repository.OutboxRepository,
+	bus *event.Bus,
+) WatchLibraryUseCase {
+	return &WatchLibraryUseCaseImpl{
+		outboxRepo: outboxRepo,
+		bus:        bus,
+	}
+}
+
+type WatchLibraryRequest struct {
+	UserID int64
+	// 前回受け取ったイベントのResumeToken。空の場合は現在以降の変更のみ受け取る
+	ResumeToken string
+}
+
+// WatchLibrary ctxがキャンセルされるまでライブラリの変更をsendに渡し続ける。
+// ResumeTokenがあれば取りこぼしたイベントを再送してからLibrarySyncedを送る。
+// 受信が追いつかない場合はStreamLaggedErrorで終了するので、最後のResumeTokenで再接続する。
+func (u *WatchLibraryUseCaseImpl) WatchLibrary(ctx context.Context, req WatchLibraryRequest, send func(entity.LibraryEvent) error) (err error) {
+	defer func() {
+		if err != nil {
+			err = handle(err)
+		}
+	}()
+
+	// イベントのIDはコミット順と一致しないため、リレーが配信した順の番号で再開位置を管理する
+	var lastSeq int64
+	if req.ResumeToken != "" {
+		lastSeq, err = decodeCursor(req.ResumeToken)
+		if err != nil || lastSeq < 0 {
+			return newError(BadRequest, InvalidCursorError, "invalid resume token")
+		}
+	}
+
+	// 再送中に配信されたイベントを取りこぼさないよう、先に購読しておく
+	sub := u.bus.Subscribe(req.UserID)
+	defer sub.Close()
+
+	if req.ResumeToken == "" {
+		lastSeq, err = u.outboxRepo.GetLatestPublishedSeq(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	for {
+		events, err := u.outboxRepo.GetPublishedByUser(ctx, repository.GetPublishedOutboxEventsByUserRequest{
+			UserID:   req.UserID,
+			AfterSeq: lastSeq,
+			Types:    libraryEventTypes,
+			Limit:    watchLibraryReplayPageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if err := u.send(send, entity.DomainEvent{ID: e.ID, Type: e.Type, Payload: e.Payload, OccurredAt: e.CreatedAt, PublishedSeq: e.PublishedSeq}); err != nil {
+				return err
+			}
+			lastSeq = e.PublishedSeq
+		}
+		if int32(len(events)) < watchLibraryReplayPageSize {
+			break
+		}
+	}
+	err = send(entity.LibraryEvent{Type: entity.LibrarySynced, ResumeToken: encodeCursor(lastSeq)})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				return newError(Maintenance, StreamLaggedError, "library stream lagged behind, reconnect with the last resume token")
+			}
+			// 再送済みのイベントは送らない
+			if e.PublishedSeq <= lastSeq || !isLibraryEvent(e.Type) {
+				continue
+			}
+			if err := u.send(send, e); err != nil {
+				return err
+			}
+			lastSeq = e.PublishedSeq
+		}
+	}
+}
+
+func (u *WatchLibraryUseCaseImpl) send(send func(entity.LibraryEvent) error, e entity.DomainEvent) error {
+	le, err := newLibraryEvent(e)
+	if err != nil {
+		return err
+	}
+	return send(le)
+}
+
+func isLibraryEvent(t entity.DomainEventType) bool {
+	for _, lt := range libraryEventTypes {
+		if t == lt {
+			return true
+		}
+	}
+	return false
+}
+
+func newLibraryEvent(e entity.DomainEvent) (entity.LibraryEvent, error) {
+	le := entity.LibraryEvent{
+		ResumeToken: encodeCursor(e.PublishedSeq),
+		OccurredAt:  e.OccurredAt,
+	}
+	switch e.Type {
+	case entity.BookRegistered:
+		var p entity.BookRegisteredPayload
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return le, err
+		}
+		le.Type = entity.LibraryBookAdded
+		le.BookID = p.BookID
+		le.Title = p.Title
+		le.Status = entity.ParseReadingStatus(p.Status)
+	case entity.BookUpdated:
+		var p entity.BookUpdatedPayload
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return le, err
+		}
+		le.Type = entity.LibraryBookUpdated
+		le.BookID = p.BookID
+		le.Priority = p.Priority
+		le.Owned = p.Owned
+	case entity.BookDeleted:
+		var p entity.BookDeletedPayload
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return le, err
+		}
+		le.Type = entity.LibraryBookRemoved
+		le.BookID = p.BookID
+	case entity.StatusChanged:
+		var p entity.StatusChangedPayload
+		if err := json.Unmarshal(e.Payload, &p); err != nil {
+			return le, err
+		}
+		le.Type = entity.LibraryStatusChanged
+		le.BookID = p.BookID
+		le.Status = entity.ParseReadingStatus(p.To)
+		le.PreviousStatus = entity.ParseReadingStatus(p.From)
+	}
+	return le, nil
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"readly/entity"
+	"readly/service/event"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+func watchTestLibrary(t *testing.T, bus *event.Bus, req WatchLibraryRequest) (<-chan entity.LibraryEvent, <-chan error, context.CancelFunc) {
+	watchLibraryUseCase := newTestWatchLibraryUseCase(t, bus)
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan entity.LibraryEvent, 10)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- watchLibraryUseCase.WatchLibrary(ctx, req, func(e entity.LibraryEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+	return events, errs, cancel
+}
+
+func receiveLibraryEvent(t *testing.T, events <-chan entity.LibraryEvent) entity.LibraryEvent {
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("library event was not received")
+		return entity.LibraryEvent{}
+	}
+}
+
+func TestWatchLibrary(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	wishlistUseCase := newTestUpdateWishlistEntryUseCase(t)
+	deleteBookUseCase := newTestDeleteBookUseCase(t)
+	bus := event.NewBus()
+	relayUseCase := newTestRelayOutboxEventsUseCase(t, nil, bus)
+
+	drainOutbox(t)
+
+	user := signUpTestUser(t)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: user.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Unread,
+	})
+	require.NoError(t, err)
+	drainOutbox(t)
+
+	// 接続前のイベントはResumeTokenから再送される
+	events, errs, cancel := watchTestLibrary(t, bus, WatchLibraryRequest{UserID: user.UserID, ResumeToken: encodeCursor(0)})
+	added := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibraryBookAdded, added.Type)
+	require.Equal(t, book.ID, added.BookID)
+	require.Equal(t, book.Title, added.Title)
+	require.Equal(t, entity.Unread, added.Status)
+	synced := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibrarySynced, synced.Type)
+	require.Equal(t, added.ResumeToken, synced.ResumeToken)
+
+	_, err = wishlistUseCase.UpdateWishlistEntry(context.Background(), UpdateWishlistEntryRequest{
+		UserID:   user.UserID,
+		BookID:   book.ID,
+		Priority: 3,
+		Owned:    true,
+	})
+	require.NoError(t, err)
+	err = deleteBookUseCase.DeleteBook(context.Background(), DeleteBookRequest{UserID: user.UserID, BookID: book.ID})
+	require.NoError(t, err)
+	_, err = relayUseCase.RelayOutboxEvents(context.Background(), RelayOutboxEventsRequest{Limit: 100})
+	require.NoError(t, err)
+
+	updated := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibraryBookUpdated, updated.Type)
+	require.Equal(t, int16(3), updated.Priority)
+	require.True(t, updated.Owned)
+	removed := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibraryBookRemoved, removed.Type)
+	require.Equal(t, book.ID, removed.BookID)
+
+	cancel()
+	require.NoError(t, <-errs)
+
+	// 再接続すると最後に受け取ったイベントより後だけが再送される
+	events, errs, cancel = watchTestLibrary(t, bus, WatchLibraryRequest{UserID: user.UserID, ResumeToken: updated.ResumeToken})
+	e := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibraryBookRemoved, e.Type)
+	e = receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibrarySynced, e.Type)
+	require.Equal(t, removed.ResumeToken, e.ResumeToken)
+	cancel()
+	require.NoError(t, <-errs)
+
+	// ResumeTokenがなければ現在以降のイベントのみ受け取る
+	events, errs, cancel = watchTestLibrary(t, bus, WatchLibraryRequest{UserID: user.UserID})
+	e = receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibrarySynced, e.Type)
+	cancel()
+	require.NoError(t, <-errs)
+}
+
+func TestWatchLibraryResumeDuringRelay(t *testing.T) {
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	wishlistUseCase := newTestUpdateWishlistEntryUseCase(t)
+	bus := event.NewBus()
+
+	drainOutbox(t)
+
+	user := signUpTestUser(t)
+	book, err := registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: user.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Unread,
+	})
+	require.NoError(t, err)
+	_, err = wishlistUseCase.UpdateWishlistEntry(context.Background(), UpdateWishlistEntryRequest{
+		UserID:   user.UserID,
+		BookID:   book.ID,
+		Priority: 2,
+	})
+	require.NoError(t, err)
+
+	// 2件目のイベントの配信中にトランザクションを止める
+	reached := make(chan struct{})
+	release := make(chan struct{})
+	sink := event.SinkFunc(func(_ context.Context, e entity.DomainEvent) error {
+		if userID, _ := e.UserID(); e.Type == entity.BookUpdated && userID == user.UserID {
+			close(reached)
+			<-release
+		}
+		return nil
+	})
+	relayUseCase := newTestRelayOutboxEventsUseCase(t, []event.Sink{sink}, bus)
+	relayErrs := make(chan error, 1)
+	go func() {
+		_, err := relayUseCase.RelayOutboxEvents(context.Background(), RelayOutboxEventsRequest{Limit: 100})
+		relayErrs <- err
+	}()
+	<-reached
+
+	// コミット前の配信は再送にもライブのイベントにも現れない
+	events, errs, cancel := watchTestLibrary(t, bus, WatchLibraryRequest{UserID: user.UserID, ResumeToken: encodeCursor(0)})
+	synced := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibrarySynced, synced.Type)
+	require.Equal(t, encodeCursor(0), synced.ResumeToken)
+
+	close(release)
+	require.NoError(t, <-relayErrs)
+
+	// コミット後はバッチ内の全てのイベントを順に受け取る
+	added := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibraryBookAdded, added.Type)
+	require.Equal(t, book.ID, added.BookID)
+	updated := receiveLibraryEvent(t, events)
+	require.Equal(t, entity.LibraryBookUpdated, updated.Type)
+	require.Equal(t, int16(2), updated.Priority)
+	cancel()
+	require.NoError(t, <-errs)
+}
+
+func TestWatchLibraryWithInvalidResumeToken(t *testing.T) {
+	watchLibraryUseCase := newTestWatchLibraryUseCase(t, event.NewBus())
+	err := watchLibraryUseCase.WatchLibrary(context.Background(), WatchLibraryRequest{UserID: 1, ResumeToken: "invalid"}, func(entity.LibraryEvent) error {
+		return nil
+	})
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, BadRequest, e.StatusCode)
+	require.Equal(t, InvalidCursorError, e.ErrorCode)
+}
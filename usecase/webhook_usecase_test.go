@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"readly/entity"
+	"readly/service/event"
+	"readly/service/webhook"
+	"readly/testdata"
+	"testing"
+	"time"
+)
+
+type fakeWebhookClient struct {
+	status int
+	reqs   []webhook.Request
+}
+
+func (c *fakeWebhookClient) Send(_ context.Context, req webhook.Request) (int, error) {
+	c.reqs = append(c.reqs, req)
+	return c.status, nil
+}
+
+func TestCreateWebhook(t *testing.T) {
+	createUseCase := newTestCreateWebhookUseCase(t)
+	user := signUpTestUser(t)
+
+	testCases := []struct {
+		name      string
+		req       CreateWebhookRequest
+		errorCode ErrorCode
+	}{
+		{
+			name:      "Create webhook failure when url is not http",
+			req:       CreateWebhookRequest{UserID: user.UserID, URL: "ftp://example.com", EventTypes: []entity.DomainEventType{entity.BookRegistered}},
+			errorCode: InvalidWebhookURLError,
+		},
+		{
+			name:      "Create webhook failure when url is local",
+			req:       CreateWebhookRequest{UserID: user.UserID, URL: "http://127.0.0.1/hook", EventTypes: []entity.DomainEventType{entity.BookRegistered}},
+			errorCode: InvalidWebhookURLError,
+		},
+		{
+			name:      "Create webhook failure when url is IPv4-mapped local address",
+			req:       CreateWebhookRequest{UserID: user.UserID, URL: "http://[::ffff:169.254.169.254]/hook", EventTypes: []entity.DomainEventType{entity.BookRegistered}},
+			errorCode: InvalidWebhookURLError,
+		},
+		{
+			name:      "Create webhook failure without event types",
+			req:       CreateWebhookRequest{UserID: user.UserID, URL: "https://example.com/hook"},
+			errorCode: InvalidWebhookEventError,
+		},
+		{
+			name:      "Create webhook failure with unknown event type",
+			req:       CreateWebhookRequest{UserID: user.UserID, URL: "https://example.com/hook", EventTypes: []entity.DomainEventType{"unknown"}},
+			errorCode: InvalidWebhookEventError,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := createUseCase.CreateWebhook(context.Background(), tc.req)
+			var e *Error
+			require.ErrorAs(t, err, &e)
+			require.Equal(t, BadRequest, e.StatusCode)
+			require.Equal(t, tc.errorCode, e.ErrorCode)
+		})
+	}
+
+	t.Run("Create webhook success", func(t *testing.T) {
+		res, err := createUseCase.CreateWebhook(context.Background(), CreateWebhookRequest{
+			UserID:     user.UserID,
+			URL:        "https://example.com/hook",
+			EventTypes: []entity.DomainEventType{entity.StatusChanged, entity.StatusChanged, entity.BookRegistered},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Secret, 64)
+		require.Equal(t, "https://example.com/hook", res.Webhook.URL)
+		require.Equal(t, []entity.DomainEventType{entity.StatusChanged, entity.BookRegistered}, res.Webhook.EventTypes)
+	})
+}
+
+func TestDeliverWebhooks(t *testing.T) {
+	createUseCase := newTestCreateWebhookUseCase(t)
+	registerBookUseCase := newTestRegisterBookUseCase(t)
+	listUseCase := newTestListWebhookDeliveriesUseCase(t)
+	enqueueUseCase := newTestEnqueueWebhookDeliveriesUseCase(t)
+
+	drainOutbox(t)
+
+	user := signUpTestUser(t)
+	hook, err := createUseCase.CreateWebhook(context.Background(), CreateWebhookRequest{
+		UserID:     user.UserID,
+		URL:        "https://example.com/hook",
+		EventTypes: []entity.DomainEventType{entity.BookRegistered},
+	})
+	require.NoError(t, err)
+
+	_, err = registerBookUseCase.RegisterBook(context.Background(), RegisterBookRequest{
+		UserID: user.UserID,
+		Title:  testdata.RandomString(10),
+		Status: entity.Unread,
+	})
+	require.NoError(t, err)
+
+	relayUseCase := newTestRelayOutboxEventsUseCase(t, []event.Sink{event.SinkFunc(enqueueUseCase.EnqueueWebhookDeliveries)})
+	// 同じイベントが複数回配信されても配信は1件だけ作成される
+	for i := 0; i < 2; i++ {
+		_, err = relayUseCase.RelayOutboxEvents(context.Background(), RelayOutboxEventsRequest{Limit: 100})
+		require.NoError(t, err)
+	}
+	deliveries, err := listUseCase.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesRequest{
+		UserID:    user.UserID,
+		WebhookID: hook.Webhook.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, entity.BookRegistered, deliveries[0].EventType)
+	require.Equal(t, entity.DeliveryPending, deliveries[0].Status)
+
+	failingClient := &fakeWebhookClient{status: http.StatusInternalServerError}
+	_, err = newTestDeliverWebhooksUseCase(t, failingClient).DeliverWebhooks(context.Background(), DeliverWebhooksRequest{Limit: 100})
+	require.NoError(t, err)
+	require.NotEmpty(t, failingClient.reqs)
+
+	deliveries, err = listUseCase.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesRequest{
+		UserID:    user.UserID,
+		WebhookID: hook.Webhook.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, entity.DeliveryPending, deliveries[0].Status)
+	require.Equal(t, int32(1), deliveries[0].Attempts)
+	require.Equal(t, int32(http.StatusInternalServerError), *deliveries[0].LastResponseStatus)
+	require.WithinDuration(t, time.Now().Add(webhookBaseBackoff), *deliveries[0].NextAttemptAt, 5*time.Second)
+
+	// 再試行の時刻まではもう一度送信しない
+	succeedingClient := &fakeWebhookClient{status: http.StatusOK}
+	_, err = newTestDeliverWebhooksUseCase(t, succeedingClient).DeliverWebhooks(context.Background(), DeliverWebhooksRequest{Limit: 100})
+	require.NoError(t, err)
+	for _, req := range succeedingClient.reqs {
+		require.NotEqual(t, deliveries[0].ID, req.DeliveryID)
+	}
+
+	_, err = listUseCase.ListWebhookDeliveries(context.Background(), ListWebhookDeliveriesRequest{
+		UserID:    signUpTestUser(t).UserID,
+		WebhookID: hook.Webhook.ID,
+	})
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	require.Equal(t, NotFoundWebhookError, e.ErrorCode)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	require.Equal(t, webhookBaseBackoff, webhookBackoff(1))
+	require.Equal(t, 2*webhookBaseBackoff, webhookBackoff(2))
+	require.Equal(t, 64*webhookBaseBackoff, webhookBackoff(webhookMaxAttempts-1))
+}
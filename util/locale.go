@@ -0,0 +1,64 @@
+package util
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+)
+
+type Locale string
+
+const (
+	Japanese Locale = "ja"
+	English  Locale = "en"
+	// DefaultLocale 利用者の多くが日本語話者のため、指定がない場合は日本語にする
+	DefaultLocale = Japanese
+)
+
+// 先頭がDefaultLocaleになるようにする
+var localeMatcher = language.NewMatcher([]language.Tag{language.Japanese, language.English})
+
+// ParseLocale Accept-Languageの形式(例: "en-US,en;q=0.9,ja;q=0.8")から対応しているロケールを選ぶ
+func ParseLocale(acceptLanguage string) Locale {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLocale
+	}
+	_, index, confidence := localeMatcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLocale
+	}
+	if index == 1 {
+		return English
+	}
+	return Japanese
+}
+
+// Message ロケールごとのメッセージの書式
+type Message map[Locale]string
+
+// Format 指定したロケールの書式がない場合は英語を使う
+func (m Message) Format(locale Locale, args ...any) string {
+	format, ok := m[locale]
+	if !ok {
+		format = m[English]
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// LocalizedError Error()は英語のメッセージを返す
+type LocalizedError struct {
+	message Message
+	args    []any
+}
+
+func NewLocalizedError(message Message, args ...any) *LocalizedError {
+	return &LocalizedError{message: message, args: args}
+}
+
+func (e *LocalizedError) Error() string {
+	return e.Localize(English)
+}
+
+func (e *LocalizedError) Localize(locale Locale) string {
+	return e.message.Format(locale, e.args...)
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseLocale(t *testing.T) {
+	testCases := []struct {
+		acceptLanguage string
+		want           Locale
+	}{
+		{acceptLanguage: "", want: Japanese},
+		{acceptLanguage: "ja", want: Japanese},
+		{acceptLanguage: "ja-JP,ja;q=0.9", want: Japanese},
+		{acceptLanguage: "en-US,en;q=0.9,ja;q=0.8", want: English},
+		{acceptLanguage: "fr-FR,en;q=0.5", want: English},
+		{acceptLanguage: "fr-FR", want: Japanese},
+		{acceptLanguage: "invalid;;", want: Japanese},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.acceptLanguage, func(t *testing.T) {
+			require.Equal(t, tc.want, ParseLocale(tc.acceptLanguage))
+		})
+	}
+}
+
+func TestLocalizedError(t *testing.T) {
+	err := StringValidator("").ValidateLength(1, 255)
+
+	var e *LocalizedError
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, "length must be between 1 and 255", err.Error())
+	require.Equal(t, "length must be between 1 and 255", e.Localize(English))
+	require.Equal(t, "1文字以上255文字以下で入力してください", e.Localize(Japanese))
+}
@@ -13,6 +13,13 @@ func ToTimeOrNil(value *timestamppb.Timestamp) *time.Time {
 	return nil
 }
 
+func ToTimeOrZero(value *timestamppb.Timestamp) time.Time {
+	if value != nil {
+		return value.AsTime()
+	}
+	return time.Time{}
+}
+
 func ToTimestampOrNil(value *time.Time) *timestamppb.Timestamp {
 	if value != nil {
 		t := timestamppb.New(*value)
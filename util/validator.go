@@ -1,8 +1,9 @@
 package util
 
 import (
-	"fmt"
+	"net/url"
 	"regexp"
+	"unicode/utf8"
 )
 
 var (
@@ -12,20 +13,64 @@ var (
 	lowerCaseRegex = regexp.MustCompile(`[a-z]`)
 	digitRegex     = regexp.MustCompile(`[0-9]`)
 	symbolRegex    = regexp.MustCompile(`[\-^$*.@]`)
+	isbn10Regex    = regexp.MustCompile(`^[0-9]{9}[0-9X]$`)
+	isbn13Regex    = regexp.MustCompile(`^[0-9]{13}$`)
 )
 
+var (
+	lengthMessage = Message{
+		English:  "length must be between %d and %d",
+		Japanese: "%d文字以上%d文字以下で入力してください",
+	}
+	formatMessage = Message{
+		English:  "'%s' invalid format",
+		Japanese: "'%s' の形式が正しくありません",
+	}
+	urlMessage = Message{
+		English:  "'%s' is not a valid URL",
+		Japanese: "'%s' はURLの形式が正しくありません",
+	}
+	isbnMessage = Message{
+		English:  "'%s' is not a valid ISBN",
+		Japanese: "'%s' はISBNの形式が正しくありません",
+	}
+	passwordLengthMessage = Message{
+		English:  "password length must be between 8 and 48",
+		Japanese: "パスワードは8文字以上48文字以下で入力してください",
+	}
+	passwordUpperCaseMessage = Message{
+		English:  "password must contain at least one uppercase letter",
+		Japanese: "パスワードには英大文字を1文字以上含めてください",
+	}
+	passwordLowerCaseMessage = Message{
+		English:  "password must contain at least one lowercase letter",
+		Japanese: "パスワードには英小文字を1文字以上含めてください",
+	}
+	passwordDigitMessage = Message{
+		English:  "password must contain at least one digit",
+		Japanese: "パスワードには数字を1文字以上含めてください",
+	}
+	passwordSymbolMessage = Message{
+		English:  "password must contain at least one symbol",
+		Japanese: "パスワードには記号(-^$*.@)を1文字以上含めてください",
+	}
+)
+
+// StringValidator 検証に失敗した場合は*LocalizedErrorを返す
 type StringValidator string
 
+// ValidateLength バイト数ではなく文字数で判定する
 func (s StringValidator) ValidateLength(minLength int, maxLength int) error {
-	if len(s) < minLength || len(s) > maxLength {
-		return fmt.Errorf("length must be between %d and %d", minLength, maxLength)
+	n := utf8.RuneCountInString(string(s))
+	if n < minLength || n > maxLength {
+		return NewLocalizedError(lengthMessage, minLength, maxLength)
 	}
 	return nil
 }
 
 func (s StringValidator) validateRegex(re *regexp.Regexp) error {
 	if !re.MatchString(string(s)) {
-		return fmt.Errorf("'%s' invalid format", s)
+		return NewLocalizedError(formatMessage, string(s))
 	}
 	return nil
 }
@@ -42,23 +87,64 @@ func (s StringValidator) ValidateEmail() error {
 func (s StringValidator) ValidatePassword() error {
 	// 大小英数字記号をそれぞれ1文字以上含む8文字以上48文字以下の文字列
 	if s.ValidateLength(8, 48) != nil {
-		return fmt.Errorf("password length must be between 8 and 48")
+		return NewLocalizedError(passwordLengthMessage)
 	}
 	if !s.hasUpperCase() {
-		return fmt.Errorf("password must contain at least one uppercase letter")
+		return NewLocalizedError(passwordUpperCaseMessage)
 	}
 	if !s.hasLowerCase() {
-		return fmt.Errorf("password must contain at least one lowercase letter")
+		return NewLocalizedError(passwordLowerCaseMessage)
 	}
 	if !s.hasDigit() {
-		return fmt.Errorf("password must contain at least one digit")
+		return NewLocalizedError(passwordDigitMessage)
 	}
 	if !s.hasSymbol() {
-		return fmt.Errorf("password must contain at least one symbol")
+		return NewLocalizedError(passwordSymbolMessage)
 	}
 	return nil
 }
 
+func (s StringValidator) ValidateURL() error {
+	// http/httpsのスキームとホストを持つ絶対URL
+	u, err := url.Parse(string(s))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return NewLocalizedError(urlMessage, string(s))
+	}
+	return nil
+}
+
+func (s StringValidator) ValidateISBN() error {
+	// ハイフンなしのISBN-10またはISBN-13でチェックディジットが正しいこと
+	v := string(s)
+	switch {
+	case isbn10Regex.MatchString(v):
+		sum := 0
+		for i, c := range v {
+			d := int(c - '0')
+			if c == 'X' {
+				d = 10
+			}
+			sum += (10 - i) * d
+		}
+		if sum%11 == 0 {
+			return nil
+		}
+	case isbn13Regex.MatchString(v):
+		sum := 0
+		for i, c := range v {
+			d := int(c - '0')
+			if i%2 == 1 {
+				d *= 3
+			}
+			sum += d
+		}
+		if sum%10 == 0 {
+			return nil
+		}
+	}
+	return NewLocalizedError(isbnMessage, string(s))
+}
+
 func (s StringValidator) hasUpperCase() bool {
 	err := s.validateRegex(upperCaseRegex)
 	return err == nil
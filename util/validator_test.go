@@ -138,3 +138,129 @@ func TestStringValidator_ValidatePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestStringValidator_ValidateLength(t *testing.T) {
+	testCases := []struct {
+		value string
+		check func(err error)
+	}{
+		{
+			value: "ノルウェイの森",
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			value: "",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			value: "ノルウェイの森森",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("value=%s", tc.value), func(t *testing.T) {
+			err := StringValidator(tc.value).ValidateLength(1, 7)
+			tc.check(err)
+		})
+	}
+}
+
+func TestStringValidator_ValidateURL(t *testing.T) {
+	testCases := []struct {
+		url   string
+		check func(err error)
+	}{
+		{
+			url: "https://example.com/books/1",
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			url: "http://example.com",
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			url: "ftp://example.com",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			url: "example.com",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			url: "https://",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("url=%s", tc.url), func(t *testing.T) {
+			err := StringValidator(tc.url).ValidateURL()
+			tc.check(err)
+		})
+	}
+}
+
+func TestStringValidator_ValidateISBN(t *testing.T) {
+	testCases := []struct {
+		isbn  string
+		check func(err error)
+	}{
+		{
+			isbn: "9784101001548",
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			isbn: "4101001545",
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			isbn: "080442957X",
+			check: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			isbn: "9784101001549",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			isbn: "978-4101001548",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			isbn: "12345",
+			check: func(err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("isbn=%s", tc.isbn), func(t *testing.T) {
+			err := StringValidator(tc.isbn).ValidateISBN()
+			tc.check(err)
+		})
+	}
+}